@@ -3,7 +3,9 @@ package main
 import (
 	"fmt"
 	"os"
+	"strings"
 
+	"zd-cli/internal/cache"
 	"zd-cli/internal/commands"
 	"github.com/spf13/cobra"
 )
@@ -13,26 +15,63 @@ var (
 )
 
 func main() {
-	if err := rootCmd.Execute(); err != nil {
+	args, err := commands.ExpandAliases(rootCmd, os.Args[1:])
+	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
 	}
+	rootCmd.SetArgs(args)
+
+	cmd, err := rootCmd.ExecuteC()
+
+	// Hit/miss counters are best effort and never fail the command
+	cache.SaveStats()
+
+	commands.ShowRateLimit(rootCmd)
+
+	// Errors are printed here rather than by cobra, so each is printed once
+	// and API errors come with a suggestion
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", commands.HandleError(err))
+		if strings.HasPrefix(err.Error(), "unknown command") {
+			fmt.Fprintf(os.Stderr, "Run '%s --help' for usage.\n", cmd.CommandPath())
+		}
+		commands.SuggestRequestCommand(cmd, err)
+		os.Exit(1)
+	}
 }
 
-var rootCmd = &cobra.Command{
-	Use:   "zd",
-	Short: "Zendesk CLI - Manage your Zendesk instances from the command line",
-	Long: `zd is a command-line interface for managing Zendesk instances.
+var rootCmd = newRootCommand()
+
+// newRootCommand builds the zd command with every subcommand and global flag
+func newRootCommand() *cobra.Command {
+	rootCmd := &cobra.Command{
+		Use:   "zd",
+		Short: "Zendesk CLI - Manage your Zendesk instances from the command line",
+		Long: `zd is a command-line interface for managing Zendesk instances.
 
 It supports multiple instances with easy switching, API token and OAuth authentication,
 and provides commands for managing tickets, users, and more.`,
-	Version: version,
-	Run: func(cmd *cobra.Command, args []string) {
-		cmd.Help()
-	},
-}
+		Version:       version,
+		SilenceErrors: true,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			// Links can pick the instance, so they come before its defaults
+			if err := commands.ApplyResourceLinks(cmd, args); err != nil {
+				return err
+			}
+			if err := commands.ApplyConfigDefaults(cmd); err != nil {
+				return err
+			}
+			if err := commands.ApplyNonInteractive(cmd); err != nil {
+				return err
+			}
+			return commands.ApplyOutputOptions(cmd)
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Help()
+		},
+	}
 
-func init() {
 	// Register commands
 	rootCmd.AddCommand(commands.NewInitCommand())
 	rootCmd.AddCommand(commands.NewInstanceCommand())
@@ -41,15 +80,66 @@ func init() {
 	rootCmd.AddCommand(commands.NewInstallCommand())
 	rootCmd.AddCommand(commands.NewCacheCommand())
 	rootCmd.AddCommand(commands.NewUserCommand())
+	rootCmd.AddCommand(commands.NewSessionCommand())
 	rootCmd.AddCommand(commands.NewTicketCommand())
+	rootCmd.AddCommand(commands.NewRequestCommand())
 	rootCmd.AddCommand(commands.NewOrganizationCommand())
 	rootCmd.AddCommand(commands.NewGroupCommand())
+	rootCmd.AddCommand(commands.NewMacroCommand())
+	rootCmd.AddCommand(commands.NewViewCommand())
+	rootCmd.AddCommand(commands.NewPlayCommand())
+	rootCmd.AddCommand(commands.NewAutomationCommand())
+	rootCmd.AddCommand(commands.NewBrandCommand())
+	rootCmd.AddCommand(commands.NewRoleCommand())
+	rootCmd.AddCommand(commands.NewScheduleCommand())
+	rootCmd.AddCommand(commands.NewDynamicContentCommand())
+	rootCmd.AddCommand(commands.NewLocaleCommand())
+	rootCmd.AddCommand(commands.NewActivityCommand())
+	rootCmd.AddCommand(commands.NewAuditLogCommand())
+	rootCmd.AddCommand(commands.NewTicketFieldCommand())
+	rootCmd.AddCommand(commands.NewTagCommand())
+	rootCmd.AddCommand(commands.NewSatisfactionCommand())
+	rootCmd.AddCommand(commands.NewSearchCommand())
+	rootCmd.AddCommand(commands.NewOpenCommand())
 	rootCmd.AddCommand(commands.NewReauthCommand())
+	rootCmd.AddCommand(commands.NewRateLimitCommand())
+	rootCmd.AddCommand(commands.NewAPICommand())
+	rootCmd.AddCommand(commands.NewJobCommand())
+	rootCmd.AddCommand(commands.NewExportCommand())
+	rootCmd.AddCommand(commands.NewMigrateCommand())
+	rootCmd.AddCommand(commands.NewDiffCommand())
+	rootCmd.AddCommand(commands.NewConfigCommand())
+	rootCmd.AddCommand(commands.NewAliasCommand())
+	rootCmd.AddCommand(commands.NewHelpCenterCommand())
+	rootCmd.AddCommand(commands.NewWebhookCommand())
+	rootCmd.AddCommand(commands.NewTailCommand())
+	rootCmd.AddCommand(commands.NewNotifyCommand())
+	rootCmd.AddCommand(commands.NewDaemonCommand())
+	rootCmd.AddCommand(commands.NewReportCommand())
 
 	// Global flags
 	rootCmd.PersistentFlags().String("instance", "", "Override the current instance")
 	rootCmd.PersistentFlags().String("config", "", "Config file path (default: ~/.zd/config)")
+	rootCmd.PersistentFlags().Int("max-retries", 3, "Retry rate limited and failed requests up to this many times")
+	rootCmd.PersistentFlags().Bool("no-retry", false, "Don't retry rate limited or failed requests")
+	rootCmd.PersistentFlags().String("timeout", "", "Time limit for each API request, retries included, e.g. 45s or 2m; 0 for none (default: the instance's timeout, or 30s)")
+	rootCmd.PersistentFlags().Int("concurrency", 4, "Pages to fetch at once for --all and other multi-page commands")
+	rootCmd.PersistentFlags().Bool("show-rate-limit", false, "Print the API rate limit budget left after the command runs")
+	rootCmd.PersistentFlags().String("cache-ttl", "", "Cache TTL, overall and/or per resource, e.g. 5m or 5m,tickets=30s,users=1h")
+	rootCmd.PersistentFlags().StringSlice("fields", nil, "Only include these fields in JSON/CSV output, e.g. id,subject,status")
+	rootCmd.PersistentFlags().Bool("wide", false, "Show extra columns in table output")
+	rootCmd.PersistentFlags().Bool("no-truncate", false, "Don't truncate table columns to fit the terminal")
+	rootCmd.PersistentFlags().Bool("no-resolve", false, "Show raw user, organization, and group IDs instead of looking up names")
+	rootCmd.PersistentFlags().String("template", "", "Render output with a Go template, e.g. '{{.id}} {{.subject}}'")
+	rootCmd.PersistentFlags().StringP("debug", "v", "", "Log API requests to stderr; --debug=body also logs bodies with secrets redacted (or set ZD_DEBUG)")
+	rootCmd.PersistentFlags().Lookup("debug").NoOptDefVal = "on"
+	rootCmd.PersistentFlags().Bool("confirm-production", false, "Allow changes to an instance with environment = production without asking")
+	rootCmd.PersistentFlags().Bool("non-interactive", false, "Fail instead of prompting, naming the flag to pass (automatic when stdin isn't a terminal, or set ZD_NON_INTERACTIVE)")
+
+	rootCmd.RegisterFlagCompletionFunc("instance", commands.CompleteInstanceNames)
 
 	// Disable the default completion command since we have our own
 	rootCmd.CompletionOptions.DisableDefaultCmd = true
+
+	return rootCmd
 }
@@ -0,0 +1,328 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"zd-cli/internal/commands"
+	"zd-cli/internal/config"
+	"zd-cli/internal/zdtest"
+)
+
+// runZD runs zd with args against rt, with a config holding one instance for
+// zdtest.Subdomain plus any extra settings for it, and returns what the
+// command printed to stdout
+func runZD(t *testing.T, rt http.RoundTripper, instanceSettings string, args ...string) (string, error) {
+	t.Helper()
+
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "config")
+	configText := "[core]\ncurrent = test\n\n[instance \"test\"]\n" +
+		"subdomain = " + zdtest.Subdomain + "\nauth_type = token\nemail = agent@example.com\napi_token = zdtest-token\n" +
+		instanceSettings
+	if err := os.WriteFile(configPath, []byte(configText), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv("HOME", dir)
+	t.Setenv(config.ConfigEnvVar, configPath)
+	t.Setenv(config.InstanceEnvVar, "")
+	t.Setenv(config.SubdomainEnvVar, "")
+	t.Setenv(config.EmailEnvVar, "")
+	t.Setenv(config.APITokenEnvVar, "")
+
+	commands.SetTransport(rt)
+	defer commands.SetTransport(nil)
+
+	stdout, err := os.Create(filepath.Join(dir, "stdout"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stdout.Close()
+
+	realStdout := os.Stdout
+	os.Stdout = stdout
+	defer func() { os.Stdout = realStdout }()
+
+	root := newRootCommand()
+	root.SetArgs(args)
+	_, runErr := root.ExecuteC()
+
+	if _, err := stdout.Seek(0, io.SeekStart); err != nil {
+		t.Fatal(err)
+	}
+	printed, err := io.ReadAll(stdout)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(printed), runErr
+}
+
+// sent returns the requests server received with method
+func sent(server *zdtest.Server, method string) []zdtest.Request {
+	var requests []zdtest.Request
+	for _, req := range server.Requests() {
+		if req.Method == method {
+			requests = append(requests, req)
+		}
+	}
+	return requests
+}
+
+func TestTicketShowFollowsALink(t *testing.T) {
+	server := zdtest.NewServer()
+	defer server.Close()
+
+	server.Handle("GET", "/tickets/42.json", 200, `{"ticket":{"id":42,"subject":"Printer on fire","status":"open"}}`)
+
+	out, err := runZD(t, server.Transport(), "", "ticket", "show", "https://zdtest.zendesk.com/agent/tickets/42", "-o", "json")
+	if err != nil {
+		t.Fatalf("ticket show: %v", err)
+	}
+	if !strings.Contains(out, "Printer on fire") {
+		t.Errorf("output %q doesn't show the ticket", out)
+	}
+}
+
+func TestTicketListFiltersThroughSearch(t *testing.T) {
+	server := zdtest.NewServer()
+	defer server.Close()
+
+	server.Handle("GET", "/search.json", 200, `{"results":[{"id":7,"subject":"Refund","status":"pending","priority":"high"}],"count":1}`)
+
+	out, err := runZD(t, server.Transport(), "", "ticket", "list", "--status", "open,pending", "--priority", "high", "-o", "json")
+	if err != nil {
+		t.Fatalf("ticket list: %v", err)
+	}
+	if !strings.Contains(out, "Refund") {
+		t.Errorf("output %q doesn't list the ticket", out)
+	}
+
+	searches := sent(server, "GET")
+	if len(searches) != 1 {
+		t.Fatalf("sent %d requests, want 1 search", len(searches))
+	}
+	if query := searches[0].Query.Get("query"); query != "type:ticket status:open status:pending priority:high" {
+		t.Errorf("query = %q", query)
+	}
+}
+
+func TestTicketUpdateDueNeedsATask(t *testing.T) {
+	server := zdtest.NewServer()
+	defer server.Close()
+
+	server.Handle("GET", "/tickets/7.json", 200, `{"ticket":{"id":7,"subject":"Outage","type":"incident"}}`)
+	server.Handle("PUT", "/tickets/7.json", 200, `{"ticket":{"id":7,"subject":"Outage","type":"task"}}`)
+
+	_, err := runZD(t, server.Transport(), "", "ticket", "update", "7", "--due", "2026-10-30")
+	if err == nil || !strings.Contains(err.Error(), "isn't a task") {
+		t.Fatalf("ticket update error = %v, want isn't a task", err)
+	}
+	if puts := sent(server, "PUT"); len(puts) != 0 {
+		t.Fatalf("sent %d updates to an incident", len(puts))
+	}
+
+	if _, err := runZD(t, server.Transport(), "", "ticket", "update", "7", "--due", "2026-10-30", "--type", "task"); err != nil {
+		t.Fatalf("ticket update --type task: %v", err)
+	}
+
+	puts := sent(server, "PUT")
+	if len(puts) != 1 {
+		t.Fatalf("sent %d updates, want 1", len(puts))
+	}
+	var body struct {
+		Ticket struct {
+			Type  string `json:"type"`
+			DueAt string `json:"due_at"`
+		} `json:"ticket"`
+	}
+	if err := json.Unmarshal(puts[0].Body, &body); err != nil {
+		t.Fatal(err)
+	}
+	if body.Ticket.Type != "task" || !strings.HasPrefix(body.Ticket.DueAt, "2026-10-30T") {
+		t.Errorf("update = %s, want a task due 2026-10-30", puts[0].Body)
+	}
+}
+
+func TestReadOnlyInstanceSendsNoWrites(t *testing.T) {
+	server := zdtest.NewServer()
+	defer server.Close()
+
+	_, err := runZD(t, server.Transport(), "read_only = true\n", "ticket", "update", "7", "--status", "solved")
+	if err == nil || !strings.Contains(err.Error(), "read-only") {
+		t.Fatalf("ticket update error = %v, want read-only", err)
+	}
+	if puts := sent(server, "PUT"); len(puts) != 0 {
+		t.Errorf("sent %d updates to a read-only instance", len(puts))
+	}
+}
+
+func TestTicketFollowFromCassette(t *testing.T) {
+	cassette, err := zdtest.LoadCassette(filepath.Join("testdata", "ticket_follow.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// One transport, so the second update plays the second recording
+	rt := cassette.Transport()
+	if _, err := runZD(t, rt, "", "ticket", "follow", "7"); err != nil {
+		t.Errorf("ticket follow: %v", err)
+	}
+	if _, err := runZD(t, rt, "", "ticket", "unfollow", "7"); err != nil {
+		t.Errorf("ticket unfollow: %v", err)
+	}
+}
+
+func TestTicketBulkUpdateReportsEveryID(t *testing.T) {
+	server := zdtest.NewServer()
+	defer server.Close()
+
+	server.Handle("PUT", "/tickets/update_many.json", 200, `{"job_status":{"id":"j1","status":"completed","total":2,"progress":2,"results":[{"id":1,"action":"update","status":"Updated"}]}}`)
+
+	out, err := runZD(t, server.Transport(), "", "ticket", "bulk-update", "1", "2", "--status", "solved", "-o", "json")
+	if err != nil {
+		t.Fatalf("ticket bulk-update: %v", err)
+	}
+
+	var results []struct {
+		ID      int64  `json:"id"`
+		Success bool   `json:"success"`
+		Error   string `json:"error"`
+	}
+	if err := json.Unmarshal([]byte(out), &results); err != nil {
+		t.Fatalf("output %q: %v", out, err)
+	}
+	if len(results) != 2 || !results[0].Success || results[1].Success || results[1].Error != "no result returned" {
+		t.Errorf("results = %+v, want 1 updated and 2 with no result", results)
+	}
+}
+
+func TestOpenUsesTheLinksInstance(t *testing.T) {
+	server := zdtest.NewServer()
+	defer server.Close()
+
+	_, err := runZD(t, server.Transport(), "", "open", "https://elsewhere.zendesk.com/api/v2/users/5.json")
+	if err == nil || !strings.Contains(err.Error(), "no instance is configured for elsewhere.zendesk.com") {
+		t.Errorf("open error = %v, want no instance for elsewhere.zendesk.com", err)
+	}
+
+	_, err = runZD(t, server.Transport(), "", "open", "https://"+zdtest.Subdomain+".zendesk.com/agent/groups/5")
+	if err == nil || !strings.Contains(err.Error(), "doesn't link to a ticket, user, or organization") {
+		t.Errorf("open error = %v, want doesn't link to a ticket, user, or organization", err)
+	}
+}
+
+func TestTicketBulkCloseReportsBatchesBeforeAFailure(t *testing.T) {
+	server := zdtest.NewServer()
+	defer server.Close()
+
+	// 101 tickets make two batches; the first closes its tickets and the
+	// second is refused
+	server.HandleFunc("GET", "/tickets/show_many.json", func(w http.ResponseWriter, r *http.Request) {
+		var tickets []string
+		for _, id := range strings.Split(r.URL.Query().Get("ids"), ",") {
+			tickets = append(tickets, fmt.Sprintf(`{"id":%s,"status":"open"}`, id))
+		}
+		fmt.Fprintf(w, `{"tickets":[%s]}`, strings.Join(tickets, ","))
+	})
+	server.HandleFunc("PUT", "/tickets/update_many.json", func(w http.ResponseWriter, r *http.Request) {
+		ids := strings.Split(r.URL.Query().Get("ids"), ",")
+		if len(sent(server, "PUT")) > 1 {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			fmt.Fprint(w, `{"error":"RecordInvalid","description":"Too many changes"}`)
+			return
+		}
+		var results []string
+		for _, id := range ids {
+			results = append(results, fmt.Sprintf(`{"id":%s,"action":"update","status":"Updated"}`, id))
+		}
+		fmt.Fprintf(w, `{"job_status":{"id":"j1","status":"completed","total":%d,"progress":%d,"results":[%s]}}`, len(ids), len(ids), strings.Join(results, ","))
+	})
+
+	args := []string{"ticket", "bulk-close", "--force", "--report", filepath.Join(t.TempDir(), "report.csv"), "-o", "json"}
+	for id := 1; id <= 101; id++ {
+		args = append(args, fmt.Sprint(id))
+	}
+	reportPath := args[4]
+
+	out, err := runZD(t, server.Transport(), "", args...)
+	if err == nil || !strings.Contains(err.Error(), "failed to submit batch 2/2") {
+		t.Fatalf("ticket bulk-close error = %v, want the second batch to fail", err)
+	}
+
+	var results []struct {
+		ID      int64 `json:"id"`
+		Success bool  `json:"success"`
+	}
+	if err := json.Unmarshal([]byte(out), &results); err != nil {
+		t.Fatalf("output %q: %v", out, err)
+	}
+	if len(results) != 100 || !results[0].Success {
+		t.Errorf("printed %d results, want the first batch's 100", len(results))
+	}
+
+	report, err := os.ReadFile(reportPath)
+	if err != nil {
+		t.Fatalf("no report: %v", err)
+	}
+	if lines := strings.Count(string(report), "\n"); lines != 101 {
+		t.Errorf("report has %d lines, want a header and 100 results", lines)
+	}
+}
+
+func TestMigrateRemapsMacroGroupRestrictions(t *testing.T) {
+	server := zdtest.NewServer()
+	defer server.Close()
+
+	// Both instances share the server, so records are served by who's asking
+	const target = "\n[instance \"target\"]\nsubdomain = " + zdtest.Subdomain + "\nauth_type = token\nemail = target@example.com\napi_token = zdtest-token\n"
+	records := func(source, target string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if user, _, _ := r.BasicAuth(); strings.HasPrefix(user, "target@") {
+				fmt.Fprint(w, target)
+				return
+			}
+			fmt.Fprint(w, source)
+		}
+	}
+	server.HandleFunc("GET", "/ticket_fields.json", records(`{"ticket_fields":[]}`, `{"ticket_fields":[]}`))
+	server.HandleFunc("GET", "/groups.json", records(
+		`{"groups":[{"id":1,"name":"Tier 2"},{"id":3,"name":"Legal"}]}`,
+		`{"groups":[{"id":20,"name":"Tier 2"}]}`))
+	server.HandleFunc("GET", "/macros.json", records(
+		`{"macros":[{"id":7,"title":"Escalate","actions":[],"restriction":{"type":"Group","id":1,"ids":[1]}},`+
+			`{"id":8,"title":"Hold","actions":[],"restriction":{"type":"Group","id":3,"ids":[3]}}]}`,
+		`{"macros":[]}`))
+	server.Handle("POST", "/macros.json", 201, `{"macro":{"id":70,"title":"Escalate"}}`)
+
+	_, err := runZD(t, server.Transport(), target, "migrate", "--from", "test", "--to", "target", "--resources", "macros")
+	if err == nil || !strings.Contains(err.Error(), "some records could not be copied") {
+		t.Fatalf("migrate error = %v, want the macro restricted to a missing group skipped", err)
+	}
+
+	posts := sent(server, "POST")
+	if len(posts) != 1 {
+		t.Fatalf("created %d macros, want 1", len(posts))
+	}
+	var body struct {
+		Macro struct {
+			Title       string `json:"title"`
+			Restriction struct {
+				ID  int64   `json:"id"`
+				IDs []int64 `json:"ids"`
+			} `json:"restriction"`
+		} `json:"macro"`
+	}
+	if err := json.Unmarshal(posts[0].Body, &body); err != nil {
+		t.Fatal(err)
+	}
+	if body.Macro.Title != "Escalate" || body.Macro.Restriction.ID != 20 || len(body.Macro.Restriction.IDs) != 1 || body.Macro.Restriction.IDs[0] != 20 {
+		t.Errorf("created %s, want Escalate restricted to group 20", posts[0].Body)
+	}
+}
@@ -6,8 +6,8 @@ import (
 	"runtime"
 )
 
-// openBrowser opens the default browser to the specified URL
-func openBrowser(url string) error {
+// OpenBrowser opens the default browser to the specified URL
+func OpenBrowser(url string) error {
 	var cmd *exec.Cmd
 
 	switch runtime.GOOS {
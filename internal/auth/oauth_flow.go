@@ -1,9 +1,14 @@
 package auth
 
 import (
+	"bufio"
 	"context"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
+	"os"
+	"strings"
 	"time"
 
 	"golang.org/x/oauth2"
@@ -16,12 +21,32 @@ const (
 	CallbackPort = 8080
 )
 
+// DefaultScopes are the OAuth scopes requested when none are configured
+var DefaultScopes = []string{"read", "write"}
+
 // OAuthConfig holds OAuth2 configuration for Zendesk
 type OAuthConfig struct {
 	ClientID     string
 	ClientSecret string
 	RedirectURL  string
 	Subdomain    string
+	// Scopes to request; DefaultScopes when empty
+	Scopes []string
+	// PKCE uses a code verifier instead of a client secret, for public clients
+	PKCE bool
+	// CallbackPort is the local port for the callback server; CallbackPort when zero
+	CallbackPort int
+	// NoBrowser skips the browser and callback server; the user pastes the
+	// redirect URL or authorization code instead
+	NoBrowser bool
+}
+
+// RedirectURLForPort returns the local callback URL for a callback port
+func RedirectURLForPort(port int) string {
+	if port == 0 {
+		return DefaultRedirectURL
+	}
+	return fmt.Sprintf("http://localhost:%d/callback", port)
 }
 
 // GetOAuthConfig creates an OAuth2 config for Zendesk
@@ -30,7 +55,12 @@ func GetOAuthConfig(cfg OAuthConfig) *oauth2.Config {
 	tokenURL := fmt.Sprintf("https://%s.zendesk.com/oauth/tokens", cfg.Subdomain)
 
 	if cfg.RedirectURL == "" {
-		cfg.RedirectURL = DefaultRedirectURL
+		cfg.RedirectURL = RedirectURLForPort(cfg.CallbackPort)
+	}
+
+	scopes := cfg.Scopes
+	if len(scopes) == 0 {
+		scopes = DefaultScopes
 	}
 
 	return &oauth2.Config{
@@ -42,7 +72,7 @@ func GetOAuthConfig(cfg OAuthConfig) *oauth2.Config {
 			TokenURL: tokenURL,
 		},
 		// Zendesk requires scope parameter as space-separated string
-		Scopes: []string{"read", "write"},
+		Scopes: scopes,
 	}
 }
 
@@ -57,10 +87,32 @@ func PerformOAuthFlow(ctx context.Context, cfg OAuthConfig) (*oauth2.Token, erro
 	codeChan := make(chan string, 1)
 	errChan := make(chan error, 1)
 
+	// PKCE replaces the client secret with a one-time code verifier
+	var authOpts, exchangeOpts []oauth2.AuthCodeOption
+	if cfg.PKCE {
+		verifier := oauth2.GenerateVerifier()
+		authOpts = append(authOpts, oauth2.S256ChallengeOption(verifier))
+		exchangeOpts = append(exchangeOpts, oauth2.VerifierOption(verifier))
+	}
+
+	if cfg.NoBrowser {
+		code, err := readAuthorizationCode(oauthCfg.AuthCodeURL(state, authOpts...), state, os.Stdin)
+		if err != nil {
+			return nil, err
+		}
+		return exchangeCode(ctx, oauthCfg, code, exchangeOpts)
+	}
+
+	port := cfg.CallbackPort
+	if port == 0 {
+		port = CallbackPort
+	}
+
 	// Start local server to receive callback
-	server := &http.Server{Addr: fmt.Sprintf(":%d", CallbackPort)}
+	mux := http.NewServeMux()
+	server := &http.Server{Addr: fmt.Sprintf(":%d", port), Handler: mux}
 
-	http.HandleFunc("/callback", func(w http.ResponseWriter, r *http.Request) {
+	mux.HandleFunc("/callback", func(w http.ResponseWriter, r *http.Request) {
 		// Log the callback for debugging
 		fmt.Printf("\n📥 Received callback: %s\n", r.URL.String())
 
@@ -120,14 +172,14 @@ func PerformOAuthFlow(ctx context.Context, cfg OAuthConfig) (*oauth2.Token, erro
 	time.Sleep(200 * time.Millisecond)
 
 	// Generate authorization URL (don't use AccessTypeOffline - Zendesk doesn't support it)
-	authURL := oauthCfg.AuthCodeURL(state)
+	authURL := oauthCfg.AuthCodeURL(state, authOpts...)
 
 	fmt.Printf("\nOpening browser for authorization...\n")
 	fmt.Printf("\nIf browser doesn't open automatically, visit:\n")
 	fmt.Printf("%s\n\n", authURL)
 
 	// Try to open browser
-	if err := openBrowser(authURL); err != nil {
+	if err := OpenBrowser(authURL); err != nil {
 		fmt.Printf("⚠ Could not open browser automatically: %v\n", err)
 		fmt.Printf("Please open the URL manually.\n\n")
 	}
@@ -155,8 +207,12 @@ func PerformOAuthFlow(ctx context.Context, cfg OAuthConfig) (*oauth2.Token, erro
 	defer cancel()
 	server.Shutdown(shutdownCtx)
 
-	// Exchange code for token
-	token, err := oauthCfg.Exchange(ctx, code)
+	return exchangeCode(ctx, oauthCfg, code, exchangeOpts)
+}
+
+// exchangeCode exchanges an authorization code for a token
+func exchangeCode(ctx context.Context, oauthCfg *oauth2.Config, code string, opts []oauth2.AuthCodeOption) (*oauth2.Token, error) {
+	token, err := oauthCfg.Exchange(ctx, code, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to exchange authorization code for token: %w", err)
 	}
@@ -164,6 +220,51 @@ func PerformOAuthFlow(ctx context.Context, cfg OAuthConfig) (*oauth2.Token, erro
 	return token, nil
 }
 
+// readAuthorizationCode prints the authorization URL and reads the redirect
+// URL or bare authorization code the user pastes back, for machines where the
+// browser can't reach the local callback server
+func readAuthorizationCode(authURL, state string, in io.Reader) (string, error) {
+	fmt.Printf("\nOpen this URL in a browser on any machine and approve access:\n")
+	fmt.Printf("%s\n\n", authURL)
+	fmt.Printf("The browser is then sent to a localhost URL that won't load. Copy that\n")
+	fmt.Printf("URL from the address bar (or just its code parameter) and paste it here.\n\n")
+	fmt.Print("Redirect URL or code: ")
+
+	line, err := bufio.NewReader(in).ReadString('\n')
+	if err != nil && line == "" {
+		return "", fmt.Errorf("failed to read authorization code: %w", err)
+	}
+	input := strings.TrimSpace(line)
+	if input == "" {
+		return "", fmt.Errorf("no authorization code entered")
+	}
+
+	// A bare code has no query string to check
+	if !strings.Contains(input, "?") {
+		return input, nil
+	}
+
+	redirect, err := url.Parse(input)
+	if err != nil {
+		return "", fmt.Errorf("invalid redirect URL: %w", err)
+	}
+	query := redirect.Query()
+
+	if errMsg := query.Get("error"); errMsg != "" {
+		return "", fmt.Errorf("authorization failed: %s - %s", errMsg, query.Get("error_description"))
+	}
+	if query.Get("state") != state {
+		return "", fmt.Errorf("state mismatch - possible CSRF attack")
+	}
+
+	code := query.Get("code")
+	if code == "" {
+		return "", fmt.Errorf("no authorization code in redirect URL")
+	}
+
+	return code, nil
+}
+
 // RefreshToken refreshes an OAuth token if it's expired
 func RefreshToken(ctx context.Context, cfg *oauth2.Config, token *oauth2.Token) (*oauth2.Token, error) {
 	if token.Valid() {
@@ -193,12 +294,13 @@ func ValidateOAuthToken(accessToken, refreshToken, expiry string) error {
 	return nil
 }
 
-// ValidateOAuthConfig validates OAuth client configuration
-func ValidateOAuthConfig(clientID, clientSecret string) error {
+// ValidateOAuthConfig validates OAuth client configuration. PKCE clients
+// don't need a client secret.
+func ValidateOAuthConfig(clientID, clientSecret string, pkce bool) error {
 	if clientID == "" {
 		return fmt.Errorf("OAuth client ID is required")
 	}
-	if clientSecret == "" {
+	if clientSecret == "" && !pkce {
 		return fmt.Errorf("OAuth client secret is required")
 	}
 	return nil
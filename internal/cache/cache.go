@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
+	"strings"
 	"time"
 )
 
@@ -19,41 +21,84 @@ const (
 
 // Entry represents a cached item with expiration
 type Entry struct {
-	Data      json.RawMessage `json:"data"`
-	ExpiresAt time.Time       `json:"expires_at"`
-	CreatedAt time.Time       `json:"created_at"`
+	Key          string          `json:"key"`
+	Data         json.RawMessage `json:"data"`
+	ExpiresAt    time.Time       `json:"expires_at"`
+	CreatedAt    time.Time       `json:"created_at"`
+	ETag         string          `json:"etag,omitempty"`
+	LastModified string          `json:"last_modified,omitempty"`
 }
 
-// Cache handles caching of API responses
+// Validators are the ETag and Last-Modified headers a response was served
+// with. An expired entry that has them can be revalidated with a conditional
+// request instead of being fetched again.
+type Validators struct {
+	ETag         string
+	LastModified string
+}
+
+// IsZero reports whether there are no validators
+func (v Validators) IsZero() bool {
+	return v.ETag == "" && v.LastModified == ""
+}
+
+// validators returns the validators the entry was stored with
+func (e *Entry) validators() Validators {
+	return Validators{ETag: e.ETag, LastModified: e.LastModified}
+}
+
+// Cache handles caching of API responses. Entries are stored as files, with
+// an in-memory LRU layer in front for repeated lookups within one run.
 type Cache struct {
-	dir string
-	ttl time.Duration
+	dir    string
+	ttl    TTL
+	memory *memoryCache
 }
 
-// New creates a new cache instance with the specified TTL
-func New(ttl time.Duration) (*Cache, error) {
+// Dir returns the cache directory
+func Dir() (string, error) {
 	home, err := os.UserHomeDir()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get home directory: %w", err)
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+
+	return filepath.Join(home, cacheDirName, cacheSubDir), nil
+}
+
+// New creates a new cache instance with the specified TTL
+func New(ttl TTL) (*Cache, error) {
+	cacheDir, err := Dir()
+	if err != nil {
+		return nil, err
 	}
 
-	cacheDir := filepath.Join(home, cacheDirName, cacheSubDir)
 	if err := os.MkdirAll(cacheDir, 0700); err != nil {
 		return nil, fmt.Errorf("failed to create cache directory: %w", err)
 	}
 
 	return &Cache{
-		dir: cacheDir,
-		ttl: ttl,
+		dir:    cacheDir,
+		ttl:    ttl,
+		memory: newMemoryCache(memoryCapacity),
 	}, nil
 }
 
 // Get retrieves a cached item by key
 func (c *Cache) Get(key string) ([]byte, bool) {
+	// Check the in-memory layer first
+	if cached, ok := c.memory.get(key); ok {
+		if c.fresh(key, cached.createdAt, cached.expiresAt) {
+			recordHit()
+			return cached.data, true
+		}
+		c.memory.remove(key)
+	}
+
 	path := c.keyToPath(key)
 
 	data, err := os.ReadFile(path)
 	if err != nil {
+		recordMiss()
 		return nil, false
 	}
 
@@ -61,26 +106,95 @@ func (c *Cache) Get(key string) ([]byte, bool) {
 	if err := json.Unmarshal(data, &entry); err != nil {
 		// Invalid cache entry, remove it
 		os.Remove(path)
+		recordMiss()
 		return nil, false
 	}
 
-	// Check if expired
-	if time.Now().After(entry.ExpiresAt) {
-		os.Remove(path)
+	// Check if expired, including against a TTL shorter than the one the
+	// entry was written with. Entries with validators are kept, so Stale
+	// can hand them out for revalidation.
+	if !c.fresh(key, entry.CreatedAt, entry.ExpiresAt) {
+		if entry.validators().IsZero() {
+			os.Remove(path)
+		}
+		recordMiss()
 		return nil, false
 	}
 
+	c.memory.set(&memoryEntry{key: key, data: entry.Data, createdAt: entry.CreatedAt, expiresAt: entry.ExpiresAt})
+
+	recordHit()
 	return entry.Data, true
 }
 
+// fresh reports whether an entry for key is still within both its stored
+// expiry and the current TTL for key
+func (c *Cache) fresh(key string, createdAt, expiresAt time.Time) bool {
+	now := time.Now()
+	return !now.After(expiresAt) && !now.After(createdAt.Add(c.ttl.For(key)))
+}
+
 // Set stores an item in the cache
 func (c *Cache) Set(key string, data []byte) error {
-	entry := Entry{
-		Data:      data,
-		CreatedAt: time.Now(),
-		ExpiresAt: time.Now().Add(c.ttl),
+	return c.SetWithValidators(key, data, Validators{})
+}
+
+// SetWithValidators stores an item in the cache along with the validators
+// of the response it came from
+func (c *Cache) SetWithValidators(key string, data []byte, validators Validators) error {
+	return c.write(&Entry{
+		Key:          key,
+		Data:         data,
+		ETag:         validators.ETag,
+		LastModified: validators.LastModified,
+	})
+}
+
+// Stale returns an entry whether or not it has expired, with its validators,
+// for revalidating with a conditional request. Entries without validators
+// aren't returned.
+func (c *Cache) Stale(key string) ([]byte, Validators, bool) {
+	entry, err := c.read(key)
+	if err != nil || entry.validators().IsZero() {
+		return nil, Validators{}, false
+	}
+	return entry.Data, entry.validators(), true
+}
+
+// Renew restarts an entry's TTL after the API confirmed with a 304 Not
+// Modified that it is unchanged
+func (c *Cache) Renew(key string) error {
+	entry, err := c.read(key)
+	if err != nil {
+		return err
 	}
 
+	recordRevalidation()
+	return c.write(entry)
+}
+
+// read reads an entry from disk, expired or not
+func (c *Cache) read(key string) (*Entry, error) {
+	data, err := os.ReadFile(c.keyToPath(key))
+	if err != nil {
+		return nil, err
+	}
+
+	var entry Entry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, fmt.Errorf("invalid cache entry: %w", err)
+	}
+	return &entry, nil
+}
+
+// write stores an entry, starting its TTL now
+func (c *Cache) write(entry *Entry) error {
+	key := entry.Key
+	entry.CreatedAt = time.Now()
+	entry.ExpiresAt = entry.CreatedAt.Add(c.ttl.For(key))
+
+	c.memory.set(&memoryEntry{key: key, data: entry.Data, createdAt: entry.CreatedAt, expiresAt: entry.ExpiresAt})
+
 	entryData, err := json.Marshal(entry)
 	if err != nil {
 		return fmt.Errorf("failed to marshal cache entry: %w", err)
@@ -96,6 +210,8 @@ func (c *Cache) Set(key string, data []byte) error {
 
 // Delete removes an item from the cache
 func (c *Cache) Delete(key string) error {
+	c.memory.remove(key)
+
 	path := c.keyToPath(key)
 	err := os.Remove(path)
 	if os.IsNotExist(err) {
@@ -106,6 +222,8 @@ func (c *Cache) Delete(key string) error {
 
 // Clear removes all cached items
 func (c *Cache) Clear() error {
+	c.memory.clear()
+
 	entries, err := os.ReadDir(c.dir)
 	if err != nil {
 		return fmt.Errorf("failed to read cache directory: %w", err)
@@ -131,36 +249,133 @@ func (c *Cache) keyToPath(key string) string {
 	return filepath.Join(c.dir, filename)
 }
 
-// PruneExpired removes all expired cache entries
-func (c *Cache) PruneExpired() error {
-	entries, err := os.ReadDir(c.dir)
+// EntryInfo describes one cache entry on disk
+type EntryInfo struct {
+	// Key is the entry's cache key; empty for entries written by older versions
+	Key       string
+	Path      string
+	Size      int64
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+// Expired reports whether the entry has passed its expiry time
+func (e EntryInfo) Expired() bool {
+	return time.Now().After(e.ExpiresAt)
+}
+
+// Resource returns the resource type from the entry's key, e.g. "tickets"
+func (e EntryInfo) Resource() string {
+	parts := strings.SplitN(e.Key, ":", 3)
+	if len(parts) < 2 {
+		return ""
+	}
+	return parts[1]
+}
+
+// Entries lists every cache entry. Unreadable entries are removed.
+func (c *Cache) Entries() ([]EntryInfo, error) {
+	dirEntries, err := os.ReadDir(c.dir)
 	if err != nil {
-		return fmt.Errorf("failed to read cache directory: %w", err)
+		return nil, fmt.Errorf("failed to read cache directory: %w", err)
 	}
 
-	now := time.Now()
-	for _, entry := range entries {
-		if entry.IsDir() {
+	var entries []EntryInfo
+	for _, dirEntry := range dirEntries {
+		if dirEntry.IsDir() {
 			continue
 		}
 
-		path := filepath.Join(c.dir, entry.Name())
+		path := filepath.Join(c.dir, dirEntry.Name())
 		data, err := os.ReadFile(path)
 		if err != nil {
 			continue
 		}
 
-		var cacheEntry Entry
-		if err := json.Unmarshal(data, &cacheEntry); err != nil {
+		var entry Entry
+		if err := json.Unmarshal(data, &entry); err != nil {
 			// Invalid entry, remove it
 			os.Remove(path)
 			continue
 		}
 
-		if now.After(cacheEntry.ExpiresAt) {
-			os.Remove(path)
+		entries = append(entries, EntryInfo{
+			Key:       entry.Key,
+			Path:      path,
+			Size:      int64(len(data)),
+			CreatedAt: entry.CreatedAt,
+			ExpiresAt: entry.ExpiresAt,
+		})
+	}
+
+	return entries, nil
+}
+
+// PruneExpired removes all expired cache entries and returns how many were removed
+func (c *Cache) PruneExpired() (int, error) {
+	entries, err := c.Entries()
+	if err != nil {
+		return 0, err
+	}
+
+	removed := 0
+	for _, entry := range entries {
+		if entry.Expired() {
+			if err := os.Remove(entry.Path); err == nil {
+				removed++
+			}
 		}
 	}
 
-	return nil
+	return removed, nil
+}
+
+// Invalidate removes the entries whose key matches pattern and returns how
+// many were removed. The pattern is matched against the key both with and
+// without its leading "<subdomain>:", and * matches any run of characters, so
+// "tickets*" removes every ticket entry for every instance.
+func (c *Cache) Invalidate(pattern string) (int, error) {
+	matcher, err := compilePattern(pattern)
+	if err != nil {
+		return 0, err
+	}
+
+	entries, err := c.Entries()
+	if err != nil {
+		return 0, err
+	}
+
+	c.memory.clear()
+
+	removed := 0
+	for _, entry := range entries {
+		if entry.Key == "" {
+			continue
+		}
+
+		_, withoutInstance, _ := strings.Cut(entry.Key, ":")
+		if !matcher.MatchString(entry.Key) && !matcher.MatchString(withoutInstance) {
+			continue
+		}
+
+		if err := os.Remove(entry.Path); err == nil {
+			removed++
+		}
+	}
+
+	return removed, nil
+}
+
+// compilePattern turns a glob pattern where * matches anything into a regexp
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	if pattern == "" {
+		return nil, fmt.Errorf("pattern cannot be empty")
+	}
+
+	parts := strings.Split(pattern, "*")
+	for i, part := range parts {
+		parts[i] = regexp.QuoteMeta(part)
+	}
+
+	return regexp.Compile("^" + strings.Join(parts, ".*") + "$")
 }
@@ -0,0 +1,88 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// memoryCapacity is the number of entries kept in the in-memory layer
+const memoryCapacity = 512
+
+// memoryEntry is an entry in the in-memory layer
+type memoryEntry struct {
+	key       string
+	data      []byte
+	createdAt time.Time
+	expiresAt time.Time
+}
+
+// memoryCache is a small LRU cache that sits in front of the file cache, so
+// repeated lookups within one run don't read the same file over and over
+type memoryCache struct {
+	mu       sync.Mutex
+	capacity int
+	order    *list.List
+	items    map[string]*list.Element
+}
+
+// newMemoryCache creates an LRU cache holding up to capacity entries
+func newMemoryCache(capacity int) *memoryCache {
+	return &memoryCache{
+		capacity: capacity,
+		order:    list.New(),
+		items:    make(map[string]*list.Element),
+	}
+}
+
+// get returns the entry for key, marking it as recently used
+func (m *memoryCache) get(key string) (*memoryEntry, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	elem, ok := m.items[key]
+	if !ok {
+		return nil, false
+	}
+	m.order.MoveToFront(elem)
+	return elem.Value.(*memoryEntry), true
+}
+
+// set stores an entry, evicting the least recently used one when full
+func (m *memoryCache) set(entry *memoryEntry) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if elem, ok := m.items[entry.key]; ok {
+		elem.Value = entry
+		m.order.MoveToFront(elem)
+		return
+	}
+
+	m.items[entry.key] = m.order.PushFront(entry)
+	if m.order.Len() > m.capacity {
+		oldest := m.order.Back()
+		m.order.Remove(oldest)
+		delete(m.items, oldest.Value.(*memoryEntry).key)
+	}
+}
+
+// remove deletes the entry for key
+func (m *memoryCache) remove(key string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if elem, ok := m.items[key]; ok {
+		m.order.Remove(elem)
+		delete(m.items, key)
+	}
+}
+
+// clear deletes every entry
+func (m *memoryCache) clear() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.order.Init()
+	m.items = make(map[string]*list.Element)
+}
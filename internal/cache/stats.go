@@ -0,0 +1,138 @@
+package cache
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+)
+
+// statsFileName is the file hit and miss counters are kept in. It lives next
+// to the cache directory so clearing the cache doesn't reset it.
+const statsFileName = "cache-stats.json"
+
+// Hit, miss, and revalidation counters for the current run
+var runHits, runMisses, runRevalidations atomic.Int64
+
+func recordHit()          { runHits.Add(1) }
+func recordMiss()         { runMisses.Add(1) }
+func recordRevalidation() { runRevalidations.Add(1) }
+
+// Counts are cache hit and miss counts. Revalidations are misses the API
+// answered with 304 Not Modified, so the cached entry was used after all.
+type Counts struct {
+	Hits          int64 `json:"hits"`
+	Misses        int64 `json:"misses"`
+	Revalidations int64 `json:"revalidations,omitempty"`
+}
+
+// HitRate returns the share of lookups that were hits, from 0 to 1
+func (c Counts) HitRate() float64 {
+	if c.Hits+c.Misses == 0 {
+		return 0
+	}
+	return float64(c.Hits) / float64(c.Hits+c.Misses)
+}
+
+// Stats are the persisted cache counters: the most recent run that used the
+// cache, and the totals across all runs
+type Stats struct {
+	LastRun   Counts    `json:"last_run"`
+	Total     Counts    `json:"total"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// statsPath returns the path of the stats file
+func statsPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+
+	return filepath.Join(home, cacheDirName, statsFileName), nil
+}
+
+// LoadStats reads the persisted cache counters. Missing stats are returned as zero.
+func LoadStats() (*Stats, error) {
+	path, err := statsPath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return &Stats{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cache stats: %w", err)
+	}
+
+	var stats Stats
+	if err := json.Unmarshal(data, &stats); err != nil {
+		// Corrupt stats are reset rather than reported
+		return &Stats{}, nil
+	}
+
+	return &stats, nil
+}
+
+// SaveStats adds this run's hit and miss counts to the persisted counters.
+// Runs that never looked anything up in the cache leave the stats untouched.
+func SaveStats() error {
+	run := Counts{Hits: runHits.Load(), Misses: runMisses.Load(), Revalidations: runRevalidations.Load()}
+	if run.Hits+run.Misses == 0 {
+		return nil
+	}
+
+	stats, err := LoadStats()
+	if err != nil {
+		return err
+	}
+
+	stats.LastRun = run
+	stats.Total.Hits += run.Hits
+	stats.Total.Misses += run.Misses
+	stats.Total.Revalidations += run.Revalidations
+	stats.UpdatedAt = time.Now()
+
+	return writeStats(stats)
+}
+
+// ResetStats clears the persisted counters
+func ResetStats() error {
+	path, err := statsPath()
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to reset cache stats: %w", err)
+	}
+
+	return nil
+}
+
+// writeStats writes the counters to the stats file
+func writeStats(stats *Stats) error {
+	path, err := statsPath()
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(stats)
+	if err != nil {
+		return fmt.Errorf("failed to marshal cache stats: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("failed to create cache directory: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return fmt.Errorf("failed to write cache stats: %w", err)
+	}
+
+	return nil
+}
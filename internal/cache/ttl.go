@@ -0,0 +1,96 @@
+package cache
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// TTL controls how long cached responses stay fresh. Resources overrides the
+// default for individual resource types, keyed by the resource name used in
+// cache keys (tickets, users, organizations, groups, search, ...).
+type TTL struct {
+	Default   time.Duration
+	Resources map[string]time.Duration
+}
+
+// ParseTTL parses a TTL spec: a default duration, resource=duration pairs, or
+// both, separated by commas, e.g. "5m", "tickets=1m,users=1h", or
+// "5m,tickets=30s". An empty spec returns the zero TTL, which uses DefaultTTL.
+func ParseTTL(spec string) (TTL, error) {
+	var ttl TTL
+
+	for _, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		resource, value, hasResource := strings.Cut(part, "=")
+		if !hasResource {
+			value = resource
+		}
+
+		duration, err := time.ParseDuration(strings.TrimSpace(value))
+		if err != nil || duration < 0 {
+			return TTL{}, fmt.Errorf("invalid cache TTL '%s': use a duration like 30s, 5m, or 1h", part)
+		}
+
+		if !hasResource {
+			ttl.Default = duration
+			continue
+		}
+		if ttl.Resources == nil {
+			ttl.Resources = make(map[string]time.Duration)
+		}
+		ttl.Resources[strings.TrimSpace(resource)] = duration
+	}
+
+	return ttl, nil
+}
+
+// Override returns t with the settings in other applied on top
+func (t TTL) Override(other TTL) TTL {
+	merged := TTL{Default: t.Default, Resources: make(map[string]time.Duration)}
+	if other.Default > 0 {
+		merged.Default = other.Default
+	}
+	for resource, duration := range t.Resources {
+		merged.Resources[resource] = duration
+	}
+	for resource, duration := range other.Resources {
+		merged.Resources[resource] = duration
+	}
+	return merged
+}
+
+// For returns the TTL for a cache key of the form "<subdomain>:<resource>:..."
+func (t TTL) For(key string) time.Duration {
+	parts := strings.SplitN(key, ":", 3)
+	if len(parts) > 1 {
+		if duration, ok := t.Resources[parts[1]]; ok {
+			return duration
+		}
+	}
+	if t.Default > 0 {
+		return t.Default
+	}
+	return DefaultTTL
+}
+
+// String formats t in the syntax accepted by ParseTTL
+func (t TTL) String() string {
+	parts := []string{t.For("").String()}
+
+	resources := make([]string, 0, len(t.Resources))
+	for resource := range t.Resources {
+		resources = append(resources, resource)
+	}
+	sort.Strings(resources)
+
+	for _, resource := range resources {
+		parts = append(parts, fmt.Sprintf("%s=%s", resource, t.Resources[resource]))
+	}
+	return strings.Join(parts, ",")
+}
@@ -0,0 +1,79 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// Activity is something that happened to the authenticated agent's tickets,
+// such as a ticket being assigned to them or a comment on a ticket they
+// follow. Verb names the kind, e.g. "tickets.assignment".
+type Activity struct {
+	ID        int64          `json:"id"`
+	Verb      string         `json:"verb"`
+	Title     string         `json:"title"`
+	UserID    int64          `json:"user_id"`
+	ActorID   int64          `json:"actor_id"`
+	Actor     *ActivityActor `json:"actor"`
+	Object    ActivityObject `json:"object"`
+	Target    ActivityObject `json:"target"`
+	CreatedAt string         `json:"created_at"`
+	UpdatedAt string         `json:"updated_at"`
+}
+
+// ActivityActor is the user who caused an activity
+type ActivityActor struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+// ActivityObject is what an activity happened to
+type ActivityObject struct {
+	Ticket *struct {
+		ID      int64  `json:"id"`
+		Subject string `json:"subject"`
+	} `json:"ticket,omitempty"`
+}
+
+// ActivitiesResponse represents the response from listing activities
+type ActivitiesResponse struct {
+	Activities   []Activity `json:"activities"`
+	NextPage     string     `json:"next_page"`
+	PreviousPage string     `json:"previous_page"`
+	Count        int        `json:"count"`
+}
+
+// TicketID returns the ID of the ticket an activity is about, or 0
+func (a *Activity) TicketID() int64 {
+	for _, object := range []ActivityObject{a.Target, a.Object} {
+		if object.Ticket != nil && object.Ticket.ID != 0 {
+			return object.Ticket.ID
+		}
+	}
+	return 0
+}
+
+// ListActivities retrieves the authenticated agent's activities, newest
+// first. A non-zero since only returns activities from that time on.
+// Results are not cached since they're usually wanted fresh.
+func (c *Client) ListActivities(ctx context.Context, since time.Time, page int, perPage int) (*ActivitiesResponse, error) {
+	path := fmt.Sprintf("/activities.json?page=%d&per_page=%d", page, perPage)
+	if !since.IsZero() {
+		path += "&since=" + url.QueryEscape(since.UTC().Format(time.RFC3339))
+	}
+
+	body, err := c.getPage(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp ActivitiesResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,61 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// Audit represents a single ticket audit: one change to a ticket and the
+// events it produced
+type Audit struct {
+	ID        int64        `json:"id"`
+	TicketID  int64        `json:"ticket_id"`
+	AuthorID  int64        `json:"author_id"`
+	CreatedAt string       `json:"created_at"`
+	Events    []AuditEvent `json:"events"`
+	Via       struct {
+		Channel string `json:"channel"`
+	} `json:"via"`
+	Metadata interface{} `json:"metadata"`
+}
+
+// AuditEvent represents an event within a ticket audit, such as a comment,
+// a field change, or a notification
+type AuditEvent struct {
+	ID            int64       `json:"id"`
+	Type          string      `json:"type"`
+	FieldName     string      `json:"field_name,omitempty"`
+	Value         interface{} `json:"value,omitempty"`
+	PreviousValue interface{} `json:"previous_value,omitempty"`
+	AuthorID      int64       `json:"author_id,omitempty"`
+	Body          string      `json:"body,omitempty"`
+	Public        bool        `json:"public,omitempty"`
+	Subject       string      `json:"subject,omitempty"`
+	Recipients    []int64     `json:"recipients,omitempty"`
+}
+
+// AuditsResponse represents the response from listing ticket audits
+type AuditsResponse struct {
+	Audits       []Audit `json:"audits"`
+	NextPage     string  `json:"next_page"`
+	PreviousPage string  `json:"previous_page"`
+	Count        int     `json:"count"`
+}
+
+// ListTicketAudits retrieves a page of audits for a ticket, oldest first
+// unless newestFirst is set. Audits are never cached since they are used to
+// track changes.
+func (c *Client) ListTicketAudits(ctx context.Context, ticketID int64, page int, perPage int, newestFirst bool) (*AuditsResponse, error) {
+	path := fmt.Sprintf("/tickets/%d/audits.json?page=%d&per_page=%d", ticketID, page, perPage)
+	if newestFirst {
+		path += "&sort_order=desc"
+	}
+
+	var resp AuditsResponse
+	if err := c.do(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,74 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// Automation represents a Zendesk automation: a time-based rule that runs
+// actions on tickets matching its conditions
+type Automation struct {
+	ID         int64  `json:"id"`
+	URL        string `json:"url"`
+	Title      string `json:"title"`
+	Active     bool   `json:"active"`
+	Position   int    `json:"position"`
+	Conditions struct {
+		All []ViewCondition `json:"all"`
+		Any []ViewCondition `json:"any"`
+	} `json:"conditions"`
+	Actions   []MacroAction `json:"actions"`
+	CreatedAt string        `json:"created_at"`
+	UpdatedAt string        `json:"updated_at"`
+}
+
+// AutomationsResponse represents the response from listing automations
+type AutomationsResponse struct {
+	Automations  []Automation `json:"automations"`
+	NextPage     string       `json:"next_page"`
+	PreviousPage string       `json:"previous_page"`
+	Count        int          `json:"count"`
+}
+
+// AutomationResponse represents a single automation response
+type AutomationResponse struct {
+	Automation Automation `json:"automation"`
+}
+
+// ListAutomations retrieves a list of automations
+func (c *Client) ListAutomations(ctx context.Context, page int, perPage int, activeOnly bool) (*AutomationsResponse, error) {
+	cacheKey := fmt.Sprintf("%s:automations:list:%d:%d:%t", c.subdomain, page, perPage, activeOnly)
+	path := fmt.Sprintf("/automations.json?page=%d&per_page=%d", page, perPage)
+	if activeOnly {
+		path = fmt.Sprintf("/automations/active.json?page=%d&per_page=%d", page, perPage)
+	}
+
+	var resp AutomationsResponse
+	if err := c.getCached(ctx, cacheKey, path, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetAutomation retrieves a specific automation by ID
+func (c *Client) GetAutomation(ctx context.Context, automationID int64) (*Automation, error) {
+	return c.Automations().Get(ctx, automationID)
+}
+
+// CreateAutomation creates an automation from a definition. The definition
+// is sent as-is so that exported automations round-trip without losing
+// fields this client doesn't model.
+func (c *Client) CreateAutomation(ctx context.Context, definition map[string]interface{}) (*Automation, error) {
+	return c.Automations().Create(ctx, definition)
+}
+
+// UpdateAutomation updates an automation with the fields in definition
+func (c *Client) UpdateAutomation(ctx context.Context, automationID int64, definition map[string]interface{}) (*Automation, error) {
+	return c.Automations().Update(ctx, automationID, definition)
+}
+
+// DeleteAutomation deletes an automation
+func (c *Client) DeleteAutomation(ctx context.Context, automationID int64) error {
+	return c.Automations().Delete(ctx, automationID)
+}
@@ -0,0 +1,55 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// Brand represents a Zendesk brand
+type Brand struct {
+	ID              int64   `json:"id"`
+	URL             string  `json:"url"`
+	Name            string  `json:"name"`
+	BrandURL        string  `json:"brand_url"`
+	Subdomain       string  `json:"subdomain"`
+	HostMapping     *string `json:"host_mapping"`
+	HasHelpCenter   bool    `json:"has_help_center"`
+	HelpCenterState string  `json:"help_center_state"`
+	Active          bool    `json:"active"`
+	Default         bool    `json:"default"`
+	IsDeleted       bool    `json:"is_deleted"`
+	TicketFormIDs   []int64 `json:"ticket_form_ids"`
+	CreatedAt       string  `json:"created_at"`
+	UpdatedAt       string  `json:"updated_at"`
+}
+
+// BrandsResponse represents the response from listing brands
+type BrandsResponse struct {
+	Brands       []Brand `json:"brands"`
+	NextPage     string  `json:"next_page"`
+	PreviousPage string  `json:"previous_page"`
+	Count        int     `json:"count"`
+}
+
+// BrandResponse represents a single brand response
+type BrandResponse struct {
+	Brand Brand `json:"brand"`
+}
+
+// ListBrands retrieves a list of brands
+func (c *Client) ListBrands(ctx context.Context, page int, perPage int) (*BrandsResponse, error) {
+	cacheKey := fmt.Sprintf("%s:brands:list:%d:%d", c.subdomain, page, perPage)
+	path := fmt.Sprintf("/brands.json?page=%d&per_page=%d", page, perPage)
+
+	var resp BrandsResponse
+	if err := c.getCached(ctx, cacheKey, path, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetBrand retrieves a specific brand by ID
+func (c *Client) GetBrand(ctx context.Context, brandID int64) (*Brand, error) {
+	return c.Brands().Get(ctx, brandID)
+}
@@ -0,0 +1,53 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// cursorPageSize is the page size requested when following cursor pagination
+const cursorPageSize = 100
+
+// cursorMeta holds the cursor pagination fields of a list response
+type cursorMeta struct {
+	Meta struct {
+		HasMore     bool   `json:"has_more"`
+		AfterCursor string `json:"after_cursor"`
+	} `json:"meta"`
+}
+
+// forEachCursorPage fetches path with cursor pagination and calls fn with the
+// body of each page as it arrives, until there are no more pages or fn
+// returns an error. Pages are never cached.
+func (c *Client) forEachCursorPage(ctx context.Context, path string, fn func(body []byte) error) error {
+	sep := "?"
+	if strings.Contains(path, "?") {
+		sep = "&"
+	}
+	base := fmt.Sprintf("%s%spage[size]=%d", path, sep, cursorPageSize)
+
+	next := base
+	for {
+		body, err := c.getPage(ctx, next)
+		if err != nil {
+			return err
+		}
+
+		if err := fn(body); err != nil {
+			return err
+		}
+
+		var meta cursorMeta
+		if err := json.Unmarshal(body, &meta); err != nil {
+			return fmt.Errorf("failed to decode response: %w", err)
+		}
+
+		if !meta.Meta.HasMore || meta.Meta.AfterCursor == "" {
+			return nil
+		}
+		next = base + "&page[after]=" + url.QueryEscape(meta.Meta.AfterCursor)
+	}
+}
@@ -0,0 +1,47 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// CustomRole represents an agent role and the permissions it grants.
+// Configuration maps each permission, e.g. "ticket_access", to a boolean or
+// an access level such as "within-groups".
+type CustomRole struct {
+	ID              int64                  `json:"id"`
+	Name            string                 `json:"name"`
+	Description     string                 `json:"description"`
+	RoleType        int                    `json:"role_type"`
+	TeamMemberCount int                    `json:"team_member_count"`
+	Configuration   map[string]interface{} `json:"configuration"`
+	CreatedAt       string                 `json:"created_at"`
+	UpdatedAt       string                 `json:"updated_at"`
+}
+
+// CustomRolesResponse represents the response from listing custom roles
+type CustomRolesResponse struct {
+	CustomRoles []CustomRole `json:"custom_roles"`
+}
+
+// CustomRoleResponse represents a single custom role response
+type CustomRoleResponse struct {
+	CustomRole CustomRole `json:"custom_role"`
+}
+
+// ListCustomRoles retrieves every custom role. The endpoint isn't paginated.
+func (c *Client) ListCustomRoles(ctx context.Context) ([]CustomRole, error) {
+	cacheKey := fmt.Sprintf("%s:custom_roles:list", c.subdomain)
+
+	var resp CustomRolesResponse
+	if err := c.getCached(ctx, cacheKey, "/custom_roles.json", &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.CustomRoles, nil
+}
+
+// GetCustomRole retrieves a specific custom role by ID
+func (c *Client) GetCustomRole(ctx context.Context, roleID int64) (*CustomRole, error) {
+	return c.CustomRoles().Get(ctx, roleID)
+}
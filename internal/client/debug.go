@@ -0,0 +1,120 @@
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// DebugLevel controls how much of each API request is logged to stderr
+type DebugLevel int
+
+const (
+	// DebugOff logs nothing
+	DebugOff DebugLevel = iota
+	// DebugBasic logs method, URL, status, and latency
+	DebugBasic
+	// DebugBody also logs request and response bodies, with secrets redacted
+	DebugBody
+)
+
+// DebugEnvVar turns on debug logging when set, like --debug
+const DebugEnvVar = "ZD_DEBUG"
+
+// maxDebugBody is how much of a body is logged before it is cut off
+const maxDebugBody = 4096
+
+// ParseDebugLevel parses a --debug or ZD_DEBUG value: "body" (or "2") logs
+// bodies, any other true value ("1", "true", "on") logs requests only
+func ParseDebugLevel(value string) (DebugLevel, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "", "0", "false", "off":
+		return DebugOff, nil
+	case "1", "true", "on", "basic":
+		return DebugBasic, nil
+	case "2", "body":
+		return DebugBody, nil
+	}
+	return DebugOff, fmt.Errorf("invalid debug level %q (use on, off, or body)", value)
+}
+
+// SetDebug sets how much of each API request is logged to stderr
+func (c *Client) SetDebug(level DebugLevel) {
+	c.debug.level = level
+}
+
+// debugTransport is an http.RoundTripper that logs requests and responses
+type debugTransport struct {
+	base  http.RoundTripper
+	level DebugLevel
+	out   io.Writer
+}
+
+// newDebugTransport wraps base with logging to stderr, off until a level is set
+func newDebugTransport(base http.RoundTripper) *debugTransport {
+	return &debugTransport{base: base, out: os.Stderr}
+}
+
+// RoundTrip logs the request, sends it, and logs the response
+func (t *debugTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.level == DebugOff {
+		return t.base.RoundTrip(req)
+	}
+
+	fmt.Fprintf(t.out, "[debug] → %s %s\n", req.Method, req.URL)
+	if t.level >= DebugBody && req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			data, _ := io.ReadAll(body)
+			body.Close()
+			t.logBody(data)
+		}
+	}
+
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	latency := time.Since(start).Round(time.Millisecond)
+	if err != nil {
+		fmt.Fprintf(t.out, "[debug] ← %s %s failed after %s: %v\n", req.Method, req.URL.Path, latency, err)
+		return nil, err
+	}
+
+	fmt.Fprintf(t.out, "[debug] ← %s %s (%s)\n", resp.Status, req.URL.Path, latency)
+	if t.level >= DebugBody {
+		data, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		resp.Body = io.NopCloser(bytes.NewReader(data))
+		t.logBody(data)
+	}
+
+	return resp, nil
+}
+
+// logBody writes a body with secrets redacted, cut off after maxDebugBody bytes
+func (t *debugTransport) logBody(data []byte) {
+	if len(data) == 0 {
+		return
+	}
+
+	text := RedactSecrets(string(data))
+	if len(text) > maxDebugBody {
+		text = fmt.Sprintf("%s... (%d bytes)", text[:maxDebugBody], len(data))
+	}
+	fmt.Fprintf(t.out, "[debug]   %s\n", text)
+}
+
+// secretFields matches JSON string fields whose values must never be logged
+var secretFields = regexp.MustCompile(`("(?:password|token|api_token|access_token|refresh_token|secret|client_secret|oauth_secret)"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+
+// RedactSecrets replaces the values of secret fields in a JSON body, for
+// logging or storing it
+func RedactSecrets(body string) string {
+	return secretFields.ReplaceAllString(body, `$1"[REDACTED]"`)
+}
@@ -0,0 +1,46 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// DeletedTicket represents a soft-deleted ticket
+type DeletedTicket struct {
+	ID          int64  `json:"id"`
+	Subject     string `json:"subject"`
+	Description string `json:"description"`
+	Actor       struct {
+		ID   int64  `json:"id"`
+		Name string `json:"name"`
+	} `json:"actor"`
+	PreviousState string `json:"previous_state"`
+	DeletedAt     string `json:"deleted_at"`
+}
+
+// DeletedTicketsResponse represents the response from listing deleted tickets
+type DeletedTicketsResponse struct {
+	DeletedTickets []DeletedTicket `json:"deleted_tickets"`
+	NextPage       string          `json:"next_page"`
+	PreviousPage   string          `json:"previous_page"`
+	Count          int             `json:"count"`
+}
+
+// ListDeletedTickets retrieves a list of soft-deleted tickets, most recently
+// deleted first. Results are not cached since deletes and restores change them.
+func (c *Client) ListDeletedTickets(ctx context.Context, page int, perPage int) (*DeletedTicketsResponse, error) {
+	path := fmt.Sprintf("/deleted_tickets.json?page=%d&per_page=%d&sort_by=deleted_at&sort_order=desc", page, perPage)
+
+	var resp DeletedTicketsResponse
+	if err := c.do(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// RestoreTicket restores a soft-deleted ticket
+func (c *Client) RestoreTicket(ctx context.Context, ticketID int64) error {
+	return c.do(ctx, http.MethodPut, fmt.Sprintf("/deleted_tickets/%d/restore.json", ticketID), nil, nil)
+}
@@ -0,0 +1,81 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// DeletedUser represents a soft-deleted user. Zendesk keeps deleted users
+// for 30 days unless they are permanently deleted sooner.
+type DeletedUser struct {
+	ID        int64  `json:"id"`
+	Name      string `json:"name"`
+	Email     string `json:"email"`
+	Role      string `json:"role"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// DeletedUsersResponse represents the response from listing deleted users
+type DeletedUsersResponse struct {
+	DeletedUsers []DeletedUser `json:"deleted_users"`
+	NextPage     string        `json:"next_page"`
+	PreviousPage string        `json:"previous_page"`
+	Count        int           `json:"count"`
+}
+
+// ListDeletedUsers retrieves a list of soft-deleted users. Results are not
+// cached since deletes and purges change them.
+func (c *Client) ListDeletedUsers(ctx context.Context, page int, perPage int) (*DeletedUsersResponse, error) {
+	path := fmt.Sprintf("/deleted_users.json?page=%d&per_page=%d", page, perPage)
+
+	body, err := c.getPage(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	var deletedResp DeletedUsersResponse
+	if err := json.Unmarshal(body, &deletedResp); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &deletedResp, nil
+}
+
+// DeletedUserResponse represents the response from getting a deleted user
+type DeletedUserResponse struct {
+	DeletedUser DeletedUser `json:"deleted_user"`
+}
+
+// GetDeletedUser retrieves a soft-deleted user
+func (c *Client) GetDeletedUser(ctx context.Context, userID int64) (*DeletedUser, error) {
+	body, err := c.getPage(ctx, fmt.Sprintf("/deleted_users/%d.json", userID))
+	if err != nil {
+		return nil, err
+	}
+
+	var resp DeletedUserResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &resp.DeletedUser, nil
+}
+
+// PermanentlyDeleteUser erases a soft-deleted user and their personal data,
+// as required for GDPR data-subject erasure. This cannot be undone.
+func (c *Client) PermanentlyDeleteUser(ctx context.Context, userID int64) error {
+	if err := c.do(ctx, http.MethodDelete, fmt.Sprintf("/deleted_users/%d.json", userID), nil, nil); err != nil {
+		return err
+	}
+
+	// The user is gone for good, so drop anything cached about them
+	if c.cache != nil {
+		c.cache.Delete(fmt.Sprintf("%s:users:%d", c.subdomain, userID))
+		c.cache.Invalidate(fmt.Sprintf("%s:users:%d:*", c.subdomain, userID))
+	}
+
+	return nil
+}
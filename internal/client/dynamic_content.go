@@ -0,0 +1,122 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// DynamicContentItem is a snippet of text with a translation per locale,
+// placed in macros, triggers, and automations with its placeholder, e.g.
+// {{dc.welcome_message}}
+type DynamicContentItem struct {
+	ID              int64                   `json:"id,omitempty"`
+	URL             string                  `json:"url,omitempty"`
+	Name            string                  `json:"name"`
+	Placeholder     string                  `json:"placeholder,omitempty"`
+	DefaultLocaleID int64                   `json:"default_locale_id"`
+	Outdated        bool                    `json:"outdated,omitempty"`
+	Variants        []DynamicContentVariant `json:"variants"`
+	CreatedAt       string                  `json:"created_at,omitempty"`
+	UpdatedAt       string                  `json:"updated_at,omitempty"`
+}
+
+// DynamicContentVariant is an item's text in one locale
+type DynamicContentVariant struct {
+	ID        int64  `json:"id,omitempty"`
+	Content   string `json:"content"`
+	LocaleID  int64  `json:"locale_id"`
+	Default   bool   `json:"default"`
+	Active    bool   `json:"active"`
+	Outdated  bool   `json:"outdated,omitempty"`
+	UpdatedAt string `json:"updated_at,omitempty"`
+}
+
+// DynamicContentItemsResponse represents the response from listing dynamic
+// content items
+type DynamicContentItemsResponse struct {
+	Items    []DynamicContentItem `json:"items"`
+	NextPage string               `json:"next_page"`
+	Count    int                  `json:"count"`
+}
+
+// DynamicContentItemResponse represents a single dynamic content item
+// response
+type DynamicContentItemResponse struct {
+	Item DynamicContentItem `json:"item"`
+}
+
+// Locale is a language the account has enabled
+type Locale struct {
+	ID        int64  `json:"id"`
+	Locale    string `json:"locale"`
+	Name      string `json:"name"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// LocalesResponse represents the response from listing locales
+type LocalesResponse struct {
+	Locales []Locale `json:"locales"`
+}
+
+// ListDynamicContentItems retrieves every dynamic content item with its
+// variants. Results are not cached since items are usually listed right
+// after they are edited.
+func (c *Client) ListDynamicContentItems(ctx context.Context) ([]DynamicContentItem, error) {
+	path := "/dynamic_content/items.json"
+
+	var items []DynamicContentItem
+	err := c.forEachPage(ctx, path, path, func(body []byte) error {
+		var page DynamicContentItemsResponse
+		if err := json.Unmarshal(body, &page); err != nil {
+			return fmt.Errorf("failed to decode response: %w", err)
+		}
+		items = append(items, page.Items...)
+		return nil
+	})
+	return items, err
+}
+
+// GetDynamicContentItem retrieves a dynamic content item with its variants
+func (c *Client) GetDynamicContentItem(ctx context.Context, itemID int64) (*DynamicContentItem, error) {
+	body, err := c.getPage(ctx, fmt.Sprintf("/dynamic_content/items/%d.json", itemID))
+	if err != nil {
+		return nil, err
+	}
+
+	var resp DynamicContentItemResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &resp.Item, nil
+}
+
+// CreateDynamicContentItem creates a dynamic content item. It needs a
+// variant for its default locale.
+func (c *Client) CreateDynamicContentItem(ctx context.Context, item DynamicContentItem) (*DynamicContentItem, error) {
+	body, err := json.Marshal(map[string]interface{}{"item": item})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	var resp DynamicContentItemResponse
+	if err := c.do(ctx, http.MethodPost, "/dynamic_content/items.json", body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Item, nil
+}
+
+// ListLocales retrieves the locales enabled for the account
+func (c *Client) ListLocales(ctx context.Context) ([]Locale, error) {
+	cacheKey := fmt.Sprintf("%s:locales:list", c.subdomain)
+
+	var resp LocalesResponse
+	if err := c.getCached(ctx, cacheKey, "/locales.json", &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Locales, nil
+}
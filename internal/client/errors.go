@@ -2,6 +2,7 @@ package client
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 )
@@ -73,6 +74,15 @@ func IsNotFoundError(err error) bool {
 	return false
 }
 
+// IsForbiddenError checks if the error, or an error it wraps, is a 403
+func IsForbiddenError(err error) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == http.StatusForbidden
+	}
+	return false
+}
+
 // getStatusMessage returns a user-friendly message for HTTP status codes
 func getStatusMessage(statusCode int) string {
 	switch statusCode {
@@ -97,31 +107,44 @@ func getStatusMessage(statusCode int) string {
 	}
 }
 
-// FormatUserFriendlyError formats an error with helpful suggestions
-func FormatUserFriendlyError(err error) string {
-	if apiErr, ok := err.(*APIError); ok {
-		msg := fmt.Sprintf("Error: %s", apiErr.Message)
-
-		if apiErr.Description != "" {
-			msg += fmt.Sprintf("\n  %s", apiErr.Description)
-		}
+// Suggestion returns what to try next for an API error, found anywhere in
+// err's chain, or "" if there's nothing to suggest
+func Suggestion(err error) string {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return ""
+	}
 
-		// Add helpful suggestions
-		switch apiErr.StatusCode {
-		case http.StatusUnauthorized:
-			msg += "\n\nSuggestion: Check your credentials with 'zd test'"
-		case http.StatusForbidden:
-			msg += "\n\nSuggestion: You may not have permission for this operation"
-		case http.StatusNotFound:
-			msg += "\n\nSuggestion: Verify the resource ID exists"
-		case http.StatusTooManyRequests:
-			msg += "\n\nSuggestion: You've hit the rate limit. Wait a minute and try again, or use --refresh less frequently"
-		case http.StatusUnprocessableEntity:
-			msg += "\n\nSuggestion: Check your input values and required fields"
-		}
+	switch apiErr.StatusCode {
+	case http.StatusUnauthorized:
+		return "Check your credentials with 'zd test'"
+	case http.StatusForbidden:
+		return "You may not have permission for this operation"
+	case http.StatusNotFound:
+		return "Verify the resource ID exists"
+	case http.StatusTooManyRequests:
+		return "You've hit the rate limit. Wait a minute and try again, or use --refresh less frequently"
+	case http.StatusUnprocessableEntity:
+		return "Check your input values and required fields"
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable:
+		return "Zendesk is experiencing issues. Try again later"
+	}
+	return ""
+}
 
-		return msg
+// FormatUserFriendlyError formats an error with helpful suggestions
+func FormatUserFriendlyError(err error) string {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return err.Error()
 	}
 
-	return err.Error()
+	msg := fmt.Sprintf("Error: %s", apiErr.Message)
+	if apiErr.Description != "" {
+		msg += fmt.Sprintf("\n  %s", apiErr.Description)
+	}
+	if suggestion := Suggestion(apiErr); suggestion != "" {
+		msg += "\n\nSuggestion: " + suggestion
+	}
+	return msg
 }
@@ -0,0 +1,78 @@
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// flightGroup coalesces concurrent identical GET requests so only one of them
+// reaches the API and the rest share its response
+type flightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*flightCall
+}
+
+// flightCall is a request in progress
+type flightCall struct {
+	done   chan struct{}
+	result *flightResult
+	err    error
+}
+
+// flightResult is a buffered response that can be handed to every waiter
+type flightResult struct {
+	statusCode int
+	header     http.Header
+	body       []byte
+}
+
+// do runs fn for key, or waits for the call already running for key and
+// returns its result
+func (g *flightGroup) do(key string, fn func() (*flightResult, error)) (*flightResult, error) {
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = make(map[string]*flightCall)
+	}
+	if call, ok := g.calls[key]; ok {
+		g.mu.Unlock()
+		<-call.done
+		return call.result, call.err
+	}
+
+	call := &flightCall{done: make(chan struct{})}
+	g.calls[key] = call
+	g.mu.Unlock()
+
+	call.result, call.err = fn()
+	close(call.done)
+
+	g.mu.Lock()
+	delete(g.calls, key)
+	g.mu.Unlock()
+
+	return call.result, call.err
+}
+
+// bufferResponse reads and closes a response body so it can be shared
+func bufferResponse(resp *http.Response) (*flightResult, error) {
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	return &flightResult{statusCode: resp.StatusCode, header: resp.Header, body: body}, nil
+}
+
+// response returns a fresh *http.Response for the buffered result
+func (r *flightResult) response() *http.Response {
+	return &http.Response{
+		StatusCode: r.statusCode,
+		Header:     r.header,
+		Body:       io.NopCloser(bytes.NewReader(r.body)),
+	}
+}
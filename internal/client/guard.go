@@ -0,0 +1,56 @@
+package client
+
+import (
+	"net/http"
+)
+
+// WriteGuard decides whether a request that changes data may be sent,
+// returning an error to stop it
+type WriteGuard func(req *http.Request) error
+
+// SetWriteGuard checks every POST, PUT, PATCH, and DELETE with guard before
+// it is sent. Nil lets them all through.
+func (c *Client) SetWriteGuard(guard WriteGuard) {
+	c.guard.check = guard
+}
+
+// guardTransport is an http.RoundTripper that stops requests its check
+// refuses. It wraps the timeout, so time spent confirming a write doesn't
+// count against the request.
+type guardTransport struct {
+	base  http.RoundTripper
+	check WriteGuard
+}
+
+// RoundTrip sends the request unless it changes data and the check refuses it
+func (t *guardTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.check != nil && !readOnlyMethod(req.Method) {
+		if err := t.check(req); err != nil {
+			return nil, &refusedError{err: err}
+		}
+	}
+	return t.base.RoundTrip(req)
+}
+
+// readOnlyMethod reports whether requests with method never change data
+func readOnlyMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	return false
+}
+
+// refusedError is a write the guard stopped. doRequest returns the guard's
+// error as it is, without the URL and "request failed" around it.
+type refusedError struct {
+	err error
+}
+
+func (e *refusedError) Error() string {
+	return e.err.Error()
+}
+
+func (e *refusedError) Unwrap() error {
+	return e.err
+}
@@ -0,0 +1,256 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Article represents a Help Center article in one locale
+type Article struct {
+	ID                int64    `json:"id"`
+	URL               string   `json:"url"`
+	HTMLURL           string   `json:"html_url"`
+	Title             string   `json:"title"`
+	Body              string   `json:"body"`
+	Locale            string   `json:"locale"`
+	SourceLocale      string   `json:"source_locale"`
+	SectionID         int64    `json:"section_id"`
+	AuthorID          int64    `json:"author_id"`
+	PermissionGroupID int64    `json:"permission_group_id"`
+	UserSegmentID     *int64   `json:"user_segment_id"`
+	Draft             bool     `json:"draft"`
+	Promoted          bool     `json:"promoted"`
+	Position          int      `json:"position"`
+	VoteSum           int      `json:"vote_sum"`
+	LabelNames        []string `json:"label_names"`
+	CreatedAt         string   `json:"created_at"`
+	UpdatedAt         string   `json:"updated_at"`
+	EditedAt          string   `json:"edited_at"`
+}
+
+// ArticlesResponse represents the response from listing or searching articles
+type ArticlesResponse struct {
+	Articles  []Article `json:"articles"`
+	Results   []Article `json:"results"` // Search responses list articles here
+	NextPage  string    `json:"next_page"`
+	Count     int       `json:"count"`
+	PageCount int       `json:"page_count"`
+}
+
+// ArticleResponse represents a single article response
+type ArticleResponse struct {
+	Article Article `json:"article"`
+}
+
+// ArticleListOptions narrows an article list or search
+type ArticleListOptions struct {
+	SectionID  int64
+	CategoryID int64
+	Locale     string // Empty for the default locale
+	Page       int
+	PerPage    int
+}
+
+// CreateArticleRequest represents a request to create an article
+type CreateArticleRequest struct {
+	Title             string   `json:"title"`
+	Body              string   `json:"body"`
+	Locale            string   `json:"locale"`
+	PermissionGroupID int64    `json:"permission_group_id"`
+	UserSegmentID     *int64   `json:"user_segment_id"` // nil for everyone
+	Draft             bool     `json:"draft,omitempty"`
+	Promoted          bool     `json:"promoted,omitempty"`
+	LabelNames        []string `json:"label_names,omitempty"`
+}
+
+// UpdateArticleRequest changes an article's settings, which are shared by
+// all its translations
+type UpdateArticleRequest struct {
+	SectionID         *int64    `json:"section_id,omitempty"`
+	PermissionGroupID *int64    `json:"permission_group_id,omitempty"`
+	Promoted          *bool     `json:"promoted,omitempty"`
+	LabelNames        *[]string `json:"label_names,omitempty"`
+}
+
+// UpdateTranslationRequest changes the title, body, or draft state of an
+// article in one locale
+type UpdateTranslationRequest struct {
+	Title string `json:"title,omitempty"`
+	Body  string `json:"body,omitempty"`
+	Draft *bool  `json:"draft,omitempty"`
+}
+
+// PermissionGroup represents a Guide permission group, which decides who can
+// edit and publish articles
+type PermissionGroup struct {
+	ID      int64   `json:"id"`
+	Name    string  `json:"name"`
+	BuiltIn bool    `json:"built_in"`
+	Edit    []int64 `json:"edit"`
+	Publish []int64 `json:"publish"`
+}
+
+// helpCenterPath returns a Help Center API path, prefixed with locale when set
+func helpCenterPath(locale, path string) string {
+	if locale != "" {
+		return "/help_center/" + url.PathEscape(locale) + path
+	}
+	return "/help_center" + path
+}
+
+// ListArticles retrieves a page of articles, optionally only those in one
+// section or category
+func (c *Client) ListArticles(ctx context.Context, opts ArticleListOptions) (*ArticlesResponse, error) {
+	path := "/articles.json"
+	switch {
+	case opts.SectionID != 0:
+		path = fmt.Sprintf("/sections/%d/articles.json", opts.SectionID)
+	case opts.CategoryID != 0:
+		path = fmt.Sprintf("/categories/%d/articles.json", opts.CategoryID)
+	}
+	path = helpCenterPath(opts.Locale, path) + fmt.Sprintf("?page=%d&per_page=%d", opts.Page, opts.PerPage)
+
+	var resp ArticlesResponse
+	if err := c.getCached(ctx, c.subdomain+":help_center:articles:list:"+path, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListAllArticles retrieves every article in locale, or in the default locale
+// when locale is empty, calling fn with each page of articles in order
+func (c *Client) ListAllArticles(ctx context.Context, locale string, fn func([]Article) error) error {
+	path := helpCenterPath(locale, "/articles.json")
+
+	return c.forEachPage(ctx, path, path, func(body []byte) error {
+		var page ArticlesResponse
+		if err := json.Unmarshal(body, &page); err != nil {
+			return fmt.Errorf("failed to decode response: %w", err)
+		}
+		return fn(page.Articles)
+	})
+}
+
+// SearchArticles searches articles by text, optionally within one section or
+// category
+func (c *Client) SearchArticles(ctx context.Context, query string, opts ArticleListOptions) (*ArticlesResponse, error) {
+	params := url.Values{}
+	params.Set("query", query)
+	params.Set("page", fmt.Sprint(opts.Page))
+	params.Set("per_page", fmt.Sprint(opts.PerPage))
+	if opts.Locale != "" {
+		params.Set("locale", opts.Locale)
+	}
+	if opts.SectionID != 0 {
+		params.Set("section", fmt.Sprint(opts.SectionID))
+	}
+	if opts.CategoryID != 0 {
+		params.Set("category", fmt.Sprint(opts.CategoryID))
+	}
+
+	body, err := c.getPage(ctx, "/help_center/articles/search.json?"+params.Encode())
+	if err != nil {
+		return nil, err
+	}
+
+	var resp ArticlesResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	resp.Articles = resp.Results
+	resp.Results = nil
+
+	return &resp, nil
+}
+
+// GetArticle retrieves an article in locale, or in the default locale when
+// locale is empty
+func (c *Client) GetArticle(ctx context.Context, articleID int64, locale string) (*Article, error) {
+	path := helpCenterPath(locale, fmt.Sprintf("/articles/%d.json", articleID))
+
+	var resp ArticleResponse
+	if err := c.getCached(ctx, articleCacheKey(c.subdomain, articleID, locale), path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Article, nil
+}
+
+// CreateArticle creates an article in a section. Subscribers of the section
+// are not notified.
+func (c *Client) CreateArticle(ctx context.Context, sectionID int64, req CreateArticleRequest) (*Article, error) {
+	body, err := json.Marshal(map[string]interface{}{
+		"article":            req,
+		"notify_subscribers": false,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	var resp ArticleResponse
+	path := helpCenterPath(req.Locale, fmt.Sprintf("/sections/%d/articles.json", sectionID))
+	if err := c.do(ctx, http.MethodPost, path, body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Article, nil
+}
+
+// UpdateArticle changes an article's settings
+func (c *Client) UpdateArticle(ctx context.Context, articleID int64, req UpdateArticleRequest) (*Article, error) {
+	body, err := json.Marshal(map[string]interface{}{"article": req})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	var resp ArticleResponse
+	path := fmt.Sprintf("/help_center/articles/%d.json", articleID)
+	if err := c.do(ctx, http.MethodPut, path, body, &resp); err != nil {
+		return nil, err
+	}
+
+	c.invalidateArticle(articleID, "")
+	return &resp.Article, nil
+}
+
+// UpdateArticleTranslation changes an article's title, body, or draft state
+// in one locale
+func (c *Client) UpdateArticleTranslation(ctx context.Context, articleID int64, locale string, req UpdateTranslationRequest) error {
+	body, err := json.Marshal(map[string]interface{}{"translation": req})
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	path := fmt.Sprintf("/help_center/articles/%d/translations/%s.json", articleID, url.PathEscape(locale))
+	if err := c.do(ctx, http.MethodPut, path, body, nil); err != nil {
+		return err
+	}
+
+	c.invalidateArticle(articleID, locale)
+	return nil
+}
+
+// ListPermissionGroups retrieves the Guide permission groups
+func (c *Client) ListPermissionGroups(ctx context.Context) ([]PermissionGroup, error) {
+	var resp struct {
+		PermissionGroups []PermissionGroup `json:"permission_groups"`
+	}
+	if err := c.getCached(ctx, c.subdomain+":help_center:permission_groups", "/guide/permission_groups.json?per_page=100", &resp); err != nil {
+		return nil, err
+	}
+	return resp.PermissionGroups, nil
+}
+
+func articleCacheKey(subdomain string, articleID int64, locale string) string {
+	return fmt.Sprintf("%s:help_center:articles:%d:%s", subdomain, articleID, locale)
+}
+
+// invalidateArticle drops a changed article from the cache, in the default
+// locale and in locale
+func (c *Client) invalidateArticle(articleID int64, locale string) {
+	if c.cache != nil {
+		c.cache.Delete(articleCacheKey(c.subdomain, articleID, ""))
+		c.cache.Delete(articleCacheKey(c.subdomain, articleID, locale))
+	}
+}
@@ -0,0 +1,207 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Category represents a Help Center category, the top level of the
+// knowledge base
+type Category struct {
+	ID           int64  `json:"id"`
+	URL          string `json:"url"`
+	HTMLURL      string `json:"html_url"`
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	Locale       string `json:"locale"`
+	SourceLocale string `json:"source_locale"`
+	Position     int    `json:"position"`
+	Outdated     bool   `json:"outdated"`
+	CreatedAt    string `json:"created_at"`
+	UpdatedAt    string `json:"updated_at"`
+}
+
+// CategoriesResponse represents the response from listing categories
+type CategoriesResponse struct {
+	Categories []Category `json:"categories"`
+	NextPage   string     `json:"next_page"`
+	Count      int        `json:"count"`
+}
+
+// CategoryResponse represents a single category response
+type CategoryResponse struct {
+	Category Category `json:"category"`
+}
+
+// Section represents a Help Center section, which groups articles within a
+// category or within a parent section
+type Section struct {
+	ID              int64  `json:"id"`
+	URL             string `json:"url"`
+	HTMLURL         string `json:"html_url"`
+	Name            string `json:"name"`
+	Description     string `json:"description"`
+	Locale          string `json:"locale"`
+	SourceLocale    string `json:"source_locale"`
+	CategoryID      int64  `json:"category_id"`
+	ParentSectionID *int64 `json:"parent_section_id"`
+	Position        int    `json:"position"`
+	Sorting         string `json:"sorting"`
+	Outdated        bool   `json:"outdated"`
+	CreatedAt       string `json:"created_at"`
+	UpdatedAt       string `json:"updated_at"`
+}
+
+// SectionsResponse represents the response from listing sections
+type SectionsResponse struct {
+	Sections []Section `json:"sections"`
+	NextPage string    `json:"next_page"`
+	Count    int       `json:"count"`
+}
+
+// SectionResponse represents a single section response
+type SectionResponse struct {
+	Section Section `json:"section"`
+}
+
+// CreateCategoryRequest represents a request to create a category
+type CreateCategoryRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Locale      string `json:"locale"`
+	Position    *int   `json:"position,omitempty"`
+}
+
+// CreateSectionRequest represents a request to create a section
+type CreateSectionRequest struct {
+	Name            string `json:"name"`
+	Description     string `json:"description,omitempty"`
+	Locale          string `json:"locale"`
+	ParentSectionID *int64 `json:"parent_section_id,omitempty"`
+	Position        *int   `json:"position,omitempty"`
+}
+
+// ListCategories retrieves a page of categories in locale, or in the default
+// locale when locale is empty
+func (c *Client) ListCategories(ctx context.Context, locale string, page int, perPage int) (*CategoriesResponse, error) {
+	path := helpCenterPath(locale, fmt.Sprintf("/categories.json?page=%d&per_page=%d", page, perPage))
+
+	var resp CategoriesResponse
+	if err := c.getCached(ctx, c.subdomain+":help_center:categories:list:"+path, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListAllCategories retrieves every category in locale, or in the default
+// locale when locale is empty
+func (c *Client) ListAllCategories(ctx context.Context, locale string) ([]Category, error) {
+	path := helpCenterPath(locale, "/categories.json")
+
+	var categories []Category
+	err := c.forEachPage(ctx, path, path, func(body []byte) error {
+		var page CategoriesResponse
+		if err := json.Unmarshal(body, &page); err != nil {
+			return fmt.Errorf("failed to decode response: %w", err)
+		}
+		categories = append(categories, page.Categories...)
+		return nil
+	})
+	return categories, err
+}
+
+// GetCategory retrieves a category in locale, or in the default locale when
+// locale is empty
+func (c *Client) GetCategory(ctx context.Context, categoryID int64, locale string) (*Category, error) {
+	path := helpCenterPath(locale, fmt.Sprintf("/categories/%d.json", categoryID))
+
+	var resp CategoryResponse
+	if err := c.getCached(ctx, fmt.Sprintf("%s:help_center:categories:%d:%s", c.subdomain, categoryID, locale), path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Category, nil
+}
+
+// CreateCategory creates a category
+func (c *Client) CreateCategory(ctx context.Context, req CreateCategoryRequest) (*Category, error) {
+	body, err := json.Marshal(map[string]interface{}{"category": req})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	var resp CategoryResponse
+	if err := c.do(ctx, http.MethodPost, helpCenterPath(req.Locale, "/categories.json"), body, &resp); err != nil {
+		return nil, err
+	}
+
+	if c.cache != nil {
+		c.cache.Invalidate(c.subdomain + ":help_center:categories:list:*")
+	}
+	return &resp.Category, nil
+}
+
+// ListSections retrieves a page of sections, optionally only those in one
+// category, in locale or in the default locale when locale is empty
+func (c *Client) ListSections(ctx context.Context, categoryID int64, locale string, page int, perPage int) (*SectionsResponse, error) {
+	path := "/sections.json"
+	if categoryID != 0 {
+		path = fmt.Sprintf("/categories/%d/sections.json", categoryID)
+	}
+	path = helpCenterPath(locale, path) + fmt.Sprintf("?page=%d&per_page=%d", page, perPage)
+
+	var resp SectionsResponse
+	if err := c.getCached(ctx, c.subdomain+":help_center:sections:list:"+path, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListAllSections retrieves every section in locale, or in the default
+// locale when locale is empty
+func (c *Client) ListAllSections(ctx context.Context, locale string) ([]Section, error) {
+	path := helpCenterPath(locale, "/sections.json")
+
+	var sections []Section
+	err := c.forEachPage(ctx, path, path, func(body []byte) error {
+		var page SectionsResponse
+		if err := json.Unmarshal(body, &page); err != nil {
+			return fmt.Errorf("failed to decode response: %w", err)
+		}
+		sections = append(sections, page.Sections...)
+		return nil
+	})
+	return sections, err
+}
+
+// GetSection retrieves a section in locale, or in the default locale when
+// locale is empty
+func (c *Client) GetSection(ctx context.Context, sectionID int64, locale string) (*Section, error) {
+	path := helpCenterPath(locale, fmt.Sprintf("/sections/%d.json", sectionID))
+
+	var resp SectionResponse
+	if err := c.getCached(ctx, fmt.Sprintf("%s:help_center:sections:%d:%s", c.subdomain, sectionID, locale), path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Section, nil
+}
+
+// CreateSection creates a section in a category
+func (c *Client) CreateSection(ctx context.Context, categoryID int64, req CreateSectionRequest) (*Section, error) {
+	body, err := json.Marshal(map[string]interface{}{"section": req})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	var resp SectionResponse
+	path := helpCenterPath(req.Locale, fmt.Sprintf("/categories/%d/sections.json", categoryID))
+	if err := c.do(ctx, http.MethodPost, path, body, &resp); err != nil {
+		return nil, err
+	}
+
+	if c.cache != nil {
+		c.cache.Invalidate(c.subdomain + ":help_center:sections:list:*")
+	}
+	return &resp.Section, nil
+}
@@ -0,0 +1,52 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// IncrementalTicketsResponse represents a page of the cursor-based
+// incremental ticket export
+type IncrementalTicketsResponse struct {
+	Tickets      []Ticket       `json:"tickets"`
+	MetricSets   []TicketMetric `json:"metric_sets"`
+	AfterCursor  string         `json:"after_cursor"`
+	BeforeCursor string         `json:"before_cursor"`
+	AfterURL     string         `json:"after_url"`
+	EndOfStream  bool           `json:"end_of_stream"`
+}
+
+// ExportTickets retrieves one page of the incremental ticket export.
+// The first page is requested with startTime (Unix seconds); subsequent pages
+// are requested with the AfterCursor of the previous page. Exports are never
+// cached since they are used to track changes.
+func (c *Client) ExportTickets(ctx context.Context, startTime int64, cursor string) (*IncrementalTicketsResponse, error) {
+	return c.exportTickets(ctx, startTime, cursor, "")
+}
+
+// ExportTicketsWithMetrics is ExportTickets with each page's ticket metrics
+// sideloaded in MetricSets
+func (c *Client) ExportTicketsWithMetrics(ctx context.Context, startTime int64, cursor string) (*IncrementalTicketsResponse, error) {
+	return c.exportTickets(ctx, startTime, cursor, "metric_sets")
+}
+
+func (c *Client) exportTickets(ctx context.Context, startTime int64, cursor string, include string) (*IncrementalTicketsResponse, error) {
+	path := "/incremental/tickets/cursor.json"
+	if cursor != "" {
+		path += "?cursor=" + url.QueryEscape(cursor)
+	} else {
+		path += fmt.Sprintf("?start_time=%d", startTime)
+	}
+	if include != "" {
+		path += "&include=" + include
+	}
+
+	var resp IncrementalTicketsResponse
+	if err := c.do(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
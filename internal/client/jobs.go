@@ -0,0 +1,96 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// JobPollInterval is how often WaitForJob checks on a running job
+const JobPollInterval = 2 * time.Second
+
+// JobStatus represents a Zendesk background job
+type JobStatus struct {
+	ID       string            `json:"id"`
+	URL      string            `json:"url"`
+	Total    int               `json:"total"`
+	Progress int               `json:"progress"`
+	Status   string            `json:"status"`
+	Message  string            `json:"message"`
+	Results  []JobStatusResult `json:"results"`
+}
+
+// JobStatusResult represents the outcome for a single item in a job
+type JobStatusResult struct {
+	ID      int64  `json:"id"`
+	Index   int    `json:"index"`
+	Action  string `json:"action"`
+	Success bool   `json:"success"`
+	Status  string `json:"status"`
+	Error   string `json:"error"`
+	Details string `json:"details"`
+}
+
+// JobStatusResponse represents a single job status response
+type JobStatusResponse struct {
+	JobStatus JobStatus `json:"job_status"`
+}
+
+// IsFinished returns true once the job has stopped running
+func (j *JobStatus) IsFinished() bool {
+	switch j.Status {
+	case "completed", "failed", "killed":
+		return true
+	default:
+		return false
+	}
+}
+
+// GetJobStatus retrieves the current status of a background job.
+// Job statuses are never cached since they change while the job runs.
+func (c *Client) GetJobStatus(ctx context.Context, jobID string) (*JobStatus, error) {
+	var resp JobStatusResponse
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("/job_statuses/%s.json", jobID), nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp.JobStatus, nil
+}
+
+// WaitForJob polls a background job until it finishes or ctx expires,
+// returning its final status. progress, if not nil, is called with each
+// status seen, including the first, so callers can show how far along the
+// job is.
+func (c *Client) WaitForJob(ctx context.Context, job *JobStatus, progress func(*JobStatus)) (*JobStatus, error) {
+	if progress != nil {
+		progress(job)
+	}
+
+	for !job.IsFinished() {
+		if err := sleep(ctx, JobPollInterval); err != nil {
+			return nil, err
+		}
+
+		latest, err := c.GetJobStatus(ctx, job.ID)
+		if err != nil {
+			return nil, err
+		}
+		job = latest
+
+		if progress != nil {
+			progress(job)
+		}
+	}
+
+	return job, nil
+}
+
+// makeJobStatusRequest makes a request that returns a job status
+func (c *Client) makeJobStatusRequest(ctx context.Context, method, path string, body []byte) (*JobStatus, error) {
+	var jobResp JobStatusResponse
+	if err := c.do(ctx, method, path, body, &jobResp); err != nil {
+		return nil, err
+	}
+	return &jobResp.JobStatus, nil
+}
@@ -0,0 +1,129 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Macro represents a Zendesk macro
+type Macro struct {
+	ID          int64         `json:"id"`
+	URL         string        `json:"url"`
+	Title       string        `json:"title"`
+	Active      bool          `json:"active"`
+	Description string        `json:"description"`
+	Position    int           `json:"position"`
+	Actions     []MacroAction `json:"actions"`
+	Restriction interface{}   `json:"restriction"`
+	CreatedAt   string        `json:"created_at"`
+	UpdatedAt   string        `json:"updated_at"`
+}
+
+// MacroAction represents a single action performed by a macro
+type MacroAction struct {
+	Field string      `json:"field"`
+	Value interface{} `json:"value"`
+}
+
+// MacrosResponse represents the response from listing macros
+type MacrosResponse struct {
+	Macros       []Macro `json:"macros"`
+	NextPage     string  `json:"next_page"`
+	PreviousPage string  `json:"previous_page"`
+	Count        int     `json:"count"`
+}
+
+// MacroResponse represents a single macro response
+type MacroResponse struct {
+	Macro Macro `json:"macro"`
+}
+
+// MacroResult represents the changes a macro would make to a ticket
+type MacroResult struct {
+	Ticket  map[string]interface{} `json:"ticket"`
+	Comment map[string]interface{} `json:"comment"`
+}
+
+// MacroResultResponse represents the response from the macro apply endpoint
+type MacroResultResponse struct {
+	Result MacroResult `json:"result"`
+}
+
+// ListMacros retrieves a list of macros
+func (c *Client) ListMacros(ctx context.Context, page int, perPage int, activeOnly bool) (*MacrosResponse, error) {
+	cacheKey := fmt.Sprintf("%s:macros:list:%d:%d:%t", c.subdomain, page, perPage, activeOnly)
+	path := fmt.Sprintf("/macros.json?page=%d&per_page=%d", page, perPage)
+	if activeOnly {
+		path += "&active=true"
+	}
+
+	var resp MacrosResponse
+	if err := c.getCached(ctx, cacheKey, path, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetMacro retrieves a specific macro by ID
+func (c *Client) GetMacro(ctx context.Context, macroID int64) (*Macro, error) {
+	return c.Macros().Get(ctx, macroID)
+}
+
+// PreviewMacro returns the changes a macro would make to a ticket without
+// modifying it. Previews are never cached since they depend on ticket state.
+func (c *Client) PreviewMacro(ctx context.Context, macroID int64, ticketID int64) (*MacroResult, error) {
+	path := fmt.Sprintf("/tickets/%d/macros/%d/apply.json", ticketID, macroID)
+
+	var resp MacroResultResponse
+	if err := c.do(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp.Result, nil
+}
+
+// ApplyMacro applies a macro to a ticket. The macro apply endpoint only
+// computes the resulting changes, so they are then saved with a ticket update.
+func (c *Client) ApplyMacro(ctx context.Context, macroID int64, ticketID int64) (*Ticket, error) {
+	result, err := c.PreviewMacro(ctx, macroID, ticketID)
+	if err != nil {
+		return nil, err
+	}
+
+	ticket := make(map[string]interface{})
+	for key, value := range result.Ticket {
+		ticket[key] = value
+	}
+
+	// Only send a comment when the macro actually adds one
+	if comment := macroComment(result.Comment); comment != nil {
+		ticket["comment"] = comment
+	}
+
+	return c.Tickets().Update(ctx, ticketID, ticket)
+}
+
+// macroComment converts a macro result comment into a ticket update comment
+func macroComment(comment map[string]interface{}) map[string]interface{} {
+	if comment == nil {
+		return nil
+	}
+
+	update := make(map[string]interface{})
+	if htmlBody, ok := comment["html_body"].(string); ok && strings.TrimSpace(htmlBody) != "" {
+		update["html_body"] = htmlBody
+	} else if body, ok := comment["body"].(string); ok && strings.TrimSpace(body) != "" {
+		update["body"] = body
+	} else {
+		return nil
+	}
+
+	if public, ok := comment["public"].(bool); ok {
+		update["public"] = public
+	}
+
+	return update
+}
@@ -0,0 +1,39 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// CreateRecord creates a record of res, such as a macro or trigger copied
+// from another instance, from a definition in the API's own format, and
+// returns the created record
+func (c *Client) CreateRecord(ctx context.Context, res ExportResource, definition map[string]interface{}) (map[string]interface{}, error) {
+	if res.Incremental {
+		return nil, fmt.Errorf("%s can't be created from a definition", res.Name)
+	}
+
+	body, err := json.Marshal(map[string]interface{}{res.Key: definition})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	// The record is decoded with UseNumber, so IDs and other numbers in it
+	// are copied exactly
+	var created map[string]json.RawMessage
+	if err := c.do(ctx, http.MethodPost, res.Path, body, &created); err != nil {
+		return nil, err
+	}
+
+	var record map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(created[res.Key]))
+	decoder.UseNumber()
+	if err := decoder.Decode(&record); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return record, nil
+}
@@ -4,8 +4,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
 	"net/url"
 )
 
@@ -43,232 +41,172 @@ type OrganizationResponse struct {
 // ListOrganizations retrieves a list of organizations
 func (c *Client) ListOrganizations(ctx context.Context, page int, perPage int) (*OrganizationsResponse, error) {
 	cacheKey := fmt.Sprintf("%s:organizations:list:%d:%d", c.subdomain, page, perPage)
-
-	// Try cache first
-	if c.useCache && c.cache != nil {
-		if cached, found := c.cache.Get(cacheKey); found {
-			var resp OrganizationsResponse
-			if err := json.Unmarshal(cached, &resp); err == nil {
-				return &resp, nil
-			}
-		}
-	}
-
-	// Build query parameters
 	path := fmt.Sprintf("/organizations.json?page=%d&per_page=%d", page, perPage)
 
-	// Fetch from API
-	resp, err := c.makeRequest(ctx, http.MethodGet, path)
-	if err != nil {
+	var resp OrganizationsResponse
+	if err := c.getCached(ctx, cacheKey, path, &resp); err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to list organizations (status %d): %s", resp.StatusCode, string(body))
-	}
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
-	}
 
-	var orgsResp OrganizationsResponse
-	if err := json.Unmarshal(body, &orgsResp); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
-	}
+	return &resp, nil
+}
 
-	// Cache the result
-	if c.useCache && c.cache != nil {
-		c.cache.Set(cacheKey, body)
-	}
+// ListAllOrganizations retrieves all organizations, calling fn with each page
+// of organizations in order
+func (c *Client) ListAllOrganizations(ctx context.Context, fn func([]Organization) error) error {
+	path := "/organizations.json"
 
-	return &orgsResp, nil
+	return c.forEachPage(ctx, path, path, func(body []byte) error {
+		var page OrganizationsResponse
+		if err := json.Unmarshal(body, &page); err != nil {
+			return fmt.Errorf("failed to decode response: %w", err)
+		}
+		return fn(page.Organizations)
+	})
 }
 
 // GetOrganization retrieves a specific organization by ID
 func (c *Client) GetOrganization(ctx context.Context, orgID int64) (*Organization, error) {
-	cacheKey := fmt.Sprintf("%s:organizations:%d", c.subdomain, orgID)
+	return c.Organizations().Get(ctx, orgID)
+}
+
+// GetOrganizationsByIDs retrieves several organizations at once with
+// /organizations/show_many.json, using and filling the per-organization cache
+func (c *Client) GetOrganizationsByIDs(ctx context.Context, orgIDs []int64) ([]Organization, error) {
+	var orgs []Organization
+	var missing []int64
 
 	// Try cache first
-	if c.useCache && c.cache != nil {
-		if cached, found := c.cache.Get(cacheKey); found {
-			var resp OrganizationResponse
-			if err := json.Unmarshal(cached, &resp); err == nil {
-				return &resp.Organization, nil
+	for _, orgID := range uniqueIDs(orgIDs) {
+		if c.useCache && c.cache != nil {
+			cacheKey := fmt.Sprintf("%s:organizations:%d", c.subdomain, orgID)
+			if cached, found := c.cache.Get(cacheKey); found {
+				var resp OrganizationResponse
+				if err := json.Unmarshal(cached, &resp); err == nil {
+					orgs = append(orgs, resp.Organization)
+					continue
+				}
 			}
 		}
+		missing = append(missing, orgID)
 	}
 
-	// Fetch from API
-	path := fmt.Sprintf("/organizations/%d.json", orgID)
-	resp, err := c.makeRequest(ctx, http.MethodGet, path)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
+	for start := 0; start < len(missing); start += showManyLimit {
+		end := min(start+showManyLimit, len(missing))
 
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to get organization (status %d): %s", resp.StatusCode, string(body))
-	}
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
-	}
+		body, err := c.getPage(ctx, "/organizations/show_many.json?ids="+joinIDs(missing[start:end]))
+		if err != nil {
+			return nil, err
+		}
 
-	var orgResp OrganizationResponse
-	if err := json.Unmarshal(body, &orgResp); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
-	}
+		var orgsResp OrganizationsResponse
+		if err := json.Unmarshal(body, &orgsResp); err != nil {
+			return nil, fmt.Errorf("failed to decode response: %w", err)
+		}
 
-	// Cache the result
-	if c.useCache && c.cache != nil {
-		c.cache.Set(cacheKey, body)
+		for _, org := range orgsResp.Organizations {
+			// Cache each organization under its own key
+			if c.useCache && c.cache != nil {
+				if data, err := json.Marshal(OrganizationResponse{Organization: org}); err == nil {
+					c.cache.Set(fmt.Sprintf("%s:organizations:%d", c.subdomain, org.ID), data)
+				}
+			}
+			orgs = append(orgs, org)
+		}
 	}
 
-	return &orgResp.Organization, nil
+	return orgs, nil
 }
 
 // SearchOrganizations searches for organizations by query
 func (c *Client) SearchOrganizations(ctx context.Context, query string) ([]Organization, error) {
 	cacheKey := fmt.Sprintf("%s:organizations:search:%s", c.subdomain, query)
-
-	// Try cache first
-	if c.useCache && c.cache != nil {
-		if cached, found := c.cache.Get(cacheKey); found {
-			var resp OrganizationsResponse
-			if err := json.Unmarshal(cached, &resp); err == nil {
-				return resp.Organizations, nil
-			}
-		}
-	}
-
 	// Build query parameters - Zendesk requires 'name' parameter for org search
 	path := fmt.Sprintf("/organizations/search.json?name=%s", url.QueryEscape(query))
 
-	// Fetch from API
-	resp, err := c.makeRequest(ctx, http.MethodGet, path)
-	if err != nil {
+	var resp OrganizationsResponse
+	if err := c.getCached(ctx, cacheKey, path, &resp); err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to search organizations (status %d): %s", resp.StatusCode, string(body))
-	}
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
-	}
-
-	var orgsResp OrganizationsResponse
-	if err := json.Unmarshal(body, &orgsResp); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
-	}
-
-	// Cache the result
-	if c.useCache && c.cache != nil {
-		c.cache.Set(cacheKey, body)
-	}
 
-	return orgsResp.Organizations, nil
+	return resp.Organizations, nil
 }
 
 // GetOrganizationUsers retrieves users in an organization
 func (c *Client) GetOrganizationUsers(ctx context.Context, orgID int64, page int, perPage int) (*UsersResponse, error) {
 	cacheKey := fmt.Sprintf("%s:organizations:%d:users:%d:%d", c.subdomain, orgID, page, perPage)
-
-	// Try cache first
-	if c.useCache && c.cache != nil {
-		if cached, found := c.cache.Get(cacheKey); found {
-			var resp UsersResponse
-			if err := json.Unmarshal(cached, &resp); err == nil {
-				return &resp, nil
-			}
-		}
-	}
-
-	// Build query parameters
 	path := fmt.Sprintf("/organizations/%d/users.json?page=%d&per_page=%d", orgID, page, perPage)
 
-	// Fetch from API
-	resp, err := c.makeRequest(ctx, http.MethodGet, path)
-	if err != nil {
+	var resp UsersResponse
+	if err := c.getCached(ctx, cacheKey, path, &resp); err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to get organization users (status %d): %s", resp.StatusCode, string(body))
-	}
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
-	}
-
-	var usersResp UsersResponse
-	if err := json.Unmarshal(body, &usersResp); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
-	}
+	return &resp, nil
+}
 
-	// Cache the result
-	if c.useCache && c.cache != nil {
-		c.cache.Set(cacheKey, body)
-	}
+// ListAllOrganizationUsers retrieves every user in an organization, calling
+// fn with each page of users in order
+func (c *Client) ListAllOrganizationUsers(ctx context.Context, orgID int64, fn func([]User) error) error {
+	path := fmt.Sprintf("/organizations/%d/users.json", orgID)
 
-	return &usersResp, nil
+	return c.forEachPage(ctx, path, path, func(body []byte) error {
+		var page UsersResponse
+		if err := json.Unmarshal(body, &page); err != nil {
+			return fmt.Errorf("failed to decode response: %w", err)
+		}
+		return fn(page.Users)
+	})
 }
 
 // GetOrganizationTickets retrieves tickets for an organization
 func (c *Client) GetOrganizationTickets(ctx context.Context, orgID int64, page int, perPage int) (*TicketsResponse, error) {
 	cacheKey := fmt.Sprintf("%s:organizations:%d:tickets:%d:%d", c.subdomain, orgID, page, perPage)
-
-	// Try cache first
-	if c.useCache && c.cache != nil {
-		if cached, found := c.cache.Get(cacheKey); found {
-			var resp TicketsResponse
-			if err := json.Unmarshal(cached, &resp); err == nil {
-				return &resp, nil
-			}
-		}
-	}
-
-	// Build query parameters
 	path := fmt.Sprintf("/organizations/%d/tickets.json?page=%d&per_page=%d", orgID, page, perPage)
 
-	// Fetch from API
-	resp, err := c.makeRequest(ctx, http.MethodGet, path)
-	if err != nil {
+	var resp TicketsResponse
+	if err := c.getCached(ctx, cacheKey, path, &resp); err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to get organization tickets (status %d): %s", resp.StatusCode, string(body))
-	}
+	return &resp, nil
+}
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
-	}
+// CreateOrganizationRequest represents an organization creation request
+type CreateOrganizationRequest struct {
+	Name           string   `json:"name"`
+	DomainNames    []string `json:"domain_names,omitempty"`
+	Details        string   `json:"details,omitempty"`
+	Notes          string   `json:"notes,omitempty"`
+	GroupID        *int64   `json:"group_id,omitempty"`
+	SharedTickets  *bool    `json:"shared_tickets,omitempty"`
+	SharedComments *bool    `json:"shared_comments,omitempty"`
+	Tags           []string `json:"tags,omitempty"`
+}
 
-	var ticketsResp TicketsResponse
-	if err := json.Unmarshal(body, &ticketsResp); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
-	}
+// UpdateOrganizationRequest represents an organization update request
+type UpdateOrganizationRequest struct {
+	Name           *string   `json:"name,omitempty"`
+	DomainNames    *[]string `json:"domain_names,omitempty"`
+	Details        *string   `json:"details,omitempty"`
+	Notes          *string   `json:"notes,omitempty"`
+	GroupID        *int64    `json:"group_id,omitempty"`
+	SharedTickets  *bool     `json:"shared_tickets,omitempty"`
+	SharedComments *bool     `json:"shared_comments,omitempty"`
+	Tags           *[]string `json:"tags,omitempty"`
+}
 
-	// Cache the result
-	if c.useCache && c.cache != nil {
-		c.cache.Set(cacheKey, body)
-	}
+// CreateOrganization creates a new organization
+func (c *Client) CreateOrganization(ctx context.Context, req CreateOrganizationRequest) (*Organization, error) {
+	return c.Organizations().Create(ctx, req)
+}
+
+// UpdateOrganization updates an existing organization
+func (c *Client) UpdateOrganization(ctx context.Context, orgID int64, req UpdateOrganizationRequest) (*Organization, error) {
+	return c.Organizations().Update(ctx, orgID, req)
+}
 
-	return &ticketsResp, nil
+// DeleteOrganization deletes an organization
+func (c *Client) DeleteOrganization(ctx context.Context, orgID int64) error {
+	return c.Organizations().Delete(ctx, orgID)
 }
@@ -0,0 +1,228 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// DefaultConcurrency is how many pages are fetched at once by default
+const DefaultConcurrency = 4
+
+// offsetPageLimit is how deep offset pagination goes; the API rejects pages
+// past it, so longer lists are walked with cursor pagination instead
+const offsetPageLimit = 100
+
+// MaxSearchResults is the most results the search API returns for one query
+const MaxSearchResults = 1000
+
+// SetConcurrency sets how many pages multi-page operations fetch at once.
+// Values below 1 fetch one page at a time.
+func (c *Client) SetConcurrency(concurrency int) {
+	c.concurrency = max(concurrency, 1)
+}
+
+// OnListTotal sets fn to be called with the total number of items once the
+// first page of a multi-page list or search says how many there are
+func (c *Client) OnListTotal(fn func(total int)) {
+	c.onListTotal = fn
+}
+
+// reportListTotal passes a list's total to the OnListTotal callback, if any
+func (c *Client) reportListTotal(total int) {
+	if c.onListTotal != nil {
+		c.onListTotal(total)
+	}
+}
+
+// getPage fetches one page of a list and returns its body. Pages are never cached.
+func (c *Client) getPage(ctx context.Context, path string) ([]byte, error) {
+	resp, err := c.makeRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, ParseAPIError(resp.StatusCode, body)
+	}
+
+	return body, nil
+}
+
+// forEachPage fetches every page of a list, calling fn with the body of each
+// page in order. Lists short enough for offset pagination are fetched through
+// offsetPath several pages at a time; longer lists are walked one page at a
+// time through cursorPath.
+func (c *Client) forEachPage(ctx context.Context, offsetPath, cursorPath string, fn func(body []byte) error) error {
+	first, err := c.getPage(ctx, pagePath(offsetPath, 1))
+	if err != nil {
+		return err
+	}
+
+	var meta struct {
+		Count int `json:"count"`
+	}
+	if err := json.Unmarshal(first, &meta); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	c.reportListTotal(meta.Count)
+
+	pages := (meta.Count + cursorPageSize - 1) / cursorPageSize
+	if pages > offsetPageLimit {
+		return c.forEachCursorPage(ctx, cursorPath, fn)
+	}
+
+	if err := fn(first); err != nil {
+		return err
+	}
+
+	get := func(ctx context.Context, page int) ([]byte, error) {
+		return c.getPage(ctx, pagePath(offsetPath, page))
+	}
+	return fetchPages(ctx, c.concurrency, 2, pages, get, fn)
+}
+
+// pagePath adds offset pagination params for page to path
+func pagePath(path string, page int) string {
+	sep := "?"
+	if strings.Contains(path, "?") {
+		sep = "&"
+	}
+	return fmt.Sprintf("%s%spage=%d&per_page=%d", path, sep, page, cursorPageSize)
+}
+
+// fetchPages calls get for pages first through last with up to workers
+// requests in flight, and calls fn with each page in order as soon as it and
+// every page before it have arrived. The first error stops the fetch.
+func fetchPages[T any](ctx context.Context, workers, first, last int, get func(ctx context.Context, page int) (T, error), fn func(T) error) error {
+	if last < first {
+		return nil
+	}
+
+	type result struct {
+		page T
+		err  error
+	}
+
+	// One buffered slot per page, so workers never block handing off a page
+	results := make([]chan result, last-first+1)
+	for i := range results {
+		results[i] = make(chan result, 1)
+	}
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pages := make(chan int)
+	go func() {
+		defer close(pages)
+		for page := first; page <= last; page++ {
+			select {
+			case pages <- page:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	for range min(max(workers, 1), len(results)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for page := range pages {
+				p, err := get(ctx, page)
+				results[page-first] <- result{page: p, err: err}
+			}
+		}()
+	}
+
+	for _, ch := range results {
+		var r result
+		select {
+		case r = <-ch:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		if r.err != nil {
+			return r.err
+		}
+		if err := fn(r.page); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// searchPages returns how many pages of perPage results a search with count
+// results has, up to the search API's result limit
+func searchPages(count, perPage int) int {
+	if perPage <= 0 {
+		perPage = 100
+	}
+	return (min(count, MaxSearchResults) + perPage - 1) / perPage
+}
+
+// SearchAll runs a query against the unified search API and calls fn with
+// each page of results in order, fetching several pages at once. It returns
+// the total number of matches, which may exceed what the API returns.
+func (c *Client) SearchAll(ctx context.Context, query string, opts SearchOptions, fn func([]SearchResult) error) (int, error) {
+	opts.Page = 1
+	first, err := c.Search(ctx, query, opts)
+	if err != nil {
+		return 0, err
+	}
+	c.reportListTotal(min(first.Count, MaxSearchResults))
+	if err := fn(first.Results); err != nil {
+		return first.Count, err
+	}
+
+	get := func(ctx context.Context, page int) ([]SearchResult, error) {
+		pageOpts := opts
+		pageOpts.Page = page
+		resp, err := c.Search(ctx, query, pageOpts)
+		if err != nil {
+			return nil, err
+		}
+		return resp.Results, nil
+	}
+	return first.Count, fetchPages(ctx, c.concurrency, 2, searchPages(first.Count, opts.PerPage), get, fn)
+}
+
+// SearchAllTickets searches for tickets by query and calls fn with each page
+// of tickets in order, fetching several pages at once
+func (c *Client) SearchAllTickets(ctx context.Context, query string, opts SearchOptions, fn func([]Ticket) error) error {
+	opts.Page = 1
+	first, err := c.SearchTickets(ctx, query, opts)
+	if err != nil {
+		return err
+	}
+	c.reportListTotal(min(first.Count, MaxSearchResults))
+	if err := fn(first.Results); err != nil {
+		return err
+	}
+
+	get := func(ctx context.Context, page int) ([]Ticket, error) {
+		pageOpts := opts
+		pageOpts.Page = page
+		resp, err := c.SearchTickets(ctx, query, pageOpts)
+		if err != nil {
+			return nil, err
+		}
+		return resp.Results, nil
+	}
+	return fetchPages(ctx, c.concurrency, 2, searchPages(first.Count, opts.PerPage), get, fn)
+}
@@ -0,0 +1,162 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// lowBudgetFraction is the share of the rate limit below which requests are
+// spaced out, so bulk operations don't run into 429s
+const lowBudgetFraction = 0.1
+
+// RateLimit is the API request budget reported by Zendesk response headers
+type RateLimit struct {
+	Limit      int
+	Remaining  int
+	Reset      time.Duration // Zero when the API doesn't say
+	ObservedAt time.Time
+}
+
+// Used returns how many requests of the budget have been made
+func (r RateLimit) Used() int {
+	return r.Limit - r.Remaining
+}
+
+// Low reports whether the remaining budget is nearly spent
+func (r RateLimit) Low() bool {
+	return r.Limit > 0 && float64(r.Remaining) < float64(r.Limit)*lowBudgetFraction
+}
+
+// throttleDelay returns how long to wait before the next request so the
+// remaining budget lasts until the window resets
+func (r RateLimit) throttleDelay() time.Duration {
+	window := r.Reset
+	if window <= 0 {
+		window = time.Minute
+	}
+	// The window has been running since the headers were seen
+	window -= time.Since(r.ObservedAt)
+	if window <= 0 {
+		return 0
+	}
+	return window / time.Duration(r.Remaining+1)
+}
+
+// String formats the budget for messages, e.g. "695/700 requests remaining"
+func (r RateLimit) String() string {
+	s := fmt.Sprintf("%d/%d requests remaining", r.Remaining, r.Limit)
+	if r.Reset > 0 {
+		s += fmt.Sprintf(", resets in %s", r.Reset)
+	}
+	return s
+}
+
+// rateLimits remembers the most recent budget seen by any client in this run
+var rateLimits struct {
+	sync.Mutex
+	last      RateLimit
+	seen      bool
+	throttled bool
+}
+
+// LastRateLimit returns the budget reported by the most recent API response
+// in this run, and false if no response carried rate limit headers
+func LastRateLimit() (RateLimit, bool) {
+	rateLimits.Lock()
+	defer rateLimits.Unlock()
+	return rateLimits.last, rateLimits.seen
+}
+
+// parseRateLimit reads the X-Rate-Limit headers of a response
+func parseRateLimit(header http.Header) (RateLimit, bool) {
+	limit, err := strconv.Atoi(header.Get("X-Rate-Limit"))
+	if err != nil {
+		return RateLimit{}, false
+	}
+	remaining, err := strconv.Atoi(header.Get("X-Rate-Limit-Remaining"))
+	if err != nil {
+		return RateLimit{}, false
+	}
+
+	rl := RateLimit{Limit: limit, Remaining: remaining, ObservedAt: time.Now()}
+	if reset, err := strconv.Atoi(header.Get("Ratelimit-Reset")); err == nil {
+		rl.Reset = time.Duration(reset) * time.Second
+	}
+	return rl, true
+}
+
+// rateLimitTransport is an http.RoundTripper that records the rate limit
+// headers of every response, and spaces requests out while the budget is low
+type rateLimitTransport struct {
+	base http.RoundTripper
+}
+
+// RoundTrip waits if the budget is low, then sends the request
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := throttle(req.Context()); err != nil {
+		return nil, err
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if rl, ok := parseRateLimit(resp.Header); ok {
+		rateLimits.Lock()
+		rateLimits.last = rl
+		rateLimits.seen = true
+		rateLimits.Unlock()
+	}
+
+	return resp, nil
+}
+
+// throttle waits before a request while the remaining budget is low
+func throttle(ctx context.Context) error {
+	rateLimits.Lock()
+	rl, seen := rateLimits.last, rateLimits.seen
+	warn := seen && rl.Low() && !rateLimits.throttled
+	if warn {
+		rateLimits.throttled = true
+	}
+	rateLimits.Unlock()
+
+	if !seen || !rl.Low() {
+		return nil
+	}
+
+	if warn {
+		fmt.Fprintf(os.Stderr, "Rate limit budget low (%s). Slowing down...\n", rl)
+	}
+
+	return sleep(ctx, rl.throttleDelay())
+}
+
+// GetRateLimit asks the API for the current request budget. The request
+// bypasses the cache, since cached responses don't carry headers.
+func (c *Client) GetRateLimit(ctx context.Context) (*RateLimit, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, "/users/me.json", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, ParseAPIError(resp.StatusCode, body)
+	}
+
+	rl, ok := parseRateLimit(resp.Header)
+	if !ok {
+		return nil, fmt.Errorf("response had no rate limit headers")
+	}
+
+	return &rl, nil
+}
@@ -0,0 +1,133 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// RawResponse is the status, headers, and body of a raw API request
+type RawResponse struct {
+	StatusCode int
+	Status     string
+	Header     http.Header
+	Body       []byte
+}
+
+// RawRequest sends an arbitrary authenticated request to the instance and
+// returns the response as is. path is relative to /api/v2 ("tickets.json"),
+// absolute ("/api/v2/tickets.json"), or a full URL on the instance. Responses
+// are never cached.
+func (c *Client) RawRequest(ctx context.Context, method, path string, body []byte) (*RawResponse, error) {
+	apiPath, err := c.apiPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.GetBaseURL()+apiPath, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	if body != nil {
+		setBody(req, body)
+	}
+
+	req.Header.Set("Authorization", c.authHeader)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	return &RawResponse{
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		Header:     resp.Header,
+		Body:       respBody,
+	}, nil
+}
+
+// RawPages sends GET requests for path and every following page, calling fn
+// with each response. Both offset (next_page) and cursor (links.next)
+// pagination are followed. Error responses stop paging and are returned as
+// API errors.
+func (c *Client) RawPages(ctx context.Context, path string, fn func(*RawResponse) error) error {
+	next := path
+	for next != "" {
+		resp, err := c.RawRequest(ctx, http.MethodGet, next, nil)
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			return ParseAPIError(resp.StatusCode, resp.Body)
+		}
+
+		if err := fn(resp); err != nil {
+			return err
+		}
+
+		next = nextPageURL(resp.Body)
+	}
+	return nil
+}
+
+// nextPageURL returns the URL of the page after body, or "" on the last page
+func nextPageURL(body []byte) string {
+	var page struct {
+		NextPage *string `json:"next_page"`
+		Meta     *struct {
+			HasMore bool `json:"has_more"`
+		} `json:"meta"`
+		Links *struct {
+			Next string `json:"next"`
+		} `json:"links"`
+	}
+	if err := json.Unmarshal(body, &page); err != nil {
+		return ""
+	}
+
+	if page.Meta != nil && page.Links != nil {
+		if !page.Meta.HasMore {
+			return ""
+		}
+		return page.Links.Next
+	}
+	if page.NextPage != nil {
+		return *page.NextPage
+	}
+	return ""
+}
+
+// apiPath turns a path or URL given by the user into a path relative to the
+// base API URL, refusing URLs on other hosts so credentials stay on the instance
+func (c *Client) apiPath(path string) (string, error) {
+	if strings.HasPrefix(path, "http://") || strings.HasPrefix(path, "https://") {
+		u, err := url.Parse(path)
+		if err != nil {
+			return "", fmt.Errorf("invalid URL %q: %w", path, err)
+		}
+		base, _ := url.Parse(c.GetBaseURL())
+		if u.Host != base.Host {
+			return "", fmt.Errorf("URL %q is not on this instance (%s)", path, base.Host)
+		}
+		path = u.RequestURI()
+	}
+
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	return strings.TrimPrefix(path, "/api/v2"), nil
+}
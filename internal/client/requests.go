@@ -0,0 +1,167 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Request is a ticket as its requester or a CC sees it. The requests
+// endpoints work with end-user and light agent tokens that can't use the
+// tickets endpoints, and only show tickets the token's user is part of.
+type Request struct {
+	ID              int64   `json:"id"`
+	URL             string  `json:"url"`
+	Subject         string  `json:"subject"`
+	Description     string  `json:"description"`
+	Status          string  `json:"status"`
+	Priority        string  `json:"priority"`
+	Type            string  `json:"type"`
+	RequesterID     int64   `json:"requester_id"`
+	AssigneeID      *int64  `json:"assignee_id"`
+	OrganizationID  *int64  `json:"organization_id"`
+	GroupID         *int64  `json:"group_id"`
+	CollaboratorIDs []int64 `json:"collaborator_ids"`
+	DueAt           *string `json:"due_at"`
+	CanBeSolvedByMe bool    `json:"can_be_solved_by_me"`
+	Solved          bool    `json:"solved"`
+	CreatedAt       string  `json:"created_at"`
+	UpdatedAt       string  `json:"updated_at"`
+}
+
+// RequestsResponse represents the response from listing requests
+type RequestsResponse struct {
+	Requests     []Request `json:"requests"`
+	NextPage     string    `json:"next_page"`
+	PreviousPage string    `json:"previous_page"`
+	Count        int       `json:"count"`
+}
+
+// RequestResponse represents a single request response
+type RequestResponse struct {
+	Request Request `json:"request"`
+}
+
+// RequestCommentsResponse represents the response from listing a request's
+// comments. The comment authors are always sideloaded.
+type RequestCommentsResponse struct {
+	Comments []Comment `json:"comments"`
+	Users    []User    `json:"users"`
+}
+
+// CreateRequestRequest represents a request creation request
+type CreateRequestRequest struct {
+	Subject  string         `json:"subject"`
+	Comment  RequestComment `json:"comment"`
+	Priority string         `json:"priority,omitempty"`
+	Type     string         `json:"type,omitempty"`
+}
+
+// RequestComment represents a comment added to a request. Comments made
+// through requests are always public.
+type RequestComment struct {
+	Body    string   `json:"body"`
+	Uploads []string `json:"uploads,omitempty"`
+}
+
+// ListRequests retrieves the requests of the authenticated user, most
+// recently updated first. statuses filters by status, e.g. "open,pending".
+func (c *Client) ListRequests(ctx context.Context, statuses string, page int, perPage int) (*RequestsResponse, error) {
+	path := fmt.Sprintf("/requests.json?sort_by=updated_at&sort_order=desc&page=%d&per_page=%d", page, perPage)
+	if statuses != "" {
+		path += "&status=" + url.QueryEscape(statuses)
+	}
+
+	body, err := c.getPage(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp RequestsResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &resp, nil
+}
+
+// GetRequest retrieves a request by ID
+func (c *Client) GetRequest(ctx context.Context, requestID int64) (*Request, error) {
+	body, err := c.getPage(ctx, fmt.Sprintf("/requests/%d.json", requestID))
+	if err != nil {
+		return nil, err
+	}
+
+	var resp RequestResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &resp.Request, nil
+}
+
+// GetRequestComments retrieves every public comment on a request, oldest
+// first, with their authors
+func (c *Client) GetRequestComments(ctx context.Context, requestID int64) ([]Comment, []User, error) {
+	var comments []Comment
+	var users []User
+	path := fmt.Sprintf("/requests/%d/comments.json", requestID)
+	err := c.forEachCursorPage(ctx, path, func(body []byte) error {
+		var page RequestCommentsResponse
+		if err := json.Unmarshal(body, &page); err != nil {
+			return fmt.Errorf("failed to decode response: %w", err)
+		}
+		comments = append(comments, page.Comments...)
+		users = append(users, page.Users...)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return comments, users, nil
+}
+
+// CreateRequest creates a request on behalf of the authenticated user
+func (c *Client) CreateRequest(ctx context.Context, req CreateRequestRequest) (*Request, error) {
+	body, err := json.Marshal(map[string]interface{}{"request": req})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	var resp RequestResponse
+	if err := c.do(ctx, http.MethodPost, "/requests.json", body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Request, nil
+}
+
+// AddRequestComment adds a comment to a request. With solve set the request
+// is also marked solved, which only works when it can be solved by the
+// authenticated user.
+func (c *Client) AddRequestComment(ctx context.Context, requestID int64, comment RequestComment, solve bool) (*Request, error) {
+	update := map[string]interface{}{"comment": comment}
+	if solve {
+		update["solved"] = true
+	}
+
+	body, err := json.Marshal(map[string]interface{}{"request": update})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	var resp RequestResponse
+	if err := c.do(ctx, http.MethodPut, fmt.Sprintf("/requests/%d.json", requestID), body, &resp); err != nil {
+		return nil, err
+	}
+
+	// A request is a ticket, so invalidate what's cached for the ticket
+	if c.cache != nil {
+		c.cache.Delete(fmt.Sprintf("%s:tickets:%d", c.subdomain, requestID))
+		c.cache.Delete(fmt.Sprintf("%s:tickets:%d:comments", c.subdomain, requestID))
+	}
+
+	return &resp.Request, nil
+}
@@ -2,9 +2,13 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"math"
+	"math/rand/v2"
 	"net/http"
+	"os"
 	"strconv"
 	"time"
 )
@@ -25,69 +29,161 @@ func DefaultRetryConfig() RetryConfig {
 	}
 }
 
-// RetryWithBackoff retries a request with exponential backoff
-func (c *Client) RetryWithBackoff(ctx context.Context, fn func() (*http.Response, error)) (*http.Response, error) {
-	config := DefaultRetryConfig()
+// retryTransport is an http.RoundTripper that retries rate limited and
+// failed requests with exponential backoff. Every request the client sends
+// goes through it.
+type retryTransport struct {
+	base   http.RoundTripper
+	config RetryConfig
+}
+
+// newRetryTransport wraps base with retries
+func newRetryTransport(base http.RoundTripper, config RetryConfig) *retryTransport {
+	return &retryTransport{base: base, config: config}
+}
 
-	var lastErr error
-	for attempt := 0; attempt <= config.MaxRetries; attempt++ {
-		resp, err := fn()
+// RoundTrip sends the request, retrying 429 responses, and 5xx responses
+// and network errors for idempotent requests. Retry-After is honored.
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
 
-		// Success - no retry needed
-		if err == nil && resp.StatusCode < 500 && resp.StatusCode != http.StatusTooManyRequests {
-			return resp, nil
+	for attempt := 0; ; attempt++ {
+		resp, err := t.base.RoundTrip(req)
+
+		if attempt >= t.config.MaxRetries || !retryable(req, resp, err) {
+			return resp, err
 		}
 
-		// Save error
-		if err != nil {
-			lastErr = err
-		} else {
-			lastErr = fmt.Errorf("HTTP %d", resp.StatusCode)
+		wait := t.backoff(attempt)
+		reason := "Request failed"
+		if resp != nil {
 			if resp.StatusCode == http.StatusTooManyRequests {
-				// Check for Retry-After header
-				if retryAfter := resp.Header.Get("Retry-After"); retryAfter != "" {
-					if seconds, err := strconv.Atoi(retryAfter); err == nil {
-						waitDuration := time.Duration(seconds) * time.Second
-						if waitDuration > config.MaxBackoff {
-							waitDuration = config.MaxBackoff
-						}
-
-						fmt.Printf("Rate limit hit. Waiting %s before retry...\n", waitDuration)
-
-						select {
-						case <-time.After(waitDuration):
-							continue
-						case <-ctx.Done():
-							return nil, ctx.Err()
-						}
-					}
+				reason = "Rate limit hit"
+				if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+					wait = retryAfter
 				}
 			}
+
+			// Don't sleep past the deadline just to fail; return the response as is
+			if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < wait {
+				return resp, nil
+			}
+
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
 		}
 
-		// Last attempt failed
-		if attempt == config.MaxRetries {
-			break
+		fmt.Fprintf(os.Stderr, "%s (attempt %d/%d). Retrying in %s...\n", reason, attempt+1, t.config.MaxRetries+1, wait.Round(time.Millisecond))
+
+		if err := sleep(ctx, wait); err != nil {
+			return nil, err
 		}
 
-		// Calculate backoff with exponential increase
-		backoff := time.Duration(float64(config.InitialBackoff) * math.Pow(2, float64(attempt)))
-		if backoff > config.MaxBackoff {
-			backoff = config.MaxBackoff
+		// Request bodies have been consumed, so rewind them for the next attempt
+		if req.Body != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, fmt.Errorf("failed to rewind request body: %w", err)
+			}
+			req.Body = body
 		}
+	}
+}
 
-		fmt.Printf("Request failed (attempt %d/%d). Retrying in %s...\n", attempt+1, config.MaxRetries+1, backoff)
+// backoff returns how long to wait before retrying after the given attempt:
+// exponential, capped at MaxBackoff, with up to 50% jitter so concurrent
+// requests don't retry in lockstep
+func (t *retryTransport) backoff(attempt int) time.Duration {
+	backoff := time.Duration(float64(t.config.InitialBackoff) * math.Pow(2, float64(attempt)))
+	if backoff > t.config.MaxBackoff || backoff <= 0 {
+		backoff = t.config.MaxBackoff
+	}
+
+	jitter := time.Duration(rand.Int64N(int64(backoff)/2 + 1))
+	return backoff/2 + jitter
+}
+
+// retryable reports whether a request should be sent again
+func retryable(req *http.Request, resp *http.Response, err error) bool {
+	// Bodies that can't be rewound can only be sent once
+	if req.Body != nil && req.GetBody == nil {
+		return false
+	}
 
-		// Wait before retry
-		select {
-		case <-time.After(backoff):
-			continue
-		case <-ctx.Done():
-			return nil, ctx.Err()
+	if err != nil {
+		// Cancelled and timed out requests stay that way
+		if req.Context().Err() != nil {
+			return false
 		}
+		var permanent *permanentError
+		if errors.As(err, &permanent) {
+			return false
+		}
+		return idempotent(req.Method)
+	}
+
+	// Rate limited requests were never processed, so any method can be retried
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+
+	// A server error may come after a POST has taken effect
+	return ShouldRetry(resp.StatusCode) && idempotent(req.Method)
+}
+
+// Permanent marks err, returned by a transport the client sends through, as
+// one that retrying won't fix
+func Permanent(err error) error {
+	return &permanentError{err: err}
+}
+
+// permanentError is an error that requests aren't retried after
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e *permanentError) Unwrap() error {
+	return e.err
+}
+
+// idempotent reports whether sending a request with method twice is safe
+func idempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// parseRetryAfter parses a Retry-After header given in seconds or as an HTTP date
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
 	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		return max(time.Until(t), 0), true
+	}
+	return 0, false
+}
 
-	return nil, fmt.Errorf("request failed after %d retries: %w", config.MaxRetries, lastErr)
+// sleep waits for d, or returns early when ctx is cancelled
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
 
 // ShouldRetry determines if an error is retryable
@@ -0,0 +1,50 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// SatisfactionRating represents a customer satisfaction (CSAT) rating
+type SatisfactionRating struct {
+	ID          int64  `json:"id"`
+	URL         string `json:"url"`
+	Score       string `json:"score"`
+	Comment     string `json:"comment"`
+	Reason      string `json:"reason"`
+	TicketID    int64  `json:"ticket_id"`
+	RequesterID int64  `json:"requester_id"`
+	AssigneeID  *int64 `json:"assignee_id"`
+	GroupID     *int64 `json:"group_id"`
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+}
+
+// SatisfactionRatingsResponse represents the response from listing satisfaction ratings
+type SatisfactionRatingsResponse struct {
+	SatisfactionRatings []SatisfactionRating `json:"satisfaction_ratings"`
+	NextPage            string               `json:"next_page"`
+	PreviousPage        string               `json:"previous_page"`
+	Count               int                  `json:"count"`
+}
+
+// ListSatisfactionRatings retrieves satisfaction ratings, optionally filtered
+// by score (e.g. good, bad, received) and a start time in Unix seconds
+func (c *Client) ListSatisfactionRatings(ctx context.Context, page int, perPage int, score string, startTime int64) (*SatisfactionRatingsResponse, error) {
+	cacheKey := fmt.Sprintf("%s:satisfaction_ratings:list:%d:%d:%s:%d", c.subdomain, page, perPage, score, startTime)
+	path := fmt.Sprintf("/satisfaction_ratings.json?page=%d&per_page=%d", page, perPage)
+	if score != "" {
+		path += "&score=" + url.QueryEscape(score)
+	}
+	if startTime > 0 {
+		path += fmt.Sprintf("&start_time=%d", startTime)
+	}
+
+	var resp SatisfactionRatingsResponse
+	if err := c.getCached(ctx, cacheKey, path, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
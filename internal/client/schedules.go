@@ -0,0 +1,86 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// Schedule represents a business hours schedule, used by SLA policies and
+// business hours metrics
+type Schedule struct {
+	ID        int64              `json:"id"`
+	Name      string             `json:"name"`
+	TimeZone  string             `json:"time_zone"`
+	Intervals []ScheduleInterval `json:"intervals"`
+	CreatedAt string             `json:"created_at"`
+	UpdatedAt string             `json:"updated_at"`
+}
+
+// ScheduleInterval is a stretch of business hours, in minutes since
+// midnight on Sunday in the schedule's time zone
+type ScheduleInterval struct {
+	StartTime int `json:"start_time"`
+	EndTime   int `json:"end_time"`
+}
+
+// Holiday is a day or range of days a schedule treats as outside business
+// hours. Dates are YYYY-MM-DD and inclusive.
+type Holiday struct {
+	ID        int64  `json:"id"`
+	Name      string `json:"name"`
+	StartDate string `json:"start_date"`
+	EndDate   string `json:"end_date"`
+}
+
+// SchedulesResponse represents the response from listing schedules
+type SchedulesResponse struct {
+	Schedules []Schedule `json:"schedules"`
+}
+
+// ScheduleResponse represents a single schedule response
+type ScheduleResponse struct {
+	Schedule Schedule `json:"schedule"`
+}
+
+// HolidaysResponse represents the response from listing a schedule's
+// holidays
+type HolidaysResponse struct {
+	Holidays []Holiday `json:"holidays"`
+}
+
+// ListSchedules retrieves every business hours schedule. The endpoint isn't
+// paginated.
+func (c *Client) ListSchedules(ctx context.Context) ([]Schedule, error) {
+	cacheKey := fmt.Sprintf("%s:schedules:list", c.subdomain)
+
+	var resp SchedulesResponse
+	if err := c.getCached(ctx, cacheKey, "/business_hours/schedules.json", &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Schedules, nil
+}
+
+// GetSchedule retrieves a specific schedule by ID
+func (c *Client) GetSchedule(ctx context.Context, scheduleID int64) (*Schedule, error) {
+	cacheKey := fmt.Sprintf("%s:schedules:%d", c.subdomain, scheduleID)
+
+	var resp ScheduleResponse
+	if err := c.getCached(ctx, cacheKey, fmt.Sprintf("/business_hours/schedules/%d.json", scheduleID), &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp.Schedule, nil
+}
+
+// ListHolidays retrieves a schedule's holidays
+func (c *Client) ListHolidays(ctx context.Context, scheduleID int64) ([]Holiday, error) {
+	cacheKey := fmt.Sprintf("%s:schedules:%d:holidays", c.subdomain, scheduleID)
+
+	var resp HolidaysResponse
+	if err := c.getCached(ctx, cacheKey, fmt.Sprintf("/business_hours/schedules/%d/holidays.json", scheduleID), &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Holidays, nil
+}
@@ -0,0 +1,114 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// SearchOptions controls paging and sorting of search results. Zero values
+// leave the API defaults in place.
+type SearchOptions struct {
+	Page      int
+	PerPage   int
+	SortBy    string
+	SortOrder string
+}
+
+// searchPath builds the search.json path for a query and its options
+func searchPath(query string, opts SearchOptions) string {
+	params := url.Values{}
+	params.Set("query", query)
+	if opts.Page > 0 {
+		params.Set("page", strconv.Itoa(opts.Page))
+	}
+	if opts.PerPage > 0 {
+		params.Set("per_page", strconv.Itoa(opts.PerPage))
+	}
+	if opts.SortBy != "" {
+		params.Set("sort_by", opts.SortBy)
+	}
+	if opts.SortOrder != "" {
+		params.Set("sort_order", opts.SortOrder)
+	}
+	return "/search.json?" + params.Encode()
+}
+
+// SearchResult is one result from the unified search API. The typed field
+// matching ResultType is set; results of other types keep only the raw JSON.
+type SearchResult struct {
+	ResultType   string
+	Ticket       *Ticket
+	User         *User
+	Organization *Organization
+	Group        *Group
+	raw          json.RawMessage
+}
+
+// UnmarshalJSON decodes a search result into the type named by result_type
+func (r *SearchResult) UnmarshalJSON(data []byte) error {
+	var header struct {
+		ResultType string `json:"result_type"`
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return err
+	}
+
+	r.ResultType = header.ResultType
+	r.raw = append(json.RawMessage(nil), data...)
+
+	switch r.ResultType {
+	case "ticket":
+		r.Ticket = &Ticket{}
+		return json.Unmarshal(data, r.Ticket)
+	case "user":
+		r.User = &User{}
+		return json.Unmarshal(data, r.User)
+	case "organization":
+		r.Organization = &Organization{}
+		return json.Unmarshal(data, r.Organization)
+	case "group":
+		r.Group = &Group{}
+		return json.Unmarshal(data, r.Group)
+	}
+
+	return nil
+}
+
+// MarshalJSON writes the result back out exactly as the API returned it
+func (r SearchResult) MarshalJSON() ([]byte, error) {
+	if r.raw == nil {
+		return []byte("null"), nil
+	}
+	return r.raw, nil
+}
+
+// SearchResponse represents the API response for a unified search
+type SearchResponse struct {
+	Results      []SearchResult `json:"results"`
+	NextPage     string         `json:"next_page"`
+	PreviousPage string         `json:"previous_page"`
+	Count        int            `json:"count"`
+}
+
+// Search runs a query against the unified search API, returning tickets,
+// users, organizations, and groups. Unlike SearchTickets, no type: filter is
+// added, so the query can use the full Zendesk search language.
+func (c *Client) Search(ctx context.Context, query string, opts SearchOptions) (*SearchResponse, error) {
+	path := searchPath(query, opts)
+	cacheKey := fmt.Sprintf("%s:search:%s", c.subdomain, path)
+
+	body, err := c.fetchCached(ctx, cacheKey, path)
+	if err != nil {
+		return nil, err
+	}
+
+	var searchResp SearchResponse
+	if err := json.Unmarshal(body, &searchResp); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &searchResp, nil
+}
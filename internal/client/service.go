@@ -0,0 +1,180 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Service is a typed client for a resource with the usual endpoints:
+// /<name>.json to list and create, and /<name>/{id}.json to show, update,
+// and delete. Reads are cached under the resource's name, so cache_ttl
+// settings for it apply, and writes clear the cached record.
+//
+// A resource that follows the pattern needs only its type and an accessor
+// below; its Get, List, Create, Update, and Delete come for free.
+type Service[T any] struct {
+	c    *Client
+	name string // Name in paths and cache keys, e.g. "ticket_fields"
+	key  string // JSON key of one record, e.g. "ticket_field"
+}
+
+// Page is one page of a resource list
+type Page[T any] struct {
+	Items    []T
+	Count    int
+	NextPage string
+}
+
+// Tickets returns the service for tickets
+func (c *Client) Tickets() *Service[Ticket] {
+	return &Service[Ticket]{c: c, name: "tickets", key: "ticket"}
+}
+
+// Users returns the service for users
+func (c *Client) Users() *Service[User] {
+	return &Service[User]{c: c, name: "users", key: "user"}
+}
+
+// Organizations returns the service for organizations
+func (c *Client) Organizations() *Service[Organization] {
+	return &Service[Organization]{c: c, name: "organizations", key: "organization"}
+}
+
+// Groups returns the service for groups
+func (c *Client) Groups() *Service[Group] {
+	return &Service[Group]{c: c, name: "groups", key: "group"}
+}
+
+// Brands returns the service for brands
+func (c *Client) Brands() *Service[Brand] {
+	return &Service[Brand]{c: c, name: "brands", key: "brand"}
+}
+
+// CustomRoles returns the service for custom agent roles
+func (c *Client) CustomRoles() *Service[CustomRole] {
+	return &Service[CustomRole]{c: c, name: "custom_roles", key: "custom_role"}
+}
+
+// Automations returns the service for automations
+func (c *Client) Automations() *Service[Automation] {
+	return &Service[Automation]{c: c, name: "automations", key: "automation"}
+}
+
+// Macros returns the service for macros
+func (c *Client) Macros() *Service[Macro] {
+	return &Service[Macro]{c: c, name: "macros", key: "macro"}
+}
+
+// Views returns the service for views
+func (c *Client) Views() *Service[View] {
+	return &Service[View]{c: c, name: "views", key: "view"}
+}
+
+// TicketFields returns the service for ticket fields
+func (c *Client) TicketFields() *Service[TicketField] {
+	return &Service[TicketField]{c: c, name: "ticket_fields", key: "ticket_field"}
+}
+
+// Get retrieves a record by ID
+func (s *Service[T]) Get(ctx context.Context, id int64) (*T, error) {
+	var body map[string]json.RawMessage
+	if err := s.c.getCached(ctx, s.cacheKey(id), s.path(id), &body); err != nil {
+		return nil, err
+	}
+	return s.decode(body)
+}
+
+// List retrieves one page of records
+func (s *Service[T]) List(ctx context.Context, page, perPage int) (*Page[T], error) {
+	cacheKey := fmt.Sprintf("%s:%s:list:%d:%d", s.c.subdomain, s.name, page, perPage)
+	path := fmt.Sprintf("/%s.json?page=%d&per_page=%d", s.name, page, perPage)
+
+	var body map[string]json.RawMessage
+	if err := s.c.getCached(ctx, cacheKey, path, &body); err != nil {
+		return nil, err
+	}
+
+	result := &Page[T]{}
+	if err := json.Unmarshal(body[s.name], &result.Items); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	json.Unmarshal(body["count"], &result.Count)
+	json.Unmarshal(body["next_page"], &result.NextPage)
+
+	return result, nil
+}
+
+// Create creates a record from fields, which may be a T or any value that
+// marshals to the record's JSON, such as a map of fields
+func (s *Service[T]) Create(ctx context.Context, fields interface{}) (*T, error) {
+	return s.send(ctx, http.MethodPost, fmt.Sprintf("/%s.json", s.name), fields)
+}
+
+// Update updates a record with fields, as for Create
+func (s *Service[T]) Update(ctx context.Context, id int64, fields interface{}) (*T, error) {
+	record, err := s.send(ctx, http.MethodPut, s.path(id), fields)
+	if err != nil {
+		return nil, err
+	}
+
+	s.forget(id)
+	return record, nil
+}
+
+// Delete deletes a record
+func (s *Service[T]) Delete(ctx context.Context, id int64) error {
+	if err := s.c.do(ctx, http.MethodDelete, s.path(id), nil, nil); err != nil {
+		return err
+	}
+
+	s.forget(id)
+	return nil
+}
+
+// send sends fields wrapped in the record's JSON key and decodes the record
+// from the response
+func (s *Service[T]) send(ctx context.Context, method, path string, fields interface{}) (*T, error) {
+	payload, err := json.Marshal(map[string]interface{}{s.key: fields})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	var body map[string]json.RawMessage
+	if err := s.c.do(ctx, method, path, payload, &body); err != nil {
+		return nil, err
+	}
+	return s.decode(body)
+}
+
+// decode returns the record under the record's JSON key in body
+func (s *Service[T]) decode(body map[string]json.RawMessage) (*T, error) {
+	raw, ok := body[s.key]
+	if !ok {
+		return nil, fmt.Errorf("failed to decode response: no %q in response", s.key)
+	}
+
+	var record T
+	if err := json.Unmarshal(raw, &record); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	return &record, nil
+}
+
+// path returns the path of the record with id
+func (s *Service[T]) path(id int64) string {
+	return fmt.Sprintf("/%s/%d.json", s.name, id)
+}
+
+// cacheKey returns the cache key of the record with id
+func (s *Service[T]) cacheKey(id int64) string {
+	return fmt.Sprintf("%s:%s:%d", s.c.subdomain, s.name, id)
+}
+
+// forget removes the record with id from the cache
+func (s *Service[T]) forget(id int64) {
+	if s.c.cache != nil {
+		s.c.cache.Delete(s.cacheKey(id))
+	}
+}
@@ -0,0 +1,61 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Session is a signed-in browser or device of a user
+type Session struct {
+	ID              int64  `json:"id"`
+	UserID          int64  `json:"user_id"`
+	AuthenticatedAt string `json:"authenticated_at"`
+	LastSeenAt      string `json:"last_seen_at"`
+	URL             string `json:"url"`
+}
+
+// SessionsResponse represents the response from listing sessions
+type SessionsResponse struct {
+	Sessions     []Session `json:"sessions"`
+	NextPage     string    `json:"next_page"`
+	PreviousPage string    `json:"previous_page"`
+	Count        int       `json:"count"`
+}
+
+// ListSessions retrieves a page of sessions. A userID of 0 lists the
+// sessions of every user in the account, which needs an admin. Results are
+// not cached since sessions come and go.
+func (c *Client) ListSessions(ctx context.Context, userID int64, page int, perPage int) (*SessionsResponse, error) {
+	path := "/sessions.json"
+	if userID != 0 {
+		path = fmt.Sprintf("/users/%d/sessions.json", userID)
+	}
+
+	body, err := c.getPage(ctx, fmt.Sprintf("%s?page=%d&per_page=%d", path, page, perPage))
+	if err != nil {
+		return nil, err
+	}
+
+	var resp SessionsResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &resp, nil
+}
+
+// DeleteSession signs a user out of one session
+func (c *Client) DeleteSession(ctx context.Context, userID, sessionID int64) error {
+	return c.deleteSessions(ctx, fmt.Sprintf("/users/%d/sessions/%d.json", userID, sessionID))
+}
+
+// DeleteUserSessions signs a user out of every session, on every device
+func (c *Client) DeleteUserSessions(ctx context.Context, userID int64) error {
+	return c.deleteSessions(ctx, fmt.Sprintf("/users/%d/sessions.json", userID))
+}
+
+func (c *Client) deleteSessions(ctx context.Context, path string) error {
+	return c.do(ctx, http.MethodDelete, path, nil, nil)
+}
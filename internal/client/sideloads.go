@@ -0,0 +1,54 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Sideloads that can be requested with include= on ticket endpoints
+const (
+	SideloadUsers         = "users"
+	SideloadOrganizations = "organizations"
+	SideloadGroups        = "groups"
+)
+
+// TicketSideloads lists every sideload supported on ticket endpoints
+var TicketSideloads = []string{SideloadUsers, SideloadOrganizations, SideloadGroups}
+
+// Sideloads holds related records returned alongside the main response when
+// they are requested with include=
+type Sideloads struct {
+	Users         []User         `json:"users,omitempty"`
+	Organizations []Organization `json:"organizations,omitempty"`
+	Groups        []Group        `json:"groups,omitempty"`
+}
+
+// includeParam formats sideload names for the include= query parameter
+func includeParam(include []string) string {
+	return strings.Join(include, ",")
+}
+
+// cacheSideloads stores sideloaded records under their own cache keys, so
+// later lookups of the same users, organizations, and groups hit the cache
+func (c *Client) cacheSideloads(sideloads Sideloads) {
+	if !c.useCache || c.cache == nil {
+		return
+	}
+
+	for _, user := range sideloads.Users {
+		if data, err := json.Marshal(UserResponse{User: user}); err == nil {
+			c.cache.Set(fmt.Sprintf("%s:users:%d", c.subdomain, user.ID), data)
+		}
+	}
+	for _, org := range sideloads.Organizations {
+		if data, err := json.Marshal(OrganizationResponse{Organization: org}); err == nil {
+			c.cache.Set(fmt.Sprintf("%s:organizations:%d", c.subdomain, org.ID), data)
+		}
+	}
+	for _, group := range sideloads.Groups {
+		if data, err := json.Marshal(GroupResponse{Group: group}); err == nil {
+			c.cache.Set(fmt.Sprintf("%s:groups:%d", c.subdomain, group.ID), data)
+		}
+	}
+}
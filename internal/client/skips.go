@@ -0,0 +1,67 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Skip records that an agent passed on a ticket served to them in play
+// mode, and why
+type Skip struct {
+	ID        int64  `json:"id"`
+	TicketID  int64  `json:"ticket_id"`
+	UserID    int64  `json:"user_id"`
+	Reason    string `json:"reason"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// SkipsResponse represents the response from listing skips
+type SkipsResponse struct {
+	Skips    []Skip `json:"skips"`
+	NextPage string `json:"next_page"`
+	Count    int    `json:"count"`
+}
+
+// SkipResponse represents a single skip response
+type SkipResponse struct {
+	Skip Skip `json:"skip"`
+}
+
+// ListUserSkips retrieves every ticket skip recorded for a user. Results
+// are not cached, since play mode needs to see skips made moments ago.
+func (c *Client) ListUserSkips(ctx context.Context, userID int64) ([]Skip, error) {
+	path := fmt.Sprintf("/users/%d/skips.json", userID)
+
+	var skips []Skip
+	err := c.forEachPage(ctx, path, path, func(body []byte) error {
+		var page SkipsResponse
+		if err := json.Unmarshal(body, &page); err != nil {
+			return fmt.Errorf("failed to decode response: %w", err)
+		}
+		skips = append(skips, page.Skips...)
+		return nil
+	})
+	return skips, err
+}
+
+// CreateSkip records that the authenticated agent skipped a ticket
+func (c *Client) CreateSkip(ctx context.Context, ticketID int64, reason string) (*Skip, error) {
+	body, err := json.Marshal(map[string]interface{}{
+		"skip": map[string]interface{}{
+			"ticket_id": ticketID,
+			"reason":    reason,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	var resp SkipResponse
+	if err := c.do(ctx, http.MethodPost, "/skips.json", body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Skip, nil
+}
@@ -0,0 +1,99 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// TagsResponse represents a list of tags on a resource
+type TagsResponse struct {
+	Tags []string `json:"tags"`
+}
+
+// Tag represents a tag and the number of times it is used
+type Tag struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+// TagListResponse represents the response from listing tags
+type TagListResponse struct {
+	Tags         []Tag  `json:"tags"`
+	NextPage     string `json:"next_page"`
+	PreviousPage string `json:"previous_page"`
+	Count        int    `json:"count"`
+}
+
+// ListTags retrieves the most popular tags in the account
+func (c *Client) ListTags(ctx context.Context, page int, perPage int) (*TagListResponse, error) {
+	cacheKey := fmt.Sprintf("%s:tags:list:%d:%d", c.subdomain, page, perPage)
+	path := fmt.Sprintf("/tags.json?page=%d&per_page=%d", page, perPage)
+
+	var resp TagListResponse
+	if err := c.getCached(ctx, cacheKey, path, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// AutocompleteTags returns tag names starting with prefix. Zendesk requires
+// a prefix of at least two characters.
+func (c *Client) AutocompleteTags(ctx context.Context, prefix string) ([]string, error) {
+	cacheKey := fmt.Sprintf("%s:tags:autocomplete:%s", c.subdomain, prefix)
+	path := fmt.Sprintf("/autocomplete/tags.json?name=%s", url.QueryEscape(prefix))
+
+	var resp TagsResponse
+	if err := c.getCached(ctx, cacheKey, path, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Tags, nil
+}
+
+// AddTicketTags adds tags to a ticket without touching its existing tags and
+// returns the ticket's resulting tags. Zendesk adds tags with PUT; POST on the
+// same endpoint replaces all tags, so it is deliberately not used here.
+func (c *Client) AddTicketTags(ctx context.Context, ticketID int64, tags []string) ([]string, error) {
+	return c.updateTicketTags(ctx, http.MethodPut, ticketID, tags)
+}
+
+// RemoveTicketTags removes tags from a ticket and returns the ticket's
+// remaining tags
+func (c *Client) RemoveTicketTags(ctx context.Context, ticketID int64, tags []string) ([]string, error) {
+	return c.updateTicketTags(ctx, http.MethodDelete, ticketID, tags)
+}
+
+// updateTicketTags sends a tag change for a ticket and invalidates its cache
+func (c *Client) updateTicketTags(ctx context.Context, method string, ticketID int64, tags []string) ([]string, error) {
+	body, err := json.Marshal(TagsResponse{Tags: tags})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	path := fmt.Sprintf("/tickets/%d/tags.json", ticketID)
+	result, err := c.makeTagsRequest(ctx, method, path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalidate cache for this ticket
+	if c.cache != nil {
+		cacheKey := fmt.Sprintf("%s:tickets:%d", c.subdomain, ticketID)
+		c.cache.Delete(cacheKey)
+	}
+
+	return result, nil
+}
+
+// makeTagsRequest makes a request that returns a list of tags
+func (c *Client) makeTagsRequest(ctx context.Context, method, path string, body []byte) ([]string, error) {
+	var tagsResp TagsResponse
+	if err := c.do(ctx, method, path, body, &tagsResp); err != nil {
+		return nil, err
+	}
+	return tagsResp.Tags, nil
+}
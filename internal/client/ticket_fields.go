@@ -0,0 +1,68 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// TicketField represents a Zendesk ticket field
+type TicketField struct {
+	ID                  int64               `json:"id"`
+	URL                 string              `json:"url"`
+	Type                string              `json:"type"`
+	Title               string              `json:"title"`
+	RawTitle            string              `json:"raw_title"`
+	Description         string              `json:"description"`
+	Position            int                 `json:"position"`
+	Active              bool                `json:"active"`
+	Required            bool                `json:"required"`
+	Removable           bool                `json:"removable"`
+	Tag                 *string             `json:"tag"`
+	RegexpForValidation *string             `json:"regexp_for_validation"`
+	VisibleInPortal     bool                `json:"visible_in_portal"`
+	EditableInPortal    bool                `json:"editable_in_portal"`
+	RequiredInPortal    bool                `json:"required_in_portal"`
+	CustomFieldOptions  []CustomFieldOption `json:"custom_field_options,omitempty"`
+	CreatedAt           string              `json:"created_at"`
+	UpdatedAt           string              `json:"updated_at"`
+}
+
+// CustomFieldOption represents an option of a dropdown or multi-select field
+type CustomFieldOption struct {
+	ID      int64  `json:"id"`
+	Name    string `json:"name"`
+	RawName string `json:"raw_name"`
+	Value   string `json:"value"`
+	Default bool   `json:"default"`
+}
+
+// TicketFieldsResponse represents the response from listing ticket fields
+type TicketFieldsResponse struct {
+	TicketFields []TicketField `json:"ticket_fields"`
+	NextPage     string        `json:"next_page"`
+	PreviousPage string        `json:"previous_page"`
+	Count        int           `json:"count"`
+}
+
+// TicketFieldResponse represents a single ticket field response
+type TicketFieldResponse struct {
+	TicketField TicketField `json:"ticket_field"`
+}
+
+// ListTicketFields retrieves a list of ticket fields
+func (c *Client) ListTicketFields(ctx context.Context, page int, perPage int) (*TicketFieldsResponse, error) {
+	cacheKey := fmt.Sprintf("%s:ticket_fields:list:%d:%d", c.subdomain, page, perPage)
+	path := fmt.Sprintf("/ticket_fields.json?page=%d&per_page=%d", page, perPage)
+
+	var resp TicketFieldsResponse
+	if err := c.getCached(ctx, cacheKey, path, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetTicketField retrieves a specific ticket field by ID
+func (c *Client) GetTicketField(ctx context.Context, fieldID int64) (*TicketField, error) {
+	return c.TicketFields().Get(ctx, fieldID)
+}
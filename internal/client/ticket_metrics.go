@@ -0,0 +1,64 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// TicketMetric holds the reply, wait, and resolution times Zendesk tracks
+// for a ticket. Times that haven't happened yet, such as the reply time of
+// a ticket no agent has answered, are nil.
+type TicketMetric struct {
+	ID                           int64         `json:"id"`
+	TicketID                     int64         `json:"ticket_id"`
+	URL                          string        `json:"url"`
+	GroupStations                int           `json:"group_stations"`
+	AssigneeStations             int           `json:"assignee_stations"`
+	Reopens                      int           `json:"reopens"`
+	Replies                      int           `json:"replies"`
+	AssigneeUpdatedAt            *string       `json:"assignee_updated_at"`
+	RequesterUpdatedAt           *string       `json:"requester_updated_at"`
+	StatusUpdatedAt              *string       `json:"status_updated_at"`
+	InitiallyAssignedAt          *string       `json:"initially_assigned_at"`
+	AssignedAt                   *string       `json:"assigned_at"`
+	SolvedAt                     *string       `json:"solved_at"`
+	LatestCommentAddedAt         *string       `json:"latest_comment_added_at"`
+	ReplyTimeInMinutes           MetricMinutes `json:"reply_time_in_minutes"`
+	FirstResolutionTimeInMinutes MetricMinutes `json:"first_resolution_time_in_minutes"`
+	FullResolutionTimeInMinutes  MetricMinutes `json:"full_resolution_time_in_minutes"`
+	AgentWaitTimeInMinutes       MetricMinutes `json:"agent_wait_time_in_minutes"`
+	RequesterWaitTimeInMinutes   MetricMinutes `json:"requester_wait_time_in_minutes"`
+	OnHoldTimeInMinutes          MetricMinutes `json:"on_hold_time_in_minutes"`
+	CreatedAt                    string        `json:"created_at"`
+	UpdatedAt                    string        `json:"updated_at"`
+}
+
+// MetricMinutes is a ticket metric measured in calendar minutes and in
+// business hours minutes
+type MetricMinutes struct {
+	Calendar *int64 `json:"calendar"`
+	Business *int64 `json:"business"`
+}
+
+// TicketMetricResponse represents the response from getting a ticket's
+// metrics
+type TicketMetricResponse struct {
+	TicketMetric TicketMetric `json:"ticket_metric"`
+}
+
+// GetTicketMetrics retrieves the metrics of a ticket. Metrics are never
+// cached since they change whenever the ticket is worked on.
+func (c *Client) GetTicketMetrics(ctx context.Context, ticketID int64) (*TicketMetric, error) {
+	body, err := c.getPage(ctx, fmt.Sprintf("/tickets/%d/metrics.json", ticketID))
+	if err != nil {
+		return nil, err
+	}
+
+	var resp TicketMetricResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &resp.TicketMetric, nil
+}
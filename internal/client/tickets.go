@@ -4,9 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 )
 
@@ -43,7 +43,7 @@ type Ticket struct {
 			Rel  *string     `json:"rel"`
 		} `json:"source"`
 	} `json:"via"`
-	CustomFields    []interface{} `json:"custom_fields"`
+	CustomFields    []CustomField `json:"custom_fields"`
 	SatisfactionRating *struct {
 		Score   string `json:"score"`
 		Comment string `json:"comment"`
@@ -57,6 +57,28 @@ type Ticket struct {
 	AllowAttachments    bool    `json:"allow_attachments"`
 	CreatedAt           string  `json:"created_at"`
 	UpdatedAt           string  `json:"updated_at"`
+	SLAs                *TicketSLAs `json:"slas,omitempty"`
+}
+
+// TicketSLAs holds the SLA policy metrics sideloaded with include=slas
+type TicketSLAs struct {
+	PolicyMetrics []SLAPolicyMetric `json:"policy_metrics"`
+}
+
+// SLAPolicyMetric represents one SLA target on a ticket
+type SLAPolicyMetric struct {
+	Metric   string `json:"metric"`
+	Stage    string `json:"stage"`
+	BreachAt string `json:"breach_at"`
+	Days     int    `json:"days"`
+	Hours    int    `json:"hours"`
+	Minutes  int    `json:"minutes"`
+}
+
+// CustomField represents the value of a custom ticket field
+type CustomField struct {
+	ID    int64       `json:"id"`
+	Value interface{} `json:"value"`
 }
 
 // TicketsResponse represents the response from listing tickets
@@ -65,24 +87,44 @@ type TicketsResponse struct {
 	NextPage     string   `json:"next_page"`
 	PreviousPage string   `json:"previous_page"`
 	Count        int      `json:"count"`
+	Sideloads
+}
+
+// TicketListOptions filters and sorts ticket lists. Zero values leave the
+// API defaults in place.
+type TicketListOptions struct {
+	Status    string
+	SortBy    string
+	SortOrder string
+	// Include sideloads related records, e.g. SideloadUsers
+	Include []string
+}
+
+// TicketSearchResponse represents one page of ticket search results
+type TicketSearchResponse struct {
+	Results      []Ticket `json:"results"`
+	NextPage     string   `json:"next_page"`
+	PreviousPage string   `json:"previous_page"`
+	Count        int      `json:"count"`
 }
 
 // TicketResponse represents a single ticket response
 type TicketResponse struct {
 	Ticket Ticket `json:"ticket"`
+	Sideloads
 }
 
 // Comment represents a ticket comment
 type Comment struct {
-	ID          int64    `json:"id"`
-	Type        string   `json:"type"`
-	AuthorID    int64    `json:"author_id"`
-	Body        string   `json:"body"`
-	HTMLBody    string   `json:"html_body"`
-	PlainBody   string   `json:"plain_body"`
-	Public      bool     `json:"public"`
-	Attachments []interface{} `json:"attachments"`
-	AuditID     int64    `json:"audit_id"`
+	ID          int64        `json:"id"`
+	Type        string       `json:"type"`
+	AuthorID    int64        `json:"author_id"`
+	Body        string       `json:"body"`
+	HTMLBody    string       `json:"html_body"`
+	PlainBody   string       `json:"plain_body"`
+	Public      bool         `json:"public"`
+	Attachments []Attachment `json:"attachments"`
+	AuditID     int64        `json:"audit_id"`
 	Via         struct {
 		Channel string `json:"channel"`
 		Source  struct {
@@ -104,40 +146,27 @@ type CommentsResponse struct {
 }
 
 // ListTickets retrieves a list of tickets
-func (c *Client) ListTickets(ctx context.Context, page int, perPage int, status string) (*TicketsResponse, error) {
-	cacheKey := fmt.Sprintf("%s:tickets:list:%d:%d:%s", c.subdomain, page, perPage, status)
-
-	// Try cache first
-	if c.useCache && c.cache != nil {
-		if cached, found := c.cache.Get(cacheKey); found {
-			var resp TicketsResponse
-			if err := json.Unmarshal(cached, &resp); err == nil {
-				return &resp, nil
-			}
-		}
-	}
+func (c *Client) ListTickets(ctx context.Context, page int, perPage int, opts TicketListOptions) (*TicketsResponse, error) {
+	cacheKey := fmt.Sprintf("%s:tickets:list:%d:%d:%s:%s:%s:%s", c.subdomain, page, perPage, opts.Status, opts.SortBy, opts.SortOrder, includeParam(opts.Include))
 
 	// Build query parameters
 	path := fmt.Sprintf("/tickets.json?page=%d&per_page=%d", page, perPage)
-	if status != "" {
-		path += fmt.Sprintf("&status=%s", url.QueryEscape(status))
+	if opts.Status != "" {
+		path += fmt.Sprintf("&status=%s", url.QueryEscape(opts.Status))
 	}
-
-	// Fetch from API
-	resp, err := c.makeRequest(ctx, http.MethodGet, path)
-	if err != nil {
-		return nil, err
+	if opts.SortBy != "" {
+		path += fmt.Sprintf("&sort_by=%s", url.QueryEscape(opts.SortBy))
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to list tickets (status %d): %s", resp.StatusCode, string(body))
+	if opts.SortOrder != "" {
+		path += fmt.Sprintf("&sort_order=%s", url.QueryEscape(opts.SortOrder))
+	}
+	if len(opts.Include) > 0 {
+		path += fmt.Sprintf("&include=%s", url.QueryEscape(includeParam(opts.Include)))
 	}
 
-	body, err := io.ReadAll(resp.Body)
+	body, err := c.fetchCached(ctx, cacheKey, path)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
+		return nil, err
 	}
 
 	var ticketsResp TicketsResponse
@@ -145,44 +174,81 @@ func (c *Client) ListTickets(ctx context.Context, page int, perPage int, status
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Cache the result
-	if c.useCache && c.cache != nil {
-		c.cache.Set(cacheKey, body)
-	}
+	c.cacheSideloads(ticketsResp.Sideloads)
 
 	return &ticketsResp, nil
 }
 
-// GetTicket retrieves a specific ticket by ID
-func (c *Client) GetTicket(ctx context.Context, ticketID int64) (*Ticket, error) {
-	cacheKey := fmt.Sprintf("%s:tickets:%d", c.subdomain, ticketID)
+// ListAllTickets retrieves all tickets, calling fn with each page of tickets
+// in order
+func (c *Client) ListAllTickets(ctx context.Context, opts TicketListOptions, fn func([]Ticket) error) error {
+	params := url.Values{}
+	if opts.Status != "" {
+		params.Set("status", opts.Status)
+	}
+	if len(opts.Include) > 0 {
+		params.Set("include", includeParam(opts.Include))
+	}
 
-	// Try cache first
-	if c.useCache && c.cache != nil {
-		if cached, found := c.cache.Get(cacheKey); found {
-			var resp TicketResponse
-			if err := json.Unmarshal(cached, &resp); err == nil {
-				return &resp.Ticket, nil
-			}
+	// Offset pagination sorts with sort_by and sort_order, cursor pagination
+	// with a single sort param, "-" meaning descending
+	offsetParams, cursorParams := url.Values{}, url.Values{}
+	for key, values := range params {
+		offsetParams[key] = values
+		cursorParams[key] = values
+	}
+	if opts.SortBy != "" {
+		offsetParams.Set("sort_by", opts.SortBy)
+		offsetParams.Set("sort_order", opts.SortOrder)
+
+		sort := opts.SortBy
+		if opts.SortOrder == "desc" {
+			sort = "-" + sort
 		}
+		cursorParams.Set("sort", sort)
 	}
 
-	// Fetch from API
-	path := fmt.Sprintf("/tickets/%d.json", ticketID)
-	resp, err := c.makeRequest(ctx, http.MethodGet, path)
+	offsetPath, cursorPath := "/tickets.json", "/tickets.json"
+	if len(offsetParams) > 0 {
+		offsetPath += "?" + offsetParams.Encode()
+	}
+	if len(cursorParams) > 0 {
+		cursorPath += "?" + cursorParams.Encode()
+	}
+
+	return c.forEachPage(ctx, offsetPath, cursorPath, func(body []byte) error {
+		var page TicketsResponse
+		if err := json.Unmarshal(body, &page); err != nil {
+			return fmt.Errorf("failed to decode response: %w", err)
+		}
+		c.cacheSideloads(page.Sideloads)
+		return fn(page.Tickets)
+	})
+}
+
+// GetTicket retrieves a specific ticket by ID
+func (c *Client) GetTicket(ctx context.Context, ticketID int64) (*Ticket, error) {
+	resp, err := c.GetTicketWithSideloads(ctx, ticketID, nil)
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
+	return &resp.Ticket, nil
+}
 
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to get ticket (status %d): %s", resp.StatusCode, string(body))
-	}
+// GetTicketWithSideloads retrieves a specific ticket by ID along with the
+// related records named in include. The ticket shares its cache entry with
+// GetTicket, so a cached response may carry fewer sideloads than requested.
+func (c *Client) GetTicketWithSideloads(ctx context.Context, ticketID int64, include []string) (*TicketResponse, error) {
+	cacheKey := fmt.Sprintf("%s:tickets:%d", c.subdomain, ticketID)
 
-	body, err := io.ReadAll(resp.Body)
+	// Fetch from API
+	path := fmt.Sprintf("/tickets/%d.json", ticketID)
+	if len(include) > 0 {
+		path += "?include=" + url.QueryEscape(includeParam(include))
+	}
+	body, err := c.fetchCached(ctx, cacheKey, path)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
+		return nil, err
 	}
 
 	var ticketResp TicketResponse
@@ -190,15 +256,62 @@ func (c *Client) GetTicket(ctx context.Context, ticketID int64) (*Ticket, error)
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// Cache the result
-	if c.useCache && c.cache != nil {
-		c.cache.Set(cacheKey, body)
+	c.cacheSideloads(ticketResp.Sideloads)
+
+	return &ticketResp, nil
+}
+
+// GetTicketsByIDs retrieves several tickets at once with
+// /tickets/show_many.json. Tickets already cached are served from the cache,
+// and fetched tickets are cached individually. Deleted tickets are left out.
+func (c *Client) GetTicketsByIDs(ctx context.Context, ticketIDs []int64) ([]Ticket, error) {
+	var tickets []Ticket
+	var missing []int64
+
+	// Try cache first
+	for _, ticketID := range uniqueIDs(ticketIDs) {
+		if c.useCache && c.cache != nil {
+			cacheKey := fmt.Sprintf("%s:tickets:%d", c.subdomain, ticketID)
+			if cached, found := c.cache.Get(cacheKey); found {
+				var resp TicketResponse
+				if err := json.Unmarshal(cached, &resp); err == nil {
+					tickets = append(tickets, resp.Ticket)
+					continue
+				}
+			}
+		}
+		missing = append(missing, ticketID)
+	}
+
+	for start := 0; start < len(missing); start += showManyLimit {
+		end := min(start+showManyLimit, len(missing))
+
+		body, err := c.getPage(ctx, "/tickets/show_many.json?ids="+joinIDs(missing[start:end]))
+		if err != nil {
+			return nil, err
+		}
+
+		var ticketsResp TicketsResponse
+		if err := json.Unmarshal(body, &ticketsResp); err != nil {
+			return nil, fmt.Errorf("failed to decode response: %w", err)
+		}
+
+		for _, ticket := range ticketsResp.Tickets {
+			// Cache each ticket under its own key
+			if c.useCache && c.cache != nil {
+				if data, err := json.Marshal(TicketResponse{Ticket: ticket}); err == nil {
+					c.cache.Set(fmt.Sprintf("%s:tickets:%d", c.subdomain, ticket.ID), data)
+				}
+			}
+			tickets = append(tickets, ticket)
+		}
 	}
 
-	return &ticketResp.Ticket, nil
+	return tickets, nil
 }
 
-// GetTicketComments retrieves comments for a ticket
+// GetTicketComments retrieves every comment on a ticket, oldest first,
+// following pagination for long conversations
 func (c *Client) GetTicketComments(ctx context.Context, ticketID int64) ([]Comment, error) {
 	cacheKey := fmt.Sprintf("%s:tickets:%d:comments", c.subdomain, ticketID)
 
@@ -213,75 +326,87 @@ func (c *Client) GetTicketComments(ctx context.Context, ticketID int64) ([]Comme
 	}
 
 	// Fetch from API
+	var comments []Comment
 	path := fmt.Sprintf("/tickets/%d/comments.json", ticketID)
-	resp, err := c.makeRequest(ctx, http.MethodGet, path)
+	err := c.forEachCursorPage(ctx, path, func(body []byte) error {
+		var page CommentsResponse
+		if err := json.Unmarshal(body, &page); err != nil {
+			return fmt.Errorf("failed to decode response: %w", err)
+		}
+		comments = append(comments, page.Comments...)
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to get ticket comments (status %d): %s", resp.StatusCode, string(body))
-	}
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
-	}
-
-	var commentsResp CommentsResponse
-	if err := json.Unmarshal(body, &commentsResp); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
-	}
 
-	// Cache the result
+	// Cache the whole conversation as one response
 	if c.useCache && c.cache != nil {
-		c.cache.Set(cacheKey, body)
+		if body, err := json.Marshal(CommentsResponse{Comments: comments, Count: len(comments)}); err == nil {
+			c.cache.Set(cacheKey, body)
+		}
 	}
 
-	return commentsResp.Comments, nil
+	return comments, nil
 }
 
 // CreateTicketRequest represents a ticket creation request
 type CreateTicketRequest struct {
-	Subject     string   `json:"subject"`
-	Description string   `json:"comment,omitempty"`
-	Priority    string   `json:"priority,omitempty"`
-	Type        string   `json:"type,omitempty"`
-	Status      string   `json:"status,omitempty"`
-	AssigneeID  *int64   `json:"assignee_id,omitempty"`
-	GroupID     *int64   `json:"group_id,omitempty"`
-	Tags        []string `json:"tags,omitempty"`
+	Subject      string        `json:"subject"`
+	Description  string        `json:"comment,omitempty"`
+	Priority     string        `json:"priority,omitempty"`
+	Type         string        `json:"type,omitempty"`
+	Status       string        `json:"status,omitempty"`
+	RequesterID  *int64        `json:"requester_id,omitempty"`
+	AssigneeID   *int64        `json:"assignee_id,omitempty"`
+	GroupID      *int64        `json:"group_id,omitempty"`
+	BrandID      *int64        `json:"brand_id,omitempty"`
+	Tags         []string      `json:"tags,omitempty"`
+	Uploads      []string      `json:"uploads,omitempty"`
+	CustomFields []CustomField `json:"custom_fields,omitempty"`
+	DueAt        string        `json:"due_at,omitempty"` // Tasks only
 }
 
 // UpdateTicketRequest represents a ticket update request
 type UpdateTicketRequest struct {
-	Subject    *string  `json:"subject,omitempty"`
-	Priority   *string  `json:"priority,omitempty"`
-	Status     *string  `json:"status,omitempty"`
-	AssigneeID *int64   `json:"assignee_id,omitempty"`
-	GroupID    *int64   `json:"group_id,omitempty"`
-	Tags       []string `json:"tags,omitempty"`
-	Comment    *struct {
-		Body   string `json:"body"`
-		Public bool   `json:"public"`
-	} `json:"comment,omitempty"`
+	Subject        *string        `json:"subject,omitempty"`
+	Priority       *string        `json:"priority,omitempty"`
+	Status         *string        `json:"status,omitempty"`
+	Type           *string        `json:"type,omitempty"`
+	DueAt          *string        `json:"due_at,omitempty"` // Tasks only
+	AssigneeID     *int64         `json:"assignee_id,omitempty"`
+	GroupID        *int64         `json:"group_id,omitempty"`
+	Tags           []string       `json:"tags,omitempty"`
+	AdditionalTags []string       `json:"additional_tags,omitempty"`
+	RemoveTags     []string       `json:"remove_tags,omitempty"`
+	CustomFields   []CustomField  `json:"custom_fields,omitempty"`
+	Comment        *TicketComment `json:"comment,omitempty"`
 }
 
-// CreateTicket creates a new ticket
-func (c *Client) CreateTicket(ctx context.Context, req CreateTicketRequest) (*Ticket, error) {
-	requestBody := map[string]interface{}{
-		"ticket": map[string]interface{}{
-			"subject": req.Subject,
-			"comment": map[string]interface{}{
-				"body": req.Description,
-			},
-		},
-	}
+// TicketComment represents a comment added as part of a ticket update
+type TicketComment struct {
+	Body    string   `json:"body"`
+	Public  bool     `json:"public"`
+	Uploads []string `json:"uploads,omitempty"`
+}
 
-	// Add optional fields
-	ticket := requestBody["ticket"].(map[string]interface{})
+// Fields returns the ticket fields the request sets, in the form sent to the
+// API. The description becomes the ticket's first comment.
+func (req CreateTicketRequest) Fields() map[string]interface{} {
+	ticket := map[string]interface{}{}
+	if req.Subject != "" {
+		ticket["subject"] = req.Subject
+	}
+	if req.Description != "" || len(req.Uploads) > 0 {
+		comment := map[string]interface{}{}
+		if req.Description != "" {
+			comment["body"] = req.Description
+		}
+		if len(req.Uploads) > 0 {
+			comment["uploads"] = req.Uploads
+		}
+		ticket["comment"] = comment
+	}
 	if req.Priority != "" {
 		ticket["priority"] = req.Priority
 	}
@@ -291,26 +416,93 @@ func (c *Client) CreateTicket(ctx context.Context, req CreateTicketRequest) (*Ti
 	if req.Status != "" {
 		ticket["status"] = req.Status
 	}
+	if req.RequesterID != nil {
+		ticket["requester_id"] = *req.RequesterID
+	}
 	if req.AssigneeID != nil {
 		ticket["assignee_id"] = *req.AssigneeID
 	}
 	if req.GroupID != nil {
 		ticket["group_id"] = *req.GroupID
 	}
+	if req.BrandID != nil {
+		ticket["brand_id"] = *req.BrandID
+	}
 	if len(req.Tags) > 0 {
 		ticket["tags"] = req.Tags
 	}
-
-	body, err := json.Marshal(requestBody)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	if len(req.CustomFields) > 0 {
+		ticket["custom_fields"] = req.CustomFields
+	}
+	if req.DueAt != "" {
+		ticket["due_at"] = req.DueAt
 	}
+	return ticket
+}
+
+// CreateTicket creates a new ticket
+func (c *Client) CreateTicket(ctx context.Context, req CreateTicketRequest) (*Ticket, error) {
+	return c.CreateTicketFromDefinition(ctx, req.Fields())
+}
 
-	return c.makeTicketRequest(ctx, http.MethodPost, "/tickets.json", body)
+// CreateTicketFromDefinition creates a ticket from any fields of the ticket
+// schema, such as a definition read from a file
+func (c *Client) CreateTicketFromDefinition(ctx context.Context, definition map[string]interface{}) (*Ticket, error) {
+	return c.Tickets().Create(ctx, definition)
 }
 
 // UpdateTicket updates an existing ticket
 func (c *Client) UpdateTicket(ctx context.Context, ticketID int64, req UpdateTicketRequest) (*Ticket, error) {
+	return c.Tickets().Update(ctx, ticketID, req)
+}
+
+// UpdateTicketFromDefinition updates a ticket with any fields of the ticket
+// schema, such as a definition read from a file
+func (c *Client) UpdateTicketFromDefinition(ctx context.Context, ticketID int64, definition map[string]interface{}) (*Ticket, error) {
+	return c.Tickets().Update(ctx, ticketID, definition)
+}
+
+// FollowTicket adds a user to a ticket's followers, who are notified of its
+// updates without being CC'd on replies to the requester
+func (c *Client) FollowTicket(ctx context.Context, ticketID, userID int64) (*Ticket, error) {
+	return c.updateTicketFollower(ctx, ticketID, userID, "put")
+}
+
+// UnfollowTicket removes a user from a ticket's followers
+func (c *Client) UnfollowTicket(ctx context.Context, ticketID, userID int64) (*Ticket, error) {
+	return c.updateTicketFollower(ctx, ticketID, userID, "delete")
+}
+
+// updateTicketFollower adds or removes one follower, leaving the others as
+// they are
+func (c *Client) updateTicketFollower(ctx context.Context, ticketID, userID int64, action string) (*Ticket, error) {
+	return c.Tickets().Update(ctx, ticketID, map[string]interface{}{
+		"followers": []map[string]interface{}{
+			{"user_id": userID, "action": action},
+		},
+	})
+}
+
+// DeleteTicket soft-deletes a ticket. Deleted tickets can be restored with
+// RestoreTicket until they are permanently removed.
+func (c *Client) DeleteTicket(ctx context.Context, ticketID int64) error {
+	return c.Tickets().Delete(ctx, ticketID)
+}
+
+// MaxBulkTickets is the maximum number of tickets accepted by update_many
+const MaxBulkTickets = 100
+
+// UpdateManyTickets applies the same update to up to 100 tickets using the
+// batch update endpoint. Zendesk processes the update asynchronously and
+// returns a job status that can be polled with GetJobStatus.
+func (c *Client) UpdateManyTickets(ctx context.Context, ticketIDs []int64, req UpdateTicketRequest) (*JobStatus, error) {
+	if len(ticketIDs) == 0 {
+		return nil, fmt.Errorf("no ticket IDs specified")
+	}
+	if len(ticketIDs) > MaxBulkTickets {
+		return nil, fmt.Errorf("too many tickets: %d (max %d per request)", len(ticketIDs), MaxBulkTickets)
+	}
+
 	requestBody := map[string]interface{}{
 		"ticket": req,
 	}
@@ -320,108 +512,65 @@ func (c *Client) UpdateTicket(ctx context.Context, ticketID int64, req UpdateTic
 		return nil, fmt.Errorf("failed to marshal request: %w", err)
 	}
 
-	path := fmt.Sprintf("/tickets/%d.json", ticketID)
-	ticket, err := c.makeTicketRequest(ctx, http.MethodPut, path, body)
+	ids := make([]string, len(ticketIDs))
+	for i, id := range ticketIDs {
+		ids[i] = strconv.FormatInt(id, 10)
+	}
+
+	path := fmt.Sprintf("/tickets/update_many.json?ids=%s", strings.Join(ids, ","))
+	job, err := c.makeJobStatusRequest(ctx, http.MethodPut, path, body)
 	if err != nil {
 		return nil, err
 	}
 
-	// Invalidate cache for this ticket
+	// Invalidate cache for the affected tickets
 	if c.cache != nil {
-		cacheKey := fmt.Sprintf("%s:tickets:%d", c.subdomain, ticketID)
-		c.cache.Delete(cacheKey)
+		for _, id := range ticketIDs {
+			cacheKey := fmt.Sprintf("%s:tickets:%d", c.subdomain, id)
+			c.cache.Delete(cacheKey)
+		}
 	}
 
-	return ticket, nil
+	return job, nil
 }
 
-// makeTicketRequest makes a request that returns a ticket
-func (c *Client) makeTicketRequest(ctx context.Context, method, path string, body []byte) (*Ticket, error) {
-	url := c.GetBaseURL() + path
-
-	req, err := http.NewRequestWithContext(ctx, method, url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+// ImportManyTickets imports up to 100 historical tickets with the ticket
+// import API, which keeps their original timestamps, comments, and solved
+// states and doesn't fire triggers. With archiveImmediately, closed tickets
+// are archived as they are imported. Zendesk processes the import
+// asynchronously and returns a job status that can be polled with
+// GetJobStatus.
+func (c *Client) ImportManyTickets(ctx context.Context, tickets []map[string]interface{}, archiveImmediately bool) (*JobStatus, error) {
+	if len(tickets) == 0 {
+		return nil, fmt.Errorf("no tickets specified")
 	}
-
-	if body != nil {
-		req.Body = io.NopCloser(strings.NewReader(string(body)))
-		req.ContentLength = int64(len(body))
+	if len(tickets) > MaxBulkTickets {
+		return nil, fmt.Errorf("too many tickets: %d (max %d per request)", len(tickets), MaxBulkTickets)
 	}
 
-	req.Header.Set("Authorization", c.authHeader)
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Accept", "application/json")
-
-	resp, err := c.httpClient.Do(req)
+	body, err := json.Marshal(map[string]interface{}{"tickets": tickets})
 	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
-	}
-	defer resp.Body.Close()
-
-	respBody, _ := io.ReadAll(resp.Body)
-
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("request failed with status %d: %s", resp.StatusCode, string(respBody))
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
 	}
 
-	var ticketResp TicketResponse
-	if err := json.Unmarshal(respBody, &ticketResp); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
+	path := "/imports/tickets/create_many.json"
+	if archiveImmediately {
+		path += "?archive_immediately=true"
 	}
 
-	return &ticketResp.Ticket, nil
+	return c.makeJobStatusRequest(ctx, http.MethodPost, path, body)
 }
 
-// SearchTickets searches for tickets by query
-func (c *Client) SearchTickets(ctx context.Context, query string) ([]Ticket, error) {
-	cacheKey := fmt.Sprintf("%s:tickets:search:%s", c.subdomain, query)
-
-	// Try cache first
-	if c.useCache && c.cache != nil {
-		if cached, found := c.cache.Get(cacheKey); found {
-			var resp struct {
-				Results []Ticket `json:"results"`
-			}
-			if err := json.Unmarshal(cached, &resp); err == nil {
-				return resp.Results, nil
-			}
-		}
-	}
-
+// SearchTickets searches for tickets by query, one page at a time
+func (c *Client) SearchTickets(ctx context.Context, query string, opts SearchOptions) (*TicketSearchResponse, error) {
 	// Build search query - type:ticket is required for ticket search
-	searchQuery := fmt.Sprintf("type:ticket %s", query)
-	path := fmt.Sprintf("/search.json?query=%s", url.QueryEscape(searchQuery))
+	path := searchPath(fmt.Sprintf("type:ticket %s", query), opts)
+	cacheKey := fmt.Sprintf("%s:tickets:search:%s", c.subdomain, path)
 
-	// Fetch from API
-	resp, err := c.makeRequest(ctx, http.MethodGet, path)
-	if err != nil {
+	var resp TicketSearchResponse
+	if err := c.getCached(ctx, cacheKey, path, &resp); err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to search tickets (status %d): %s", resp.StatusCode, string(body))
-	}
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
-	}
-
-	var searchResp struct {
-		Results []Ticket `json:"results"`
-		Count   int      `json:"count"`
-	}
-	if err := json.Unmarshal(body, &searchResp); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
-	}
-
-	// Cache the result
-	if c.useCache && c.cache != nil {
-		c.cache.Set(cacheKey, body)
-	}
 
-	return searchResp.Results, nil
+	return &resp, nil
 }
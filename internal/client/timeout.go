@@ -0,0 +1,100 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// DefaultTimeout is how long a request may take, retries included, unless
+// the instance or --timeout sets another limit
+const DefaultTimeout = 30 * time.Second
+
+// ParseTimeout parses a timeout setting such as "45s" or "2m". An empty
+// value means DefaultTimeout, and 0 means no limit.
+func ParseTimeout(value string) (time.Duration, error) {
+	if value == "" {
+		return DefaultTimeout, nil
+	}
+	if value == "0" {
+		return 0, nil
+	}
+
+	timeout, err := time.ParseDuration(value)
+	if err != nil || timeout < 0 {
+		return 0, fmt.Errorf("invalid timeout %q (use a duration like 45s or 2m, or 0 for no limit)", value)
+	}
+	return timeout, nil
+}
+
+// SetTimeout sets how long each request may take, retries and rate limit
+// waits included. Zero means no limit.
+func (c *Client) SetTimeout(timeout time.Duration) {
+	c.timeout.limit = timeout
+}
+
+// Timeout returns how long each request may take, or zero if there's no limit
+func (c *Client) Timeout() time.Duration {
+	return c.timeout.limit
+}
+
+type noTimeoutKey struct{}
+
+// WithoutTimeout returns a context whose requests aren't held to the
+// per-request timeout. Long operations such as exports and bulk jobs use it,
+// with a deadline of their own on ctx.
+func WithoutTimeout(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noTimeoutKey{}, true)
+}
+
+// timeoutTransport is an http.RoundTripper that cancels requests taking
+// longer than limit, including reading the response body. It wraps the
+// retries, so a request's attempts share one limit.
+type timeoutTransport struct {
+	base  http.RoundTripper
+	limit time.Duration
+}
+
+// newTimeoutTransport wraps base with a per-request timeout of DefaultTimeout
+func newTimeoutTransport(base http.RoundTripper) *timeoutTransport {
+	return &timeoutTransport{base: base, limit: DefaultTimeout}
+}
+
+// RoundTrip sends the request with the timeout applied, unless there's no
+// limit or the request's context is exempt
+func (t *timeoutTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	if t.limit <= 0 || ctx.Value(noTimeoutKey{}) != nil {
+		return t.base.RoundTrip(req)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, t.limit)
+	resp, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		if ctx.Err() == context.DeadlineExceeded && req.Context().Err() == nil {
+			return nil, fmt.Errorf("request timed out after %s (raise it with --timeout): %w", t.limit, err)
+		}
+		return nil, err
+	}
+
+	// The body is read after RoundTrip returns, so the timeout ends when
+	// it's closed
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// cancelOnClose is a response body that releases its request's timeout when
+// closed
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
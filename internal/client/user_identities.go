@@ -0,0 +1,61 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// UserIdentity is one of the ways a user can be reached or sign in, such as
+// an email address or phone number
+type UserIdentity struct {
+	ID        int64  `json:"id"`
+	UserID    int64  `json:"user_id"`
+	Type      string `json:"type"`
+	Value     string `json:"value"`
+	Verified  bool   `json:"verified"`
+	Primary   bool   `json:"primary"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// UserIdentitiesResponse represents the response from listing a user's
+// identities
+type UserIdentitiesResponse struct {
+	Identities []UserIdentity `json:"identities"`
+}
+
+// ListUserIdentities retrieves a user's identities. Identities are not
+// cached since verification changes them.
+func (c *Client) ListUserIdentities(ctx context.Context, userID int64) ([]UserIdentity, error) {
+	body, err := c.getPage(ctx, fmt.Sprintf("/users/%d/identities.json", userID))
+	if err != nil {
+		return nil, err
+	}
+
+	var resp UserIdentitiesResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return resp.Identities, nil
+}
+
+// RequestIdentityVerification sends the user a verification email for one
+// of their email identities
+func (c *Client) RequestIdentityVerification(ctx context.Context, userID, identityID int64) error {
+	path := fmt.Sprintf("/users/%d/identities/%d/request_verification.json", userID, identityID)
+	return c.do(ctx, http.MethodPut, path, nil, nil)
+}
+
+// SetUserPassword sets a user's password. Zendesk only allows this when
+// admins are permitted to set passwords in the account's security settings.
+func (c *Client) SetUserPassword(ctx context.Context, userID int64, password string) error {
+	body, err := json.Marshal(map[string]string{"password": password})
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	return c.do(ctx, http.MethodPost, fmt.Sprintf("/users/%d/password.json", userID), body, nil)
+}
@@ -4,9 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 )
 
@@ -62,138 +62,71 @@ type UserResponse struct {
 func (c *Client) GetMe(ctx context.Context) (*User, error) {
 	cacheKey := fmt.Sprintf("%s:users:me", c.subdomain)
 
-	// Try cache first
-	if c.useCache && c.cache != nil {
-		if cached, found := c.cache.Get(cacheKey); found {
-			var resp UserResponse
-			if err := json.Unmarshal(cached, &resp); err == nil {
-				return &resp.User, nil
-			}
-		}
-	}
-
-	// Fetch from API
-	resp, err := c.makeRequest(ctx, http.MethodGet, "/users/me.json")
-	if err != nil {
+	var resp UserResponse
+	if err := c.getCached(ctx, cacheKey, "/users/me.json", &resp); err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, ParseAPIError(resp.StatusCode, body)
-	}
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
-	}
-
-	var userResp UserResponse
-	if err := json.Unmarshal(body, &userResp); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
-	}
-
-	// Cache the result
-	if c.useCache && c.cache != nil {
-		c.cache.Set(cacheKey, body)
-	}
-
-	return &userResp.User, nil
+	return &resp.User, nil
 }
 
 // ListUsers retrieves a list of users
 func (c *Client) ListUsers(ctx context.Context, page int, perPage int) (*UsersResponse, error) {
 	cacheKey := fmt.Sprintf("%s:users:list:%d:%d", c.subdomain, page, perPage)
-
-	// Try cache first
-	if c.useCache && c.cache != nil {
-		if cached, found := c.cache.Get(cacheKey); found {
-			var resp UsersResponse
-			if err := json.Unmarshal(cached, &resp); err == nil {
-				return &resp, nil
-			}
-		}
-	}
-
-	// Build query parameters
 	path := fmt.Sprintf("/users.json?page=%d&per_page=%d", page, perPage)
 
-	// Fetch from API
-	resp, err := c.makeRequest(ctx, http.MethodGet, path)
-	if err != nil {
+	var resp UsersResponse
+	if err := c.getCached(ctx, cacheKey, path, &resp); err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, ParseAPIError(resp.StatusCode, body)
-	}
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
-	}
-
-	var usersResp UsersResponse
-	if err := json.Unmarshal(body, &usersResp); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
-	}
+	return &resp, nil
+}
 
-	// Cache the result
-	if c.useCache && c.cache != nil {
-		c.cache.Set(cacheKey, body)
-	}
+// ListAllUsers retrieves all users, calling fn with each page of users in order
+func (c *Client) ListAllUsers(ctx context.Context, fn func([]User) error) error {
+	path := "/users.json"
 
-	return &usersResp, nil
+	return c.forEachPage(ctx, path, path, func(body []byte) error {
+		var page UsersResponse
+		if err := json.Unmarshal(body, &page); err != nil {
+			return fmt.Errorf("failed to decode response: %w", err)
+		}
+		return fn(page.Users)
+	})
 }
 
 // SearchUsers searches for users by query
 func (c *Client) SearchUsers(ctx context.Context, query string) ([]User, error) {
 	cacheKey := fmt.Sprintf("%s:users:search:%s", c.subdomain, query)
-
-	// Try cache first
-	if c.useCache && c.cache != nil {
-		if cached, found := c.cache.Get(cacheKey); found {
-			var resp UsersResponse
-			if err := json.Unmarshal(cached, &resp); err == nil {
-				return resp.Users, nil
-			}
-		}
-	}
-
-	// Build query parameters
 	path := fmt.Sprintf("/users/search.json?query=%s", url.QueryEscape(query))
 
-	// Fetch from API
-	resp, err := c.makeRequest(ctx, http.MethodGet, path)
-	if err != nil {
+	var resp UsersResponse
+	if err := c.getCached(ctx, cacheKey, path, &resp); err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, ParseAPIError(resp.StatusCode, body)
-	}
+	return resp.Users, nil
+}
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
-	}
+// FindUserByEmail looks up a user by exact email address, returning nil if
+// no user has that email. The lookup is never cached so that a user created
+// moments ago is always found.
+func (c *Client) FindUserByEmail(ctx context.Context, email string) (*User, error) {
+	path := fmt.Sprintf("/users/search.json?query=%s", url.QueryEscape("email:"+email))
 
-	var usersResp UsersResponse
-	if err := json.Unmarshal(body, &usersResp); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
+	var resp UsersResponse
+	if err := c.do(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return nil, err
 	}
 
-	// Cache the result
-	if c.useCache && c.cache != nil {
-		c.cache.Set(cacheKey, body)
+	for _, user := range resp.Users {
+		if strings.EqualFold(user.Email, email) {
+			return &user, nil
+		}
 	}
 
-	return usersResp.Users, nil
+	return nil, nil
 }
 
 // CreateUserRequest represents a user creation request
@@ -215,216 +148,194 @@ type UpdateUserRequest struct {
 
 // CreateUser creates a new user
 func (c *Client) CreateUser(ctx context.Context, req CreateUserRequest) (*User, error) {
-	requestBody := map[string]interface{}{
-		"user": req,
-	}
-
-	body, err := json.Marshal(requestBody)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal request: %w", err)
-	}
-
-	return c.makeUserRequest(ctx, http.MethodPost, "/users.json", body)
+	return c.Users().Create(ctx, req)
 }
 
 // UpdateUser updates an existing user
 func (c *Client) UpdateUser(ctx context.Context, userID int64, req UpdateUserRequest) (*User, error) {
-	requestBody := map[string]interface{}{
-		"user": req,
-	}
-
-	body, err := json.Marshal(requestBody)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal request: %w", err)
-	}
-
-	path := fmt.Sprintf("/users/%d.json", userID)
-	user, err := c.makeUserRequest(ctx, http.MethodPut, path, body)
-	if err != nil {
-		return nil, err
-	}
-
-	// Invalidate cache for this user
-	if c.cache != nil {
-		cacheKey := fmt.Sprintf("%s:users:%d", c.subdomain, userID)
-		c.cache.Delete(cacheKey)
-	}
-
-	return user, nil
+	return c.Users().Update(ctx, userID, req)
 }
 
 // SuspendUser suspends a user
 func (c *Client) SuspendUser(ctx context.Context, userID int64) (*User, error) {
-	path := fmt.Sprintf("/users/%d.json", userID)
+	return c.Users().Update(ctx, userID, map[string]interface{}{"suspended": true})
+}
+
+// MaxBulkUsers is the maximum number of users accepted by update_many
+const MaxBulkUsers = 100
 
-	requestBody := map[string]interface{}{
-		"user": map[string]interface{}{
-			"suspended": true,
-		},
+// UpdateManyUsers sets the same fields, such as {"suspended": true}, on up
+// to 100 users using the batch update endpoint. Zendesk processes the update
+// asynchronously and returns a job status that can be polled with
+// GetJobStatus.
+func (c *Client) UpdateManyUsers(ctx context.Context, userIDs []int64, fields map[string]interface{}) (*JobStatus, error) {
+	if len(userIDs) == 0 {
+		return nil, fmt.Errorf("no user IDs specified")
+	}
+	if len(userIDs) > MaxBulkUsers {
+		return nil, fmt.Errorf("too many users: %d (max %d per request)", len(userIDs), MaxBulkUsers)
 	}
 
-	body, err := json.Marshal(requestBody)
+	body, err := json.Marshal(map[string]interface{}{"user": fields})
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal request: %w", err)
 	}
 
-	user, err := c.makeUserRequest(ctx, http.MethodPut, path, body)
+	path := "/users/update_many.json?ids=" + joinIDs(userIDs)
+	job, err := c.makeJobStatusRequest(ctx, http.MethodPut, path, body)
 	if err != nil {
 		return nil, err
 	}
 
-	// Invalidate cache
+	// Invalidate cache for the affected users
 	if c.cache != nil {
-		cacheKey := fmt.Sprintf("%s:users:%d", c.subdomain, userID)
-		c.cache.Delete(cacheKey)
+		for _, id := range userIDs {
+			c.cache.Delete(fmt.Sprintf("%s:users:%d", c.subdomain, id))
+		}
+		if _, ok := fields["organization_id"]; ok {
+			c.cache.Invalidate(fmt.Sprintf("%s:organizations:*:users:*", c.subdomain))
+		}
 	}
 
-	return user, nil
+	return job, nil
 }
 
 // UnsuspendUser unsuspends a user
 func (c *Client) UnsuspendUser(ctx context.Context, userID int64) (*User, error) {
-	path := fmt.Sprintf("/users/%d.json", userID)
-
-	requestBody := map[string]interface{}{
-		"user": map[string]interface{}{
-			"suspended": false,
-		},
-	}
-
-	body, err := json.Marshal(requestBody)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal request: %w", err)
-	}
-
-	user, err := c.makeUserRequest(ctx, http.MethodPut, path, body)
-	if err != nil {
-		return nil, err
-	}
-
-	// Invalidate cache
-	if c.cache != nil {
-		cacheKey := fmt.Sprintf("%s:users:%d", c.subdomain, userID)
-		c.cache.Delete(cacheKey)
-	}
-
-	return user, nil
+	return c.Users().Update(ctx, userID, map[string]interface{}{"suspended": false})
 }
 
 // DeleteUser deletes a user
 func (c *Client) DeleteUser(ctx context.Context, userID int64) error {
-	path := fmt.Sprintf("/users/%d.json", userID)
-
-	url := c.GetBaseURL() + path
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url, nil)
-	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
-	}
-
-	req.Header.Set("Authorization", c.authHeader)
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Accept", "application/json")
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return fmt.Errorf("request failed: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
-		body, _ := io.ReadAll(resp.Body)
-		return ParseAPIError(resp.StatusCode, body)
-	}
-
-	// Invalidate cache
-	if c.cache != nil {
-		cacheKey := fmt.Sprintf("%s:users:%d", c.subdomain, userID)
-		c.cache.Delete(cacheKey)
-	}
-
-	return nil
+	return c.Users().Delete(ctx, userID)
 }
 
-// makeUserRequest makes a request that returns a user
-func (c *Client) makeUserRequest(ctx context.Context, method, path string, body []byte) (*User, error) {
-	url := c.GetBaseURL() + path
+// UserTicketsRequested, UserTicketsAssigned, and UserTicketsCCd select which
+// of a user's tickets GetUserTickets returns
+const (
+	UserTicketsRequested = "requested"
+	UserTicketsAssigned  = "assigned"
+	UserTicketsCCd       = "ccd"
+)
 
-	req, err := http.NewRequestWithContext(ctx, method, url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
+// GetUserTickets retrieves tickets a user requested, is assigned to, or is
+// CC'd on, depending on kind. SLA policy metrics are sideloaded so callers
+// can spot tickets close to breaching.
+func (c *Client) GetUserTickets(ctx context.Context, userID int64, kind string, page int, perPage int) (*TicketsResponse, error) {
+	cacheKey := fmt.Sprintf("%s:users:%d:tickets:%s:%d:%d", c.subdomain, userID, kind, page, perPage)
+	path := fmt.Sprintf("/users/%d/tickets/%s.json?include=slas&page=%d&per_page=%d", userID, kind, page, perPage)
 
-	if body != nil {
-		req.Body = io.NopCloser(strings.NewReader(string(body)))
-		req.ContentLength = int64(len(body))
+	var resp TicketsResponse
+	if err := c.getCached(ctx, cacheKey, path, &resp); err != nil {
+		return nil, err
 	}
 
-	req.Header.Set("Authorization", c.authHeader)
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Accept", "application/json")
+	return &resp, nil
+}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
-	}
-	defer resp.Body.Close()
+// UserRelated counts the tickets and subscriptions tied to a user
+type UserRelated struct {
+	RequestedTickets          int `json:"requested_tickets"`
+	AssignedTickets           int `json:"assigned_tickets"`
+	CCDTickets                int `json:"ccd_tickets"`
+	OrganizationSubscriptions int `json:"organization_subscriptions"`
+}
 
-	respBody, _ := io.ReadAll(resp.Body)
+// UserRelatedResponse represents the response from getting a user's
+// related information
+type UserRelatedResponse struct {
+	UserRelated UserRelated `json:"user_related"`
+}
 
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, ParseAPIError(resp.StatusCode, respBody)
-	}
+// GetUserRelated retrieves counts of the tickets a user requested, is
+// assigned to, or is CC'd on
+func (c *Client) GetUserRelated(ctx context.Context, userID int64) (*UserRelated, error) {
+	cacheKey := fmt.Sprintf("%s:users:%d:related", c.subdomain, userID)
 
-	var userResp UserResponse
-	if err := json.Unmarshal(respBody, &userResp); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
+	var resp UserRelatedResponse
+	if err := c.getCached(ctx, cacheKey, fmt.Sprintf("/users/%d/related.json", userID), &resp); err != nil {
+		return nil, err
 	}
 
-	return &userResp.User, nil
+	return &resp.UserRelated, nil
 }
 
 // GetUser retrieves a specific user by ID
 func (c *Client) GetUser(ctx context.Context, userID int64) (*User, error) {
-	cacheKey := fmt.Sprintf("%s:users:%d", c.subdomain, userID)
+	return c.Users().Get(ctx, userID)
+}
+
+// showManyLimit is the most IDs a show_many request accepts
+const showManyLimit = 100
+
+// GetUsersByIDs retrieves several users at once with /users/show_many.json.
+// Users already cached are served from the cache, and fetched users are
+// cached individually so later GetUser calls hit the cache.
+func (c *Client) GetUsersByIDs(ctx context.Context, userIDs []int64) ([]User, error) {
+	var users []User
+	var missing []int64
 
 	// Try cache first
-	if c.useCache && c.cache != nil {
-		if cached, found := c.cache.Get(cacheKey); found {
-			var resp UserResponse
-			if err := json.Unmarshal(cached, &resp); err == nil {
-				return &resp.User, nil
+	for _, userID := range uniqueIDs(userIDs) {
+		if c.useCache && c.cache != nil {
+			cacheKey := fmt.Sprintf("%s:users:%d", c.subdomain, userID)
+			if cached, found := c.cache.Get(cacheKey); found {
+				var resp UserResponse
+				if err := json.Unmarshal(cached, &resp); err == nil {
+					users = append(users, resp.User)
+					continue
+				}
 			}
 		}
+		missing = append(missing, userID)
 	}
 
-	// Fetch from API
-	path := fmt.Sprintf("/users/%d.json", userID)
-	resp, err := c.makeRequest(ctx, http.MethodGet, path)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
+	for start := 0; start < len(missing); start += showManyLimit {
+		end := min(start+showManyLimit, len(missing))
 
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to get user (status %d): %s", resp.StatusCode, string(body))
-	}
+		body, err := c.getPage(ctx, "/users/show_many.json?ids="+joinIDs(missing[start:end]))
+		if err != nil {
+			return nil, err
+		}
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
-	}
+		var usersResp UsersResponse
+		if err := json.Unmarshal(body, &usersResp); err != nil {
+			return nil, fmt.Errorf("failed to decode response: %w", err)
+		}
 
-	var userResp UserResponse
-	if err := json.Unmarshal(body, &userResp); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
+		for _, user := range usersResp.Users {
+			// Cache each user under its own key
+			if c.useCache && c.cache != nil {
+				if data, err := json.Marshal(UserResponse{User: user}); err == nil {
+					c.cache.Set(fmt.Sprintf("%s:users:%d", c.subdomain, user.ID), data)
+				}
+			}
+			users = append(users, user)
+		}
 	}
 
-	// Cache the result
-	if c.useCache && c.cache != nil {
-		c.cache.Set(cacheKey, body)
+	return users, nil
+}
+
+// uniqueIDs returns ids without zeros or duplicates, in their original order
+func uniqueIDs(ids []int64) []int64 {
+	seen := make(map[int64]bool, len(ids))
+	var unique []int64
+	for _, id := range ids {
+		if id == 0 || seen[id] {
+			continue
+		}
+		seen[id] = true
+		unique = append(unique, id)
 	}
+	return unique
+}
 
-	return &userResp.User, nil
+// joinIDs formats ids as a comma-separated list for query parameters
+func joinIDs(ids []int64) string {
+	parts := make([]string, len(ids))
+	for i, id := range ids {
+		parts[i] = strconv.FormatInt(id, 10)
+	}
+	return strings.Join(parts, ",")
 }
@@ -0,0 +1,99 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// View represents a Zendesk view
+type View struct {
+	ID          int64       `json:"id"`
+	URL         string      `json:"url"`
+	Title       string      `json:"title"`
+	Active      bool        `json:"active"`
+	Description string      `json:"description"`
+	Position    int         `json:"position"`
+	Default     bool        `json:"default"`
+	Restriction interface{} `json:"restriction"`
+	Conditions  struct {
+		All []ViewCondition `json:"all"`
+		Any []ViewCondition `json:"any"`
+	} `json:"conditions"`
+	Execution struct {
+		GroupBy    *string `json:"group_by"`
+		GroupOrder string  `json:"group_order"`
+		SortBy     string  `json:"sort_by"`
+		SortOrder  string  `json:"sort_order"`
+	} `json:"execution"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// ViewCondition represents a single view condition
+type ViewCondition struct {
+	Field    string      `json:"field"`
+	Operator string      `json:"operator"`
+	Value    interface{} `json:"value"`
+}
+
+// ViewsResponse represents the response from listing views
+type ViewsResponse struct {
+	Views        []View `json:"views"`
+	NextPage     string `json:"next_page"`
+	PreviousPage string `json:"previous_page"`
+	Count        int    `json:"count"`
+}
+
+// ViewResponse represents a single view response
+type ViewResponse struct {
+	View View `json:"view"`
+}
+
+// ListViews retrieves a list of views
+func (c *Client) ListViews(ctx context.Context, page int, perPage int, activeOnly bool) (*ViewsResponse, error) {
+	cacheKey := fmt.Sprintf("%s:views:list:%d:%d:%t", c.subdomain, page, perPage, activeOnly)
+
+	// Build query parameters
+	path := fmt.Sprintf("/views.json?page=%d&per_page=%d", page, perPage)
+	if activeOnly {
+		path += "&active=true"
+	}
+
+	body, err := c.fetchCached(ctx, cacheKey, path)
+	if err != nil {
+		return nil, err
+	}
+
+	var viewsResp ViewsResponse
+	if err := json.Unmarshal(body, &viewsResp); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &viewsResp, nil
+}
+
+// GetView retrieves a specific view by ID
+func (c *Client) GetView(ctx context.Context, viewID int64) (*View, error) {
+	return c.Views().Get(ctx, viewID)
+}
+
+// GetViewTickets retrieves the tickets currently in a view
+func (c *Client) GetViewTickets(ctx context.Context, viewID int64, page int, perPage int) (*TicketsResponse, error) {
+	cacheKey := fmt.Sprintf("%s:views:%d:tickets:%d:%d", c.subdomain, viewID, page, perPage)
+
+	// Build query parameters
+	path := fmt.Sprintf("/views/%d/tickets.json?page=%d&per_page=%d", viewID, page, perPage)
+
+	body, err := c.fetchCached(ctx, cacheKey, path)
+	if err != nil {
+		return nil, err
+	}
+
+	var ticketsResp TicketsResponse
+	if err := json.Unmarshal(body, &ticketsResp); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &ticketsResp, nil
+}
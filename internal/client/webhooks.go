@@ -0,0 +1,223 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Webhook represents a Zendesk webhook, an HTTP endpoint that triggers,
+// automations, and event subscriptions send requests to
+type Webhook struct {
+	ID             string                 `json:"id"`
+	Name           string                 `json:"name"`
+	Description    string                 `json:"description,omitempty"`
+	Endpoint       string                 `json:"endpoint"`
+	HTTPMethod     string                 `json:"http_method"`
+	RequestFormat  string                 `json:"request_format"`
+	Status         string                 `json:"status"`
+	Subscriptions  []string               `json:"subscriptions,omitempty"`
+	Authentication *WebhookAuthentication `json:"authentication,omitempty"`
+	CustomHeaders  map[string]string      `json:"custom_headers,omitempty"`
+	CreatedAt      string                 `json:"created_at,omitempty"`
+	CreatedBy      string                 `json:"created_by,omitempty"`
+	UpdatedAt      string                 `json:"updated_at,omitempty"`
+	UpdatedBy      string                 `json:"updated_by,omitempty"`
+}
+
+// WebhookAuthentication is how a webhook authenticates to its endpoint:
+// basic_auth (data holds username and password), bearer_token (data holds
+// token), or api_key (data holds name and value)
+type WebhookAuthentication struct {
+	Type        string            `json:"type"`
+	AddPosition string            `json:"add_position"`
+	Data        map[string]string `json:"data,omitempty"`
+}
+
+// WebhookResponse represents a single webhook response
+type WebhookResponse struct {
+	Webhook Webhook `json:"webhook"`
+}
+
+// WebhookSigningSecret is the secret a webhook signs its requests with, so
+// the endpoint can verify they came from Zendesk
+type WebhookSigningSecret struct {
+	Secret    string `json:"secret"`
+	Algorithm string `json:"algorithm"`
+}
+
+// WebhookInvocation is one event a webhook was invoked for, which may have
+// taken several attempts to deliver
+type WebhookInvocation struct {
+	ID                     string `json:"id"`
+	CreatedAt              string `json:"created_at"`
+	LatestCompletionStatus string `json:"latest_completion_status"`
+}
+
+// WebhookInvocationAttempt is one attempt to deliver a webhook invocation
+type WebhookInvocationAttempt struct {
+	ID           string `json:"id"`
+	InvocationID string `json:"invocation_id"`
+	Status       string `json:"status"`
+	CompletedAt  string `json:"completed_at"`
+	Response     struct {
+		Status  int               `json:"status"`
+		Headers map[string]string `json:"headers"`
+		Body    string            `json:"body"`
+	} `json:"response"`
+}
+
+// WebhookTestRequest is the request a webhook test sends. Endpoint,
+// HTTPMethod, and RequestFormat are needed when testing a webhook that
+// doesn't exist yet; Payload defaults to a sample payload.
+type WebhookTestRequest struct {
+	Endpoint       string                 `json:"endpoint,omitempty"`
+	HTTPMethod     string                 `json:"http_method,omitempty"`
+	RequestFormat  string                 `json:"request_format,omitempty"`
+	Authentication *WebhookAuthentication `json:"authentication,omitempty"`
+	Payload        string                 `json:"payload,omitempty"`
+}
+
+// WebhookTestResponse is what the endpoint answered to a webhook test
+type WebhookTestResponse struct {
+	Status  int               `json:"status"`
+	Headers map[string]string `json:"headers"`
+	Body    string            `json:"body"`
+}
+
+// ListWebhooks retrieves every webhook
+func (c *Client) ListWebhooks(ctx context.Context) ([]Webhook, error) {
+	var webhooks []Webhook
+	err := c.forEachCursorPage(ctx, "/webhooks", func(body []byte) error {
+		var page struct {
+			Webhooks []Webhook `json:"webhooks"`
+		}
+		if err := json.Unmarshal(body, &page); err != nil {
+			return fmt.Errorf("failed to decode response: %w", err)
+		}
+		webhooks = append(webhooks, page.Webhooks...)
+		return nil
+	})
+	return webhooks, err
+}
+
+// GetWebhook retrieves a webhook by ID
+func (c *Client) GetWebhook(ctx context.Context, webhookID string) (*Webhook, error) {
+	var resp WebhookResponse
+	cacheKey := fmt.Sprintf("%s:webhooks:%s", c.subdomain, webhookID)
+	if err := c.getCached(ctx, cacheKey, "/webhooks/"+url.PathEscape(webhookID), &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Webhook, nil
+}
+
+// CreateWebhook creates a webhook
+func (c *Client) CreateWebhook(ctx context.Context, webhook Webhook) (*Webhook, error) {
+	body, err := json.Marshal(map[string]interface{}{"webhook": webhook})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	var resp WebhookResponse
+	if err := c.do(ctx, http.MethodPost, "/webhooks", body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Webhook, nil
+}
+
+// DeleteWebhook deletes a webhook
+func (c *Client) DeleteWebhook(ctx context.Context, webhookID string) error {
+	if err := c.do(ctx, http.MethodDelete, "/webhooks/"+url.PathEscape(webhookID), nil, nil); err != nil {
+		return err
+	}
+
+	if c.cache != nil {
+		c.cache.Delete(fmt.Sprintf("%s:webhooks:%s", c.subdomain, webhookID))
+	}
+
+	return nil
+}
+
+// TestWebhook sends a test request through an existing webhook, or through
+// the endpoint described by req when webhookID is empty, and returns what
+// the endpoint answered
+func (c *Client) TestWebhook(ctx context.Context, webhookID string, req WebhookTestRequest) (*WebhookTestResponse, error) {
+	body, err := json.Marshal(map[string]interface{}{"request": req})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	path := "/webhooks/test"
+	if webhookID != "" {
+		path += "?webhook_id=" + url.QueryEscape(webhookID)
+	}
+
+	var resp struct {
+		Response WebhookTestResponse `json:"response"`
+	}
+	if err := c.do(ctx, http.MethodPost, path, body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Response, nil
+}
+
+// GetWebhookSigningSecret retrieves the secret a webhook signs its requests with
+func (c *Client) GetWebhookSigningSecret(ctx context.Context, webhookID string) (*WebhookSigningSecret, error) {
+	body, err := c.getPage(ctx, "/webhooks/"+url.PathEscape(webhookID)+"/signing_secret")
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		SigningSecret WebhookSigningSecret `json:"signing_secret"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	return &resp.SigningSecret, nil
+}
+
+// ListWebhookInvocations retrieves up to limit (at most 100) of a webhook's
+// most recent invocations, newest first, optionally only those whose latest
+// attempt had status (success, failed, or circuit_broken)
+func (c *Client) ListWebhookInvocations(ctx context.Context, webhookID, status string, limit int) ([]WebhookInvocation, error) {
+	params := url.Values{}
+	params.Set("sort", "-created_at")
+	params.Set("page[size]", fmt.Sprint(min(max(limit, 1), 100)))
+	if status != "" {
+		params.Set("filter[status]", status)
+	}
+
+	body, err := c.getPage(ctx, "/webhooks/"+url.PathEscape(webhookID)+"/invocations?"+params.Encode())
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Invocations []WebhookInvocation `json:"invocations"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	return resp.Invocations, nil
+}
+
+// ListWebhookInvocationAttempts retrieves the delivery attempts of one
+// webhook invocation, with what the endpoint answered to each
+func (c *Client) ListWebhookInvocationAttempts(ctx context.Context, webhookID, invocationID string) ([]WebhookInvocationAttempt, error) {
+	path := fmt.Sprintf("/webhooks/%s/invocations/%s/attempts", url.PathEscape(webhookID), url.PathEscape(invocationID))
+	body, err := c.getPage(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Attempts []WebhookInvocationAttempt `json:"attempts"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	return resp.Attempts, nil
+}
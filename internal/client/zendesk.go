@@ -1,12 +1,13 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
-	"time"
 
 	"zd-cli/internal/auth"
 	"zd-cli/internal/cache"
@@ -15,24 +16,49 @@ import (
 
 // Client wraps the Zendesk API client
 type Client struct {
-	subdomain  string
-	httpClient *http.Client
-	authHeader string
-	cache      *cache.Cache
-	useCache   bool
+	subdomain   string
+	httpClient  *http.Client
+	authHeader  string
+	cache       *cache.Cache
+	useCache    bool
+	flights     flightGroup
+	retry       *retryTransport
+	debug       *debugTransport
+	timeout     *timeoutTransport
+	guard       *guardTransport
+	concurrency int
+	onListTotal func(total int)
 }
 
-// NewClient creates a new Zendesk API client from an instance configuration
+// NewClient creates a new Zendesk API client from an instance configuration,
+// caching with the instance's cache_ttl setting
 func NewClient(instance *config.Instance) (*Client, error) {
-	return NewClientWithCache(instance, true)
+	ttl, err := cache.ParseTTL(instance.CacheTTL)
+	if err != nil {
+		return nil, err
+	}
+	return NewClientWithCache(instance, true, ttl)
 }
 
-// NewClientWithCache creates a new Zendesk API client with optional caching
-func NewClientWithCache(instance *config.Instance, useCache bool) (*Client, error) {
+// NewClientWithCache creates a new Zendesk API client with optional caching.
+// ttl sets how long responses are cached.
+func NewClientWithCache(instance *config.Instance, useCache bool, ttl cache.TTL) (*Client, error) {
+	// The write guard wraps the timeout wraps retries wrap rate limiting wraps
+	// logging, so every attempt is throttled and logged, all of them share
+	// one timeout, and a refused write is never sent
+	debug := newDebugTransport(http.DefaultTransport)
+	retry := newRetryTransport(&rateLimitTransport{base: debug}, DefaultRetryConfig())
+	timeout := newTimeoutTransport(retry)
+	guard := &guardTransport{base: timeout}
 	client := &Client{
-		subdomain:  instance.Subdomain,
-		httpClient: &http.Client{Timeout: 30 * time.Second},
-		useCache:   useCache,
+		subdomain:   instance.Subdomain,
+		httpClient:  &http.Client{Transport: guard},
+		useCache:    useCache,
+		retry:       retry,
+		debug:       debug,
+		timeout:     timeout,
+		guard:       guard,
+		concurrency: DefaultConcurrency,
 	}
 
 	switch instance.AuthType {
@@ -47,7 +73,7 @@ func NewClientWithCache(instance *config.Instance, useCache bool) (*Client, erro
 		if err := auth.ValidateOAuthToken(instance.OAuthToken, instance.OAuthRefresh, instance.OAuthExpiry); err != nil {
 			return nil, err
 		}
-		if err := auth.ValidateOAuthConfig(instance.OAuthClientID, instance.OAuthSecret); err != nil {
+		if err := auth.ValidateOAuthConfig(instance.OAuthClientID, instance.OAuthSecret, instance.OAuthPKCE); err != nil {
 			return nil, err
 		}
 		client.authHeader = fmt.Sprintf("Bearer %s", instance.OAuthToken)
@@ -56,9 +82,9 @@ func NewClientWithCache(instance *config.Instance, useCache bool) (*Client, erro
 		return nil, fmt.Errorf("unsupported auth type: %s", instance.AuthType)
 	}
 
-	// Initialize cache with default TTL
+	// Initialize cache
 	if useCache {
-		c, err := cache.New(cache.DefaultTTL)
+		c, err := cache.New(ttl)
 		if err != nil {
 			// Cache initialization failed, continue without cache
 			client.useCache = false
@@ -70,13 +96,65 @@ func NewClientWithCache(instance *config.Instance, useCache bool) (*Client, erro
 	return client, nil
 }
 
+// SetMaxRetries sets how many times rate limited and failed requests are
+// retried. Zero disables retries.
+func (c *Client) SetMaxRetries(maxRetries int) {
+	c.retry.config.MaxRetries = maxRetries
+}
+
+// SetTransport sends requests through rt instead of the network, such as a
+// zdtest server or cassette. Timeouts, retries, rate limiting, and debug
+// logging still apply on top of it.
+func (c *Client) SetTransport(rt http.RoundTripper) {
+	c.debug.base = rt
+}
+
+// HTTPClient returns the HTTP client requests are sent with
+func (c *Client) HTTPClient() *http.Client {
+	return c.httpClient
+}
+
+// Subdomain returns the Zendesk subdomain of the instance
+func (c *Client) Subdomain() string {
+	return c.subdomain
+}
+
 // GetBaseURL returns the base API URL for the instance
 func (c *Client) GetBaseURL() string {
 	return fmt.Sprintf("https://%s.zendesk.com/api/v2", c.subdomain)
 }
 
-// makeRequest makes an HTTP request to the Zendesk API
-func (c *Client) makeRequest(ctx context.Context, method, path string) (*http.Response, error) {
+// AgentURL returns the agent interface URL of a resource, where resource is
+// "tickets", "users", or "organizations"
+func (c *Client) AgentURL(resource string, id int64) string {
+	return fmt.Sprintf("https://%s.zendesk.com/agent/%s/%d", c.subdomain, resource, id)
+}
+
+// makeRequest makes an HTTP request to the Zendesk API, with body as the
+// JSON request body if it isn't nil. Concurrent identical GET requests are
+// coalesced into one API call.
+func (c *Client) makeRequest(ctx context.Context, method, path string, body []byte) (*http.Response, error) {
+	if method != http.MethodGet {
+		return c.doRequest(ctx, method, path, body, nil)
+	}
+
+	result, err := c.flights.do(path, func() (*flightResult, error) {
+		resp, err := c.doRequest(ctx, method, path, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		return bufferResponse(resp)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.response(), nil
+}
+
+// doRequest sends one HTTP request to the Zendesk API, with body as the JSON
+// request body if it isn't nil, and any extra headers in header
+func (c *Client) doRequest(ctx context.Context, method, path string, body []byte, header http.Header) (*http.Response, error) {
 	url := c.GetBaseURL() + path
 
 	req, err := http.NewRequestWithContext(ctx, method, url, nil)
@@ -84,74 +162,159 @@ func (c *Client) makeRequest(ctx context.Context, method, path string) (*http.Re
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
+	if body != nil {
+		setBody(req, body)
+	}
+
+	for name, values := range header {
+		req.Header[name] = values
+	}
 	req.Header.Set("Authorization", c.authHeader)
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
+		var refused *refusedError
+		if errors.As(err, &refused) {
+			return nil, refused.err
+		}
 		return nil, fmt.Errorf("request failed: %w", err)
 	}
 
 	return resp, nil
 }
 
-// TestConnection tests the connection to the Zendesk instance
-func (c *Client) TestConnection(ctx context.Context) error {
-	resp, err := c.makeRequest(ctx, http.MethodGet, "/users/me.json")
-	if err != nil {
-		return fmt.Errorf("connection test failed: %w", err)
+// setBody sets a request body that can be rewound, so the request can be retried
+func setBody(req *http.Request, body []byte) {
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.ContentLength = int64(len(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
 	}
-	defer resp.Body.Close()
+}
 
-	body, _ := io.ReadAll(resp.Body)
+// getCached fetches path into v, reading from and filling the cache under
+// cacheKey
+func (c *Client) getCached(ctx context.Context, cacheKey, path string, v interface{}) error {
+	body, err := c.fetchCached(ctx, cacheKey, path)
+	if err != nil {
+		return err
+	}
 
-	if resp.StatusCode != http.StatusOK {
-		return ParseAPIError(resp.StatusCode, body)
+	if err := json.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
 	}
 
 	return nil
 }
 
-// GetCurrentUser retrieves information about the authenticated user
-func (c *Client) GetCurrentUser(ctx context.Context) (map[string]interface{}, error) {
-	cacheKey := fmt.Sprintf("%s:users:me", c.subdomain)
+// fetchCached returns the body of a GET of path, from the cache under
+// cacheKey while the entry is fresh. An expired entry stored with an ETag or
+// Last-Modified date is revalidated with a conditional request, so an
+// unchanged resource costs a 304 Not Modified rather than a full response.
+func (c *Client) fetchCached(ctx context.Context, cacheKey, path string) ([]byte, error) {
+	if !c.useCache || c.cache == nil {
+		return c.getPage(ctx, path)
+	}
 
-	// Try cache first
-	if c.useCache && c.cache != nil {
-		if cached, found := c.cache.Get(cacheKey); found {
-			var result map[string]interface{}
-			if err := json.Unmarshal(cached, &result); err == nil {
-				return result, nil
-			}
+	if cached, found := c.cache.Get(cacheKey); found {
+		return cached, nil
+	}
+
+	header := http.Header{}
+	stale, validators, revalidate := c.cache.Stale(cacheKey)
+	if revalidate {
+		if validators.ETag != "" {
+			header.Set("If-None-Match", validators.ETag)
+		}
+		if validators.LastModified != "" {
+			header.Set("If-Modified-Since", validators.LastModified)
 		}
 	}
 
-	// Cache miss or disabled, fetch from API
-	resp, err := c.makeRequest(ctx, http.MethodGet, "/users/me.json")
+	// Coalesce like makeRequest, but only with requests carrying the same
+	// validators, since they decide whether the answer is a 304
+	flightKey := path + "\x00" + validators.ETag + "\x00" + validators.LastModified
+	result, err := c.flights.do(flightKey, func() (*flightResult, error) {
+		resp, err := c.doRequest(ctx, http.MethodGet, path, nil, header)
+		if err != nil {
+			return nil, err
+		}
+		return bufferResponse(resp)
+	})
 	if err != nil {
 		return nil, err
 	}
+
+	if result.statusCode == http.StatusNotModified && revalidate {
+		c.cache.Renew(cacheKey)
+		return stale, nil
+	}
+	if result.statusCode != http.StatusOK {
+		return nil, ParseAPIError(result.statusCode, result.body)
+	}
+
+	c.cache.SetWithValidators(cacheKey, result.body, cache.Validators{
+		ETag:         result.header.Get("ETag"),
+		LastModified: result.header.Get("Last-Modified"),
+	})
+
+	return result.body, nil
+}
+
+// do sends a request with body as the JSON request body, if it isn't nil,
+// and decodes the response into v, if v isn't nil. Statuses outside 2xx are
+// returned as API errors.
+func (c *Client) do(ctx context.Context, method, path string, body []byte, v interface{}) error {
+	resp, err := c.makeRequest(ctx, method, path, body)
+	if err != nil {
+		return err
+	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to get current user (status %d): %s", resp.StatusCode, string(body))
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return ParseAPIError(resp.StatusCode, respBody)
+	}
+
+	if v != nil && len(respBody) > 0 {
+		if err := json.Unmarshal(respBody, v); err != nil {
+			return fmt.Errorf("failed to decode response: %w", err)
+		}
 	}
 
-	body, err := io.ReadAll(resp.Body)
+	return nil
+}
+
+// TestConnection tests the connection to the Zendesk instance
+func (c *Client) TestConnection(ctx context.Context) error {
+	resp, err := c.makeRequest(ctx, http.MethodGet, "/users/me.json", nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
+		return fmt.Errorf("connection test failed: %w", err)
 	}
+	defer resp.Body.Close()
 
-	var result map[string]interface{}
-	if err := json.Unmarshal(body, &result); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
+	body, _ := io.ReadAll(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return ParseAPIError(resp.StatusCode, body)
 	}
 
-	// Cache the result
-	if c.useCache && c.cache != nil {
-		c.cache.Set(cacheKey, body)
+	return nil
+}
+
+// GetCurrentUser retrieves information about the authenticated user
+func (c *Client) GetCurrentUser(ctx context.Context) (map[string]interface{}, error) {
+	cacheKey := fmt.Sprintf("%s:users:me", c.subdomain)
+
+	var result map[string]interface{}
+	if err := c.getCached(ctx, cacheKey, "/users/me.json", &result); err != nil {
+		return nil, err
 	}
 
 	return result, nil
@@ -0,0 +1,147 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"zd-cli/internal/client"
+	"zd-cli/internal/output"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// activityRow is an activity flattened for CSV output
+type activityRow struct {
+	ID        int64  `json:"id"`
+	Verb      string `json:"verb"`
+	Title     string `json:"title"`
+	ActorID   int64  `json:"actor_id"`
+	Actor     string `json:"actor"`
+	TicketID  int64  `json:"ticket_id"`
+	CreatedAt string `json:"created_at"`
+}
+
+// NewActivityCommand creates the activity command
+func NewActivityCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "activity",
+		Short: "Show what happened to your tickets",
+		Long: `Show the activity feed of the authenticated agent: tickets assigned to
+you, comments on tickets you follow, priority increases, and so on.`,
+	}
+
+	cmd.AddCommand(newActivityListCommand())
+
+	// Add global output format flag to all subcommands
+	cmd.PersistentFlags().StringP("output", "o", "table", "Output format: table, json, csv")
+
+	return cmd
+}
+
+func newActivityListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List recent activities, newest first",
+		Long: `List recent activities affecting you, newest first. Examples:
+  zd activity list
+  zd activity list --since 24h
+  zd activity list --since 2026-10-01 -o csv`,
+		Args: cobra.NoArgs,
+		RunE: withClient(runActivityList),
+	}
+
+	cmd.Flags().String("since", "", "Only activities since: relative (24h, 7d), YYYY-MM-DD, RFC3339, or Unix timestamp")
+	cmd.Flags().Int("page", 1, "Page number")
+	cmd.Flags().Int("per-page", 25, "Results per page (max 100)")
+
+	return cmd
+}
+
+func runActivityList(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	var since time.Time
+	if value, _ := cmd.Flags().GetString("since"); value != "" {
+		var err error
+		since, err = parseReportSince(value)
+		if err != nil {
+			return err
+		}
+	}
+
+	page, _ := cmd.Flags().GetInt("page")
+	perPage, _ := cmd.Flags().GetInt("per-page")
+
+	if perPage > 100 {
+		perPage = 100
+	}
+
+	ctx := context.Background()
+
+	resp, err := zdClient.ListActivities(ctx, since, page, perPage)
+	if err != nil {
+		return fmt.Errorf("failed to list activities: %w", err)
+	}
+
+	if len(resp.Activities) == 0 {
+		color.Yellow("No activities found.\n")
+		return nil
+	}
+
+	format, _ := cmd.Flags().GetString("output")
+	writer := output.NewWriter(output.Format(format))
+
+	switch output.Format(format) {
+	case output.FormatJSON:
+		return writer.WriteJSON(resp.Activities)
+
+	case output.FormatCSV:
+		rows := make([]activityRow, len(resp.Activities))
+		for i, activity := range resp.Activities {
+			rows[i] = activityRow{
+				ID:        activity.ID,
+				Verb:      activity.Verb,
+				Title:     activity.Title,
+				ActorID:   activity.ActorID,
+				Actor:     activityActor(&activity),
+				TicketID:  activity.TicketID(),
+				CreatedAt: activity.CreatedAt,
+			}
+		}
+		headers := []string{"id", "verb", "title", "actor_id", "actor", "ticket_id", "created_at"}
+		return writer.WriteCSV(rows, headers)
+
+	default:
+		// Table format (default)
+		color.Cyan("Activities (Page %d, showing %d of %d total)\n", page, len(resp.Activities), resp.Count)
+		color.White(strings.Repeat("─", 80) + "\n")
+
+		table := output.NewTable("TIME", "TICKET", "ACTIVITY")
+		table.SetFlexColumn(2)
+		for _, activity := range resp.Activities {
+			ticket := "-"
+			if id := activity.TicketID(); id != 0 {
+				ticket = fmt.Sprintf("#%d", id)
+			}
+			table.AddRow(formatDate(activity.CreatedAt), ticket, activity.Title)
+		}
+		table.Print()
+
+		// Show pagination info
+		if resp.NextPage != "" {
+			fmt.Println()
+			color.White("More results available. Use --page %d to see next page.\n", page+1)
+		}
+
+		return nil
+	}
+}
+
+// activityActor returns the name of whoever caused an activity
+func activityActor(activity *client.Activity) string {
+	if activity.Actor != nil && activity.Actor.Name != "" {
+		return activity.Actor.Name
+	}
+	return names.userName(activity.ActorID)
+}
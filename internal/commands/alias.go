@@ -0,0 +1,348 @@
+package commands
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"zd-cli/internal/config"
+	"zd-cli/internal/output"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// NewAliasCommand creates the alias command
+func NewAliasCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "alias",
+		Short: "Manage command aliases",
+		Long: `Manage shortcuts for commands you run often, like git aliases.
+
+An alias expands to its command before it runs, and any further arguments
+are appended, so 'zd urgent-open --limit 5' runs the aliased command with
+--limit 5. Quote the expansion; arguments in it can be quoted too. Aliases
+can't replace built-in commands or expand to other aliases. Examples:
+  zd alias set urgent-open 'ticket search "status:open priority:urgent"'
+  zd alias set mine 'ticket mine --status open'
+  zd alias list
+  zd alias delete mine`,
+	}
+
+	cmd.AddCommand(newAliasSetCommand())
+	cmd.AddCommand(newAliasListCommand())
+	cmd.AddCommand(newAliasDeleteCommand())
+
+	return cmd
+}
+
+func newAliasSetCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "set <name> <expansion>",
+		Short: "Create or replace an alias",
+		Args:  cobra.ExactArgs(2),
+		RunE:  runAliasSet,
+	}
+}
+
+func newAliasListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List aliases",
+		Args:    cobra.NoArgs,
+		RunE:    runAliasList,
+	}
+
+	cmd.Flags().StringP("output", "o", "table", "Output format: table, json")
+
+	return cmd
+}
+
+func newAliasDeleteCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:               "delete <name>",
+		Aliases:           []string{"rm"},
+		Short:             "Delete an alias",
+		Args:              cobra.ExactArgs(1),
+		RunE:              runAliasDelete,
+		ValidArgsFunction: completeAliasNames,
+	}
+}
+
+func runAliasSet(cmd *cobra.Command, args []string) error {
+	name, expansion := args[0], strings.TrimSpace(args[1])
+
+	if name == "" || strings.HasPrefix(name, "-") || strings.ContainsAny(name, " \t=") {
+		return fmt.Errorf("invalid alias name %q", name)
+	}
+	if isBuiltinCommand(cmd.Root(), name) {
+		return fmt.Errorf("%q is a built-in command and can't be aliased", name)
+	}
+
+	words, err := splitCommandLine(expansion)
+	if err != nil {
+		return fmt.Errorf("invalid expansion: %w", err)
+	}
+	if len(words) == 0 {
+		return fmt.Errorf("the expansion is empty")
+	}
+	if !isBuiltinCommand(cmd.Root(), words[0]) {
+		return fmt.Errorf("%q is not a zd command: the expansion starts with the command to run, without 'zd'", words[0])
+	}
+
+	cfg, err := loadOrCreateConfig(cmd)
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	if cfg.Aliases == nil {
+		cfg.Aliases = make(map[string]string)
+	}
+	_, replaced := cfg.Aliases[name]
+	cfg.Aliases[name] = expansion
+
+	if err := saveConfig(cmd, cfg); err != nil {
+		return fmt.Errorf("failed to save configuration: %w", err)
+	}
+
+	if replaced {
+		color.Green("✓ Replaced alias %s: zd %s\n", name, expansion)
+	} else {
+		color.Green("✓ Added alias %s: zd %s\n", name, expansion)
+	}
+
+	return nil
+}
+
+// aliasEntry is one alias as shown by alias list
+type aliasEntry struct {
+	Name      string `json:"name"`
+	Expansion string `json:"expansion"`
+}
+
+func runAliasList(cmd *cobra.Command, args []string) error {
+	cfg, err := loadOrCreateConfig(cmd)
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	entries := []aliasEntry{}
+	for name, expansion := range cfg.Aliases {
+		entries = append(entries, aliasEntry{Name: name, Expansion: expansion})
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name < entries[j].Name })
+
+	format, _ := cmd.Flags().GetString("output")
+	if output.Format(format) == output.FormatJSON {
+		return output.NewWriter(output.FormatJSON).WriteJSON(entries)
+	}
+
+	if len(entries) == 0 {
+		color.Yellow("No aliases. Use 'zd alias set <name> <expansion>' to add one.\n")
+		return nil
+	}
+
+	color.Cyan("%-20s %s\n", "ALIAS", "EXPANDS TO")
+	color.White(strings.Repeat("─", 80) + "\n")
+	for _, entry := range entries {
+		fmt.Printf("%-20s zd %s\n", entry.Name, entry.Expansion)
+	}
+
+	return nil
+}
+
+func runAliasDelete(cmd *cobra.Command, args []string) error {
+	name := args[0]
+
+	cfg, err := loadConfig(cmd)
+	if err == config.ErrConfigNotFound {
+		return fmt.Errorf("alias %q not found", name)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	if _, ok := cfg.Aliases[name]; !ok {
+		return fmt.Errorf("alias %q not found", name)
+	}
+	delete(cfg.Aliases, name)
+
+	if err := saveConfig(cmd, cfg); err != nil {
+		return fmt.Errorf("failed to save configuration: %w", err)
+	}
+
+	color.Green("✓ Deleted alias %s\n", name)
+
+	return nil
+}
+
+// completeAliasNames completes the names of configured aliases
+func completeAliasNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	cfg, err := loadConfig(cmd)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	var names []string
+	for name := range cfg.Aliases {
+		if strings.HasPrefix(name, toComplete) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
+
+// ExpandAliases replaces an alias in args, the command line without the
+// program name, with its expansion. Global flags may come before the alias.
+// Built-in commands always win, and args are returned unchanged when there
+// is no alias or no readable config file.
+func ExpandAliases(root *cobra.Command, args []string) ([]string, error) {
+	i := commandIndex(root, args)
+	if i < 0 || isBuiltinCommand(root, args[i]) {
+		return args, nil
+	}
+
+	path, err := config.ResolvePath(configPathFromArgs(args[:i]))
+	if err != nil {
+		return args, nil
+	}
+	cfg, err := config.LoadFrom(path)
+	if err != nil {
+		return args, nil
+	}
+
+	expansion, ok := cfg.Aliases[args[i]]
+	if !ok {
+		return args, nil
+	}
+
+	words, err := splitCommandLine(expansion)
+	if err != nil {
+		return nil, fmt.Errorf("invalid alias %s: %w", args[i], err)
+	}
+
+	expanded := append([]string{}, args[:i]...)
+	expanded = append(expanded, words...)
+	return append(expanded, args[i+1:]...), nil
+}
+
+// commandIndex returns the index of the first argument that names a
+// command, skipping global flags and their values, or -1 if there is none
+func commandIndex(root *cobra.Command, args []string) int {
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" {
+			return -1
+		}
+		if !strings.HasPrefix(arg, "-") || arg == "-" {
+			return i
+		}
+		if strings.Contains(arg, "=") {
+			continue
+		}
+
+		// A flag that takes a value consumes the next argument
+		var flagName string
+		if strings.HasPrefix(arg, "--") {
+			flagName = strings.TrimPrefix(arg, "--")
+		} else if len(arg) == 2 {
+			if f := root.PersistentFlags().ShorthandLookup(arg[1:]); f != nil {
+				flagName = f.Name
+			}
+		}
+		if f := root.PersistentFlags().Lookup(flagName); f != nil && f.NoOptDefVal == "" && f.Value.Type() != "bool" {
+			i++
+		}
+	}
+	return -1
+}
+
+// configPathFromArgs returns the value of a --config flag in args, which
+// hold only global flags
+func configPathFromArgs(args []string) string {
+	for i, arg := range args {
+		if value, ok := strings.CutPrefix(arg, "--config="); ok {
+			return value
+		}
+		if arg == "--config" && i+1 < len(args) {
+			return args[i+1]
+		}
+	}
+	return ""
+}
+
+// isBuiltinCommand reports whether name is a command or command alias of root
+func isBuiltinCommand(root *cobra.Command, name string) bool {
+	if name == "help" || name == cobra.ShellCompRequestCmd || name == cobra.ShellCompNoDescRequestCmd {
+		return true
+	}
+	for _, cmd := range root.Commands() {
+		if cmd.Name() == name || cmd.HasAlias(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// splitCommandLine splits s into words the way a shell would, honoring
+// single and double quotes and backslash escapes
+func splitCommandLine(s string) ([]string, error) {
+	var words []string
+	var word strings.Builder
+	inWord := false
+	var quote rune
+
+	runes := []rune(s)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case quote == '\'':
+			if r == '\'' {
+				quote = 0
+			} else {
+				word.WriteRune(r)
+			}
+		case r == '\\' && quote != '\'':
+			if i+1 >= len(runes) {
+				return nil, fmt.Errorf("trailing backslash")
+			}
+			i++
+			word.WriteRune(runes[i])
+			inWord = true
+		case quote == '"':
+			if r == '"' {
+				quote = 0
+			} else {
+				word.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote = r
+			inWord = true
+		case r == ' ' || r == '\t' || r == '\n':
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		default:
+			word.WriteRune(r)
+			inWord = true
+		}
+	}
+
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated %c quote", quote)
+	}
+	if inWord {
+		words = append(words, word.String())
+	}
+
+	return words, nil
+}
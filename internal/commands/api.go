@@ -0,0 +1,136 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"zd-cli/internal/client"
+
+	"github.com/spf13/cobra"
+)
+
+// apiMethods are the HTTP methods zd api sends
+var apiMethods = []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete}
+
+// NewAPICommand creates the api command
+func NewAPICommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "api <method> <path>",
+		Short: "Send an authenticated request to any API endpoint",
+		Long: `Send an authenticated request to the current instance and print the response,
+for endpoints zd doesn't have a command for yet.
+
+The path is relative to /api/v2, e.g. "tickets/123.json"; a full URL on the
+instance also works. JSON responses are pretty-printed. Requests are never
+cached.
+
+--data takes a JSON body inline, from a file with @file.json, or from stdin
+with -. --paginate follows next_page and cursor links and prints each page.`,
+		Example: `  zd api GET tickets/123.json
+  zd api GET "users/search.json?query=jane" --paginate
+  zd api POST tickets.json --data @ticket.json
+  echo '{"ticket":{"status":"solved"}}' | zd api PUT tickets/123.json --data -
+  zd api DELETE tags/123.json`,
+		Args: cobra.ExactArgs(2),
+		RunE: withClient(runAPI),
+	}
+
+	cmd.Flags().StringP("data", "d", "", "JSON request body: inline, @file, or - for stdin")
+	cmd.Flags().Bool("paginate", false, "Fetch and print every page of a GET list")
+	cmd.Flags().BoolP("include", "i", false, "Print the response status and headers")
+
+	return cmd
+}
+
+func runAPI(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	method := strings.ToUpper(args[0])
+	if !slices.Contains(apiMethods, method) {
+		return fmt.Errorf("invalid method %q (use %s)", args[0], strings.Join(apiMethods, ", "))
+	}
+	path := args[1]
+
+	paginate, _ := cmd.Flags().GetBool("paginate")
+	include, _ := cmd.Flags().GetBool("include")
+	data, _ := cmd.Flags().GetString("data")
+
+	if paginate && method != http.MethodGet {
+		return fmt.Errorf("--paginate only works with GET")
+	}
+
+	var body []byte
+	if data != "" {
+		text, err := readTextArg(data)
+		if err != nil {
+			return err
+		}
+		if !json.Valid([]byte(text)) {
+			return fmt.Errorf("--data is not valid JSON")
+		}
+		body = []byte(text)
+	}
+
+	if paginate {
+		ctx, cancel := runContext(zdClient, 30*time.Minute)
+		defer cancel()
+
+		return zdClient.RawPages(ctx, path, func(resp *client.RawResponse) error {
+			printAPIResponse(resp, include)
+			return nil
+		})
+	}
+
+	ctx := context.Background()
+
+	resp, err := zdClient.RawRequest(ctx, method, path, body)
+	if err != nil {
+		return err
+	}
+
+	printAPIResponse(resp, include)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("request failed: %s", resp.Status)
+	}
+	return nil
+}
+
+// printAPIResponse writes a response body to stdout, pretty-printing JSON,
+// with the status and headers first when include is set
+func printAPIResponse(resp *client.RawResponse, include bool) {
+	if include {
+		fmt.Println(resp.Status)
+		keys := make([]string, 0, len(resp.Header))
+		for key := range resp.Header {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			fmt.Printf("%s: %s\n", key, strings.Join(resp.Header[key], ", "))
+		}
+		fmt.Println()
+	}
+
+	if len(resp.Body) == 0 {
+		return
+	}
+
+	var pretty bytes.Buffer
+	if err := json.Indent(&pretty, resp.Body, "", "  "); err == nil {
+		pretty.WriteByte('\n')
+		pretty.WriteTo(os.Stdout)
+		return
+	}
+
+	os.Stdout.Write(resp.Body)
+	if !bytes.HasSuffix(resp.Body, []byte("\n")) {
+		fmt.Println()
+	}
+}
@@ -0,0 +1,167 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"zd-cli/internal/client"
+	"zd-cli/internal/output"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// auditLogActions are the actions audit logs can be filtered by
+var auditLogActions = []string{"create", "destroy", "exported", "login", "update"}
+
+// NewAuditLogCommand creates the audit-log command
+func NewAuditLogCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "audit-log",
+		Short: "Investigate changes to account settings",
+		Long: `Browse the account audit log: who changed which settings, triggers,
+users, and other records, and when. For the history of a single ticket, use
+'zd ticket audits' instead.
+
+Audit logs need an admin on an Enterprise plan.`,
+	}
+
+	cmd.AddCommand(newAuditLogListCommand())
+
+	// Add global output format flag to all subcommands
+	cmd.PersistentFlags().StringP("output", "o", "table", "Output format: table, json, csv")
+
+	return cmd
+}
+
+func newAuditLogListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List audit log entries, newest first",
+		Long: `List audit log entries, newest first. Examples:
+  zd audit-log list --since 7d
+  zd audit-log list --actor jane@example.com --since 24h
+  zd audit-log list --source-type rule --action destroy
+  zd audit-log list --source-type user --source-id 12345 -o csv`,
+		Args: cobra.NoArgs,
+		RunE: withClient(runAuditLogList),
+	}
+
+	cmd.Flags().String("actor", "", "Only changes by this agent (user ID, email, or name)")
+	cmd.Flags().String("action", "", "Only this action: "+strings.Join(auditLogActions, ", "))
+	cmd.Flags().String("source-type", "", "Only changes to this kind of record, e.g. rule, user, account_setting")
+	cmd.Flags().Int64("source-id", 0, "Only changes to this record (use with --source-type)")
+	cmd.Flags().String("ip", "", "Only changes made from this IP address")
+	cmd.Flags().String("since", "", "Only changes since: relative (24h, 7d), YYYY-MM-DD, RFC3339, or Unix timestamp")
+	cmd.Flags().String("until", "", "Only changes before: relative (24h, 7d), YYYY-MM-DD, RFC3339, or Unix timestamp")
+	cmd.Flags().Int("page", 1, "Page number")
+	cmd.Flags().Int("per-page", 25, "Results per page (max 100)")
+
+	cmd.RegisterFlagCompletionFunc("action", cobra.FixedCompletions(auditLogActions, cobra.ShellCompDirectiveNoFileComp))
+
+	return cmd
+}
+
+func runAuditLogList(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	var filter client.AuditLogFilter
+	filter.Action, _ = cmd.Flags().GetString("action")
+	filter.SourceType, _ = cmd.Flags().GetString("source-type")
+	filter.SourceID, _ = cmd.Flags().GetInt64("source-id")
+	filter.IPAddress, _ = cmd.Flags().GetString("ip")
+
+	if filter.Action != "" && !containsString(auditLogActions, filter.Action) {
+		return fmt.Errorf("invalid action %q: use %s", filter.Action, strings.Join(auditLogActions, ", "))
+	}
+	if filter.SourceID != 0 && filter.SourceType == "" {
+		return fmt.Errorf("--source-id needs --source-type")
+	}
+
+	for name, t := range map[string]*time.Time{"since": &filter.Since, "until": &filter.Until} {
+		if value, _ := cmd.Flags().GetString(name); value != "" {
+			parsed, err := parseReportSince(value)
+			if err != nil {
+				return fmt.Errorf("--%s: %w", name, err)
+			}
+			*t = parsed
+		}
+	}
+
+	page, _ := cmd.Flags().GetInt("page")
+	perPage, _ := cmd.Flags().GetInt("per-page")
+
+	if perPage > 100 {
+		perPage = 100
+	}
+
+	ctx := context.Background()
+
+	if actor, _ := cmd.Flags().GetString("actor"); actor != "" {
+		user, err := resolveAssignee(ctx, zdClient, actor)
+		if err != nil {
+			return err
+		}
+		filter.ActorID = user.ID
+	}
+
+	resp, err := zdClient.ListAuditLogs(ctx, filter, page, perPage)
+	if err != nil {
+		return fmt.Errorf("failed to list audit logs: %w", err)
+	}
+
+	if len(resp.AuditLogs) == 0 {
+		color.Yellow("No audit log entries found.\n")
+		return nil
+	}
+
+	format, _ := cmd.Flags().GetString("output")
+	writer := output.NewWriter(output.Format(format))
+
+	switch output.Format(format) {
+	case output.FormatJSON:
+		return writer.WriteJSON(resp.AuditLogs)
+
+	case output.FormatCSV:
+		headers := []string{"id", "created_at", "actor_id", "actor_name", "action", "source_type", "source_id", "source_label", "change_description", "ip_address"}
+		return writer.WriteCSV(resp.AuditLogs, headers)
+
+	default:
+		// Table format (default)
+		color.Cyan("Audit Log (Page %d, showing %d of %d total)\n", page, len(resp.AuditLogs), resp.Count)
+		color.White(strings.Repeat("─", 80) + "\n")
+
+		table := output.NewTable("TIME", "ACTOR", "ACTION", "SOURCE", "CHANGE")
+		table.SetFlexColumn(4)
+		for _, entry := range resp.AuditLogs {
+			table.AddRow(
+				formatDate(entry.CreatedAt),
+				orDash(entry.ActorName),
+				orDash(entry.ActionLabel),
+				auditLogSource(&entry),
+				orDash(entry.ChangeDescription))
+		}
+		table.Print()
+
+		// Show pagination info
+		if resp.NextPage != "" {
+			fmt.Println()
+			color.White("More results available. Use --page %d to see next page.\n", page+1)
+		}
+
+		return nil
+	}
+}
+
+// auditLogSource describes the record an audit log entry changed, e.g.
+// "Trigger: Notify requester (360001)"
+func auditLogSource(entry *client.AuditLog) string {
+	label := entry.SourceLabel
+	if label == "" {
+		label = entry.SourceType
+	}
+	if entry.SourceID == 0 {
+		return orDash(label)
+	}
+	return fmt.Sprintf("%s (%d)", label, entry.SourceID)
+}
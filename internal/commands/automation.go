@@ -0,0 +1,404 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"zd-cli/internal/client"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// NewAutomationCommand creates the automation management command
+func NewAutomationCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "automation",
+		Short: "Manage Zendesk automations",
+		Long:  "List, inspect, create, update, delete, and export Zendesk automations.",
+	}
+
+	cmd.AddCommand(newAutomationListCommand())
+	cmd.AddCommand(newAutomationShowCommand())
+	cmd.AddCommand(newAutomationCreateCommand())
+	cmd.AddCommand(newAutomationUpdateCommand())
+	cmd.AddCommand(newAutomationDeleteCommand())
+	cmd.AddCommand(newAutomationExportCommand())
+
+	// Add global output format flag to all subcommands
+	cmd.PersistentFlags().StringP("output", "o", "table", "Output format: table, json, csv")
+
+	return cmd
+}
+
+func newAutomationListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List automations",
+		RunE:  withClient(runAutomationList),
+	}
+
+	cmd.Flags().Int("page", 1, "Page number")
+	cmd.Flags().Int("per-page", 100, "Results per page (max 100)")
+	cmd.Flags().Bool("active", false, "Only show active automations")
+	cmd.Flags().Bool("refresh", false, "Bypass cache and fetch fresh data")
+
+	return cmd
+}
+
+func newAutomationShowCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show <automation-id>",
+		Short: "Show detailed information for a specific automation",
+		Args:  cobra.ExactArgs(1),
+		RunE:  withClient(runAutomationShow),
+	}
+
+	cmd.Flags().Bool("refresh", false, "Bypass cache and fetch fresh data")
+
+	return cmd
+}
+
+func newAutomationCreateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create an automation from a JSON definition",
+		Long: `Create an automation from a JSON definition, such as one written by
+'zd automation export'. Examples:
+  zd automation create --from-file close-stale.json
+  zd automation export 123 | zd automation create --from-file - --title "Copy of rule"`,
+		RunE: withClient(runAutomationCreate),
+	}
+
+	cmd.Flags().String("from-file", "", "JSON or YAML definition file (- for stdin)")
+	cmd.Flags().String("title", "", "Override the automation title")
+	cmd.Flags().Bool("inactive", false, "Create the automation as inactive")
+	cmd.MarkFlagRequired("from-file")
+
+	return cmd
+}
+
+func newAutomationUpdateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update <automation-id>",
+		Short: "Update an automation",
+		Long: `Update an automation's title or active state, or replace its definition
+from a JSON file. Examples:
+  zd automation update 123 --inactive
+  zd automation update 123 --from-file close-stale.json`,
+		Args: cobra.ExactArgs(1),
+		RunE: withClient(runAutomationUpdate),
+	}
+
+	cmd.Flags().String("from-file", "", "JSON or YAML definition file (- for stdin)")
+	cmd.Flags().String("title", "", "New title")
+	cmd.Flags().Bool("active", false, "Activate the automation")
+	cmd.Flags().Bool("inactive", false, "Deactivate the automation")
+	cmd.MarkFlagsMutuallyExclusive("active", "inactive")
+
+	return cmd
+}
+
+func newAutomationDeleteCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete <automation-id>",
+		Short: "Delete an automation",
+		Args:  cobra.ExactArgs(1),
+		RunE:  withClient(runAutomationDelete),
+	}
+
+	cmd.Flags().Bool("force", false, "Skip confirmation prompt")
+
+	return cmd
+}
+
+func newAutomationExportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export <automation-id>",
+		Short: "Export an automation definition as JSON",
+		Long: `Export an automation's definition as JSON, without read-only fields, so it
+can be version-controlled or re-imported with 'zd automation create'.`,
+		Args: cobra.ExactArgs(1),
+		RunE: withClient(runAutomationExport),
+	}
+
+	cmd.Flags().String("out", "", "Write the definition to a file instead of stdout")
+
+	return cmd
+}
+
+func runAutomationList(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	page, _ := cmd.Flags().GetInt("page")
+	perPage, _ := cmd.Flags().GetInt("per-page")
+	activeOnly, _ := cmd.Flags().GetBool("active")
+
+	if perPage > 100 {
+		perPage = 100
+	}
+
+	ctx := context.Background()
+
+	resp, err := zdClient.ListAutomations(ctx, page, perPage, activeOnly)
+	if err != nil {
+		return fmt.Errorf("failed to list automations: %w", err)
+	}
+
+	if len(resp.Automations) == 0 {
+		color.Yellow("No automations found.\n")
+		return nil
+	}
+
+	return outputAutomations(cmd, resp.Automations, page, resp.Count, resp.NextPage)
+}
+
+func runAutomationShow(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	automationID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid automation ID: %s", args[0])
+	}
+
+	ctx := context.Background()
+
+	automation, err := zdClient.GetAutomation(ctx, automationID)
+	if err != nil {
+		return fmt.Errorf("failed to get automation: %w", err)
+	}
+
+	return outputAutomation(cmd, automation)
+}
+
+func runAutomationCreate(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	fromFile, _ := cmd.Flags().GetString("from-file")
+	definition, err := readDefinition(fromFile, "automation")
+	if err != nil {
+		return err
+	}
+
+	if cmd.Flags().Changed("title") {
+		title, _ := cmd.Flags().GetString("title")
+		definition["title"] = title
+	}
+	if inactive, _ := cmd.Flags().GetBool("inactive"); inactive {
+		definition["active"] = false
+	}
+	delete(definition, "position")
+
+	ctx := context.Background()
+
+	automation, err := zdClient.CreateAutomation(ctx, definition)
+	if err != nil {
+		return fmt.Errorf("failed to create automation: %w", err)
+	}
+
+	color.Green("✓ Automation created successfully!\n")
+	color.White("Automation ID: %d\n", automation.ID)
+	color.White("Title: %s\n", automation.Title)
+
+	return nil
+}
+
+func runAutomationUpdate(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	automationID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid automation ID: %s", args[0])
+	}
+
+	definition := map[string]interface{}{}
+	if fromFile, _ := cmd.Flags().GetString("from-file"); fromFile != "" {
+		definition, err = readDefinition(fromFile, "automation")
+		if err != nil {
+			return err
+		}
+	}
+
+	if cmd.Flags().Changed("title") {
+		title, _ := cmd.Flags().GetString("title")
+		definition["title"] = title
+	}
+	if active, _ := cmd.Flags().GetBool("active"); active {
+		definition["active"] = true
+	}
+	if inactive, _ := cmd.Flags().GetBool("inactive"); inactive {
+		definition["active"] = false
+	}
+
+	if len(definition) == 0 {
+		return fmt.Errorf("no updates specified. Use --from-file, --title, --active, or --inactive")
+	}
+
+	ctx := context.Background()
+
+	automation, err := zdClient.UpdateAutomation(ctx, automationID, definition)
+	if err != nil {
+		return fmt.Errorf("failed to update automation: %w", err)
+	}
+
+	color.Green("✓ Automation #%d updated successfully!\n", automationID)
+	displayAutomation(automation)
+
+	return nil
+}
+
+func runAutomationDelete(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	automationID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid automation ID: %s", args[0])
+	}
+
+	// Confirmation unless --force
+	force, _ := cmd.Flags().GetBool("force")
+	if !force {
+		color.Yellow("WARNING: This will permanently delete automation %d\n", automationID)
+		confirm, err := promptString("Type 'yes' to confirm", true, "--force")
+		if err != nil {
+			return err
+		}
+		if strings.ToLower(confirm) != "yes" {
+			color.Yellow("Deletion cancelled.\n")
+			return nil
+		}
+	}
+
+	ctx := context.Background()
+
+	if err := zdClient.DeleteAutomation(ctx, automationID); err != nil {
+		return fmt.Errorf("failed to delete automation: %w", err)
+	}
+
+	color.Green("✓ Automation #%d deleted\n", automationID)
+
+	return nil
+}
+
+func runAutomationExport(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	automationID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid automation ID: %s", args[0])
+	}
+
+	ctx := context.Background()
+
+	automation, err := zdClient.GetAutomation(ctx, automationID)
+	if err != nil {
+		return fmt.Errorf("failed to get automation: %w", err)
+	}
+
+	// Only the fields needed to recreate the automation
+	definition := map[string]interface{}{
+		"automation": map[string]interface{}{
+			"title":      automation.Title,
+			"active":     automation.Active,
+			"position":   automation.Position,
+			"conditions": automation.Conditions,
+			"actions":    automation.Actions,
+		},
+	}
+
+	data, err := json.MarshalIndent(definition, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode automation: %w", err)
+	}
+	data = append(data, '\n')
+
+	outPath, _ := cmd.Flags().GetString("out")
+	if outPath == "" {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+
+	if err := os.WriteFile(outPath, data, 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", outPath, err)
+	}
+
+	color.Green("✓ Exported automation #%d to %s\n", automationID, outPath)
+
+	return nil
+}
+
+// outputAutomation outputs a single automation in the requested format
+func outputAutomation(cmd *cobra.Command, automation *client.Automation) error {
+	headers := []string{"id", "title", "active", "position", "created_at", "updated_at"}
+	return writeOutput(cmd, automation, headers, func() {
+		displayAutomation(automation)
+	})
+}
+
+// outputAutomations outputs multiple automations in the requested format
+func outputAutomations(cmd *cobra.Command, automations []client.Automation, page, total int, nextPage string) error {
+	headers := []string{"id", "title", "active", "position", "created_at", "updated_at"}
+	return writeOutput(cmd, automations, headers, func() {
+		color.Cyan("Automations (Page %d, showing %d of %d total)\n", page, len(automations), total)
+		color.White(strings.Repeat("─", 80) + "\n\n")
+
+		for i, automation := range automations {
+			displayAutomationSummary(&automation, i+1)
+		}
+
+		// Show pagination info
+		if nextPage != "" {
+			fmt.Println()
+			color.White("More results available. Use --page %d to see next page.\n", page+1)
+		}
+	})
+}
+
+// Display an automation summary (compact format)
+func displayAutomationSummary(automation *client.Automation, index int) {
+	activeBadge := ""
+	if !automation.Active {
+		activeBadge = " | " + color.YellowString("inactive")
+	}
+
+	fmt.Printf("#%-3d %s | ID: %d%s\n",
+		index,
+		color.CyanString(automation.Title),
+		automation.ID,
+		activeBadge)
+}
+
+// Display full automation details
+func displayAutomation(automation *client.Automation) {
+	color.Cyan("Automation: %s\n", automation.Title)
+	color.White(strings.Repeat("─", 80) + "\n")
+
+	color.White("ID:           %d\n", automation.ID)
+	if automation.Active {
+		color.Green("Status:       active\n")
+	} else {
+		color.Yellow("Status:       inactive\n")
+	}
+	color.White("Position:     %d\n", automation.Position)
+
+	// Conditions
+	if len(automation.Conditions.All) > 0 {
+		color.White("\nMatches ALL of:\n")
+		for _, condition := range automation.Conditions.All {
+			displayViewCondition(condition)
+		}
+	}
+	if len(automation.Conditions.Any) > 0 {
+		color.White("\nMatches ANY of:\n")
+		for _, condition := range automation.Conditions.Any {
+			displayViewCondition(condition)
+		}
+	}
+
+	// Actions
+	if len(automation.Actions) > 0 {
+		color.White("\nActions:\n")
+		for _, action := range automation.Actions {
+			color.White("  %-20s %s\n", action.Field, formatMacroValue(action.Value))
+		}
+	}
+
+	// Dates
+	color.White("\nDates:\n")
+	color.White("  Created:      %s\n", formatDate(automation.CreatedAt))
+	color.White("  Last Updated: %s\n", formatDate(automation.UpdatedAt))
+
+	color.White("\nURL: %s\n", automation.URL)
+}
@@ -0,0 +1,181 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"zd-cli/internal/client"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// brandHeaders are the CSV columns for brands
+var brandHeaders = []string{"id", "name", "subdomain", "brand_url", "active", "default", "created_at", "updated_at"}
+
+// NewBrandCommand creates the brand command
+func NewBrandCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "brand",
+		Short: "Browse Zendesk brands",
+		Long:  "List the brands on a multi-brand Zendesk instance.",
+	}
+
+	cmd.AddCommand(newBrandListCommand())
+	cmd.AddCommand(newBrandShowCommand())
+
+	// Add global output format flag to all subcommands
+	cmd.PersistentFlags().StringP("output", "o", "table", "Output format: table, json, csv")
+
+	return cmd
+}
+
+func newBrandListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List brands",
+		RunE:  withClient(runBrandList),
+	}
+
+	cmd.Flags().Int("page", 1, "Page number")
+	cmd.Flags().Int("per-page", 100, "Results per page (max 100)")
+	cmd.Flags().Bool("refresh", false, "Bypass cache and fetch fresh data")
+
+	return cmd
+}
+
+func newBrandShowCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show <brand-id>",
+		Short: "Show detailed information for a specific brand",
+		Args:  cobra.ExactArgs(1),
+		RunE:  withClient(runBrandShow),
+	}
+
+	cmd.Flags().Bool("refresh", false, "Bypass cache and fetch fresh data")
+
+	return cmd
+}
+
+func runBrandList(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	page, _ := cmd.Flags().GetInt("page")
+	perPage, _ := cmd.Flags().GetInt("per-page")
+
+	if perPage > 100 {
+		perPage = 100
+	}
+
+	ctx := context.Background()
+
+	resp, err := zdClient.ListBrands(ctx, page, perPage)
+	if err != nil {
+		return fmt.Errorf("failed to list brands: %w", err)
+	}
+
+	if len(resp.Brands) == 0 {
+		color.Yellow("No brands found.\n")
+		return nil
+	}
+
+	return outputBrands(cmd, resp.Brands, page, resp.Count, resp.NextPage)
+}
+
+func runBrandShow(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	brandID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid brand ID: %s", args[0])
+	}
+
+	brand, err := zdClient.GetBrand(context.Background(), brandID)
+	if err != nil {
+		return fmt.Errorf("failed to get brand: %w", err)
+	}
+
+	return writeOutput(cmd, brand, brandHeaders, func() { displayBrand(brand) })
+}
+
+// resolveBrand turns a brand ID, name, or subdomain into a brand ID
+func resolveBrand(zdClient *client.Client, value string) (int64, error) {
+	if id, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return id, nil
+	}
+
+	ctx := context.Background()
+
+	resp, err := zdClient.ListBrands(ctx, 1, 100)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list brands: %w", err)
+	}
+
+	for _, brand := range resp.Brands {
+		if strings.EqualFold(brand.Name, value) || strings.EqualFold(brand.Subdomain, value) {
+			return brand.ID, nil
+		}
+	}
+
+	return 0, fmt.Errorf("brand not found: %s (see 'zd brand list')", value)
+}
+
+// outputBrands outputs multiple brands in the requested format
+func outputBrands(cmd *cobra.Command, brands []client.Brand, page, total int, nextPage string) error {
+	return writeOutput(cmd, brands, brandHeaders, func() {
+		color.Cyan("Brands (Page %d, showing %d of %d total)\n", page, len(brands), total)
+		color.White(strings.Repeat("─", 80) + "\n\n")
+
+		for i, brand := range brands {
+			displayBrandSummary(&brand, i+1)
+		}
+
+		// Show pagination info
+		if nextPage != "" {
+			fmt.Println()
+			color.White("More results available. Use --page %d to see next page.\n", page+1)
+		}
+	})
+}
+
+// Display a brand summary (compact format)
+func displayBrandSummary(brand *client.Brand, index int) {
+	badges := ""
+	if brand.Default {
+		badges += " | " + color.GreenString("default")
+	}
+	if !brand.Active {
+		badges += " | " + color.YellowString("inactive")
+	}
+
+	fmt.Printf("#%-3d %s | ID: %d | %s%s\n",
+		index,
+		color.CyanString(brand.Name),
+		brand.ID,
+		brand.Subdomain,
+		badges)
+}
+
+// Display full brand details
+func displayBrand(brand *client.Brand) {
+	color.Cyan("Brand: %s\n", brand.Name)
+	color.White(strings.Repeat("─", 80) + "\n")
+
+	color.White("ID:           %d\n", brand.ID)
+	color.White("Subdomain:    %s\n", brand.Subdomain)
+	if brand.HostMapping != nil && *brand.HostMapping != "" {
+		color.White("Host Mapping: %s\n", *brand.HostMapping)
+	}
+	if brand.Active {
+		color.Green("Status:       active\n")
+	} else {
+		color.Yellow("Status:       inactive\n")
+	}
+	color.White("Default:      %t\n", brand.Default)
+	color.White("Help Center:  %s\n", brand.HelpCenterState)
+
+	// Dates
+	color.White("\nDates:\n")
+	color.White("  Created:      %s\n", formatDate(brand.CreatedAt))
+	color.White("  Last Updated: %s\n", formatDate(brand.UpdatedAt))
+
+	color.White("\nURL: %s\n", brand.BrandURL)
+}
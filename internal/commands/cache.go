@@ -4,9 +4,10 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
-	"time"
+	"sort"
 
 	"zd-cli/internal/cache"
+	"zd-cli/internal/output"
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 )
@@ -21,6 +22,9 @@ func NewCacheCommand() *cobra.Command {
 
 	cmd.AddCommand(newCacheInfoCommand())
 	cmd.AddCommand(newCacheClearCommand())
+	cmd.AddCommand(newCachePruneCommand())
+	cmd.AddCommand(newCacheStatsCommand())
+	cmd.AddCommand(newCacheInvalidateCommand())
 
 	return cmd
 }
@@ -41,6 +45,45 @@ func newCacheClearCommand() *cobra.Command {
 	}
 }
 
+func newCachePruneCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "prune",
+		Short: "Remove expired cache entries",
+		RunE:  runCachePrune,
+	}
+}
+
+func newCacheStatsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stats",
+		Short: "Show cache hit/miss counters and entries per resource",
+		RunE:  runCacheStats,
+	}
+
+	cmd.Flags().Bool("reset", false, "Reset the hit/miss counters")
+
+	return cmd
+}
+
+func newCacheInvalidateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "invalidate",
+		Short: "Remove cached entries matching a pattern",
+		Long: `Remove cached entries whose key matches a pattern, leaving the rest of the
+cache intact. Keys look like "tickets:12345" or "users:list:1:25"; * matches
+anything. Examples:
+  zd cache invalidate --pattern "tickets*"
+  zd cache invalidate --pattern "users:*"
+  zd cache invalidate --pattern "mycompany:search*"`,
+		RunE: runCacheInvalidate,
+	}
+
+	cmd.Flags().String("pattern", "", "Key pattern to remove, e.g. \"tickets*\" (required)")
+	cmd.MarkFlagRequired("pattern")
+
+	return cmd
+}
+
 func runCacheInfo(cmd *cobra.Command, args []string) error {
 	home, err := os.UserHomeDir()
 	if err != nil {
@@ -89,13 +132,13 @@ func runCacheInfo(cmd *cobra.Command, args []string) error {
 	color.White("Location:     %s\n", cacheDir)
 	color.White("Entries:      %d\n", validEntries)
 	color.White("Total size:   %.2f KB\n", float64(totalSize)/1024)
-	color.White("Default TTL:  10 minutes\n")
+	color.White("Default TTL:  %s\n", cache.DefaultTTL)
 
 	return nil
 }
 
 func runCacheClear(cmd *cobra.Command, args []string) error {
-	c, err := cache.New(15 * time.Minute)
+	c, err := cache.New(cache.TTL{})
 	if err != nil {
 		return fmt.Errorf("failed to initialize cache: %w", err)
 	}
@@ -108,3 +151,120 @@ func runCacheClear(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+func runCachePrune(cmd *cobra.Command, args []string) error {
+	c, err := cache.New(cache.TTL{})
+	if err != nil {
+		return fmt.Errorf("failed to initialize cache: %w", err)
+	}
+
+	removed, err := c.PruneExpired()
+	if err != nil {
+		return fmt.Errorf("failed to prune cache: %w", err)
+	}
+
+	color.Green("✓ Removed %d expired cache entries\n", removed)
+
+	return nil
+}
+
+func runCacheStats(cmd *cobra.Command, args []string) error {
+	if reset, _ := cmd.Flags().GetBool("reset"); reset {
+		if err := cache.ResetStats(); err != nil {
+			return err
+		}
+		color.Green("✓ Cache stats reset\n")
+		return nil
+	}
+
+	stats, err := cache.LoadStats()
+	if err != nil {
+		return err
+	}
+
+	c, err := cache.New(cache.TTL{})
+	if err != nil {
+		return fmt.Errorf("failed to initialize cache: %w", err)
+	}
+
+	entries, err := c.Entries()
+	if err != nil {
+		return err
+	}
+
+	// Group entries by resource type
+	type resourceStats struct {
+		entries int
+		expired int
+		size    int64
+	}
+	byResource := make(map[string]*resourceStats)
+	for _, entry := range entries {
+		resource := entry.Resource()
+		if resource == "" {
+			resource = "(unknown)"
+		}
+		rs, ok := byResource[resource]
+		if !ok {
+			rs = &resourceStats{}
+			byResource[resource] = rs
+		}
+		rs.entries++
+		rs.size += entry.Size
+		if entry.Expired() {
+			rs.expired++
+		}
+	}
+
+	color.Cyan("Cache Statistics\n")
+	color.White("────────────────\n")
+	color.White("Last run:     %d hits, %d misses, %d revalidated (%.0f%% hit rate)\n",
+		stats.LastRun.Hits, stats.LastRun.Misses, stats.LastRun.Revalidations, stats.LastRun.HitRate()*100)
+	color.White("All runs:     %d hits, %d misses, %d revalidated (%.0f%% hit rate)\n",
+		stats.Total.Hits, stats.Total.Misses, stats.Total.Revalidations, stats.Total.HitRate()*100)
+	if !stats.UpdatedAt.IsZero() {
+		color.White("Updated:      %s\n", stats.UpdatedAt.Format("2006-01-02 15:04:05 MST"))
+	}
+
+	if len(byResource) == 0 {
+		color.Yellow("\nCache is empty.\n")
+		return nil
+	}
+
+	resources := make([]string, 0, len(byResource))
+	for resource := range byResource {
+		resources = append(resources, resource)
+	}
+	sort.Strings(resources)
+
+	fmt.Println()
+	table := output.NewTable("RESOURCE", "ENTRIES", "EXPIRED", "SIZE")
+	for _, resource := range resources {
+		rs := byResource[resource]
+		table.AddRow(resource,
+			fmt.Sprintf("%d", rs.entries),
+			fmt.Sprintf("%d", rs.expired),
+			fmt.Sprintf("%.2f KB", float64(rs.size)/1024))
+	}
+	table.Print()
+
+	return nil
+}
+
+func runCacheInvalidate(cmd *cobra.Command, args []string) error {
+	pattern, _ := cmd.Flags().GetString("pattern")
+
+	c, err := cache.New(cache.TTL{})
+	if err != nil {
+		return fmt.Errorf("failed to initialize cache: %w", err)
+	}
+
+	removed, err := c.Invalidate(pattern)
+	if err != nil {
+		return fmt.Errorf("failed to invalidate cache: %w", err)
+	}
+
+	color.Green("✓ Removed %d cache entries matching '%s'\n", removed, pattern)
+
+	return nil
+}
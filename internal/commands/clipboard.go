@@ -0,0 +1,91 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// addCopyFlag adds --copy to a show command. A bare --copy copies the
+// resource's agent URL; --copy=json copies the resource as JSON.
+func addCopyFlag(cmd *cobra.Command) {
+	cmd.Flags().String("copy", "", "Copy the resource to the clipboard: url (default) or json")
+	cmd.Flags().Lookup("copy").NoOptDefVal = "url"
+}
+
+// copyFromFlags copies url or resource to the clipboard as --copy asks,
+// doing nothing when the flag isn't given
+func copyFromFlags(cmd *cobra.Command, url string, resource interface{}) error {
+	what, _ := cmd.Flags().GetString("copy")
+
+	var text string
+	switch strings.ToLower(what) {
+	case "":
+		return nil
+	case "url":
+		text = url
+	case "json":
+		data, err := json.MarshalIndent(resource, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to encode JSON: %w", err)
+		}
+		text = string(data)
+	default:
+		return fmt.Errorf("invalid --copy value %q: use url or json", what)
+	}
+
+	if err := copyToClipboard(text); err != nil {
+		return err
+	}
+
+	// Confirm on stderr so piped output stays clean
+	color.New(color.FgGreen).Fprintf(os.Stderr, "✓ Copied %s to clipboard\n", strings.ToLower(what))
+
+	return nil
+}
+
+// copyToClipboard writes text to the system clipboard using the platform's
+// clipboard tool
+func copyToClipboard(text string) error {
+	var candidates [][]string
+
+	switch runtime.GOOS {
+	case "darwin":
+		candidates = [][]string{{"pbcopy"}}
+	case "windows":
+		candidates = [][]string{{"clip"}}
+	case "linux", "freebsd", "openbsd", "netbsd":
+		// Prefer the Wayland tool when running under Wayland
+		if os.Getenv("WAYLAND_DISPLAY") != "" {
+			candidates = append(candidates, []string{"wl-copy"})
+		}
+		candidates = append(candidates,
+			[]string{"xclip", "-selection", "clipboard"},
+			[]string{"xsel", "--clipboard", "--input"},
+		)
+	default:
+		return fmt.Errorf("clipboard not supported on %s", runtime.GOOS)
+	}
+
+	for _, args := range candidates {
+		path, err := exec.LookPath(args[0])
+		if err != nil {
+			continue
+		}
+		copyCmd := exec.Command(path, args[1:]...)
+		copyCmd.Stdin = bytes.NewBufferString(text)
+		if err := copyCmd.Run(); err != nil {
+			return fmt.Errorf("failed to copy to clipboard: %w", err)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("no clipboard tool found (install xclip, xsel, or wl-clipboard)")
+}
@@ -84,6 +84,9 @@ func runCompletion(cmd *cobra.Command, rootCmd *cobra.Command) error {
 	color.White("You'll need to restart your shell or run 'source ~/%s' after installation.\n\n", getShellRC(shell))
 
 	// Confirm installation
+	if !interactive() {
+		return fmt.Errorf("can't confirm the installation when not running interactively: add 'source <(zd completion %s)' to ~/%s instead", shell, getShellRC(shell))
+	}
 	prompt := promptui.Prompt{
 		Label:     "Install completion",
 		IsConfirm: true,
@@ -0,0 +1,346 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"zd-cli/internal/config"
+	"zd-cli/internal/output"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// configDefaultFlags maps each default key to the flag it fills in
+var configDefaultFlags = map[string]string{
+	"output":   "output",
+	"group":    "group",
+	"priority": "priority",
+	"per_page": "per-page",
+
+	"summarize_command": "command",
+	"summarize_webhook": "webhook",
+}
+
+// configDefaultAnnotation marks flags that take their value from a default
+// key. Output and page size apply wherever those flags exist; the others
+// only where a command opts in, so group and priority never narrow a list.
+const configDefaultAnnotation = "zd_config_default"
+
+// NewConfigCommand creates the config command
+func NewConfigCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Manage default flag values",
+		Long: fmt.Sprintf(`Manage defaults used when a flag isn't given on the command line.
+
+Keys: %s
+
+  output     Output format for commands that support it: table, json, csv, markdown
+  group      Group ID for new tickets
+  priority   Priority for new tickets: %s
+  per_page   Page size for list commands (1-100)
+
+  summarize_command   Command 'zd ticket summarize' pipes a ticket thread to
+  summarize_webhook   URL 'zd ticket summarize' posts a ticket thread to
+
+Defaults are global, or apply to one instance with --instance. An instance's
+own defaults take precedence over the global ones, and flags given on the
+command line take precedence over both. Examples:
+  zd config set output json
+  zd config set group 360001234567 --instance production
+  zd config set summarize_command 'llm -s "Summarize this support ticket"'
+  zd config get
+  zd config unset output`, strings.Join(config.DefaultKeys, ", "), strings.Join(ticketPriorities, ", ")),
+	}
+
+	cmd.AddCommand(newConfigSetCommand())
+	cmd.AddCommand(newConfigGetCommand())
+	cmd.AddCommand(newConfigUnsetCommand())
+
+	return cmd
+}
+
+func newConfigSetCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:       "set <key> <value>",
+		Short:     "Set a default",
+		Args:      cobra.ExactArgs(2),
+		ValidArgs: config.DefaultKeys,
+		RunE:      runConfigSet,
+	}
+}
+
+func newConfigGetCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:       "get [key]",
+		Short:     "Show the defaults in effect",
+		Args:      cobra.MaximumNArgs(1),
+		ValidArgs: config.DefaultKeys,
+		RunE:      runConfigGet,
+	}
+
+	cmd.Flags().StringP("output", "o", "table", "Output format: table, json")
+
+	return cmd
+}
+
+func newConfigUnsetCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:       "unset <key>",
+		Short:     "Remove a default",
+		Args:      cobra.ExactArgs(1),
+		ValidArgs: config.DefaultKeys,
+		RunE:      runConfigUnset,
+	}
+}
+
+func runConfigSet(cmd *cobra.Command, args []string) error {
+	key, value := args[0], strings.TrimSpace(args[1])
+	if err := validateConfigDefault(key, value); err != nil {
+		return err
+	}
+
+	cfg, err := loadOrCreateConfig(cmd)
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	defaults, scope, err := configDefaultsScope(cmd, cfg)
+	if err != nil {
+		return err
+	}
+	defaults[key] = value
+
+	if err := saveConfig(cmd, cfg); err != nil {
+		return fmt.Errorf("failed to save configuration: %w", err)
+	}
+
+	color.Green("✓ Set %s = %s (%s)\n", key, value, scope)
+
+	return nil
+}
+
+func runConfigUnset(cmd *cobra.Command, args []string) error {
+	key := args[0]
+	if !config.IsDefaultKey(key) {
+		return unknownConfigKeyError(key)
+	}
+
+	cfg, err := loadConfig(cmd)
+	if err == config.ErrConfigNotFound {
+		return fmt.Errorf("no configuration found. Run 'zd init' to get started")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	defaults, scope, err := configDefaultsScope(cmd, cfg)
+	if err != nil {
+		return err
+	}
+	if _, ok := defaults[key]; !ok {
+		return fmt.Errorf("%s is not set (%s)", key, scope)
+	}
+	delete(defaults, key)
+
+	if err := saveConfig(cmd, cfg); err != nil {
+		return fmt.Errorf("failed to save configuration: %w", err)
+	}
+
+	color.Green("✓ Unset %s (%s)\n", key, scope)
+
+	return nil
+}
+
+// configDefaultEntry is one default as shown by config get
+type configDefaultEntry struct {
+	Key    string `json:"key"`
+	Value  string `json:"value"`
+	Source string `json:"source"` // global or instance
+}
+
+func runConfigGet(cmd *cobra.Command, args []string) error {
+	keys := config.DefaultKeys
+	if len(args) == 1 {
+		if !config.IsDefaultKey(args[0]) {
+			return unknownConfigKeyError(args[0])
+		}
+		keys = args
+	}
+
+	cfg, err := loadOrCreateConfig(cmd)
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	// Show what applies to the instance commands would run against
+	name := configInstanceName(cmd, cfg)
+	var instanceDefaults config.Defaults
+	if instance, ok := cfg.Instances[name]; ok {
+		instanceDefaults = instance.Defaults
+	}
+
+	entries := []configDefaultEntry{}
+	for _, key := range keys {
+		if value, ok := instanceDefaults[key]; ok {
+			entries = append(entries, configDefaultEntry{Key: key, Value: value, Source: "instance " + name})
+		} else if value, ok := cfg.Defaults[key]; ok {
+			entries = append(entries, configDefaultEntry{Key: key, Value: value, Source: "global"})
+		}
+	}
+
+	format, _ := cmd.Flags().GetString("output")
+	if output.Format(format) == output.FormatJSON {
+		return output.NewWriter(output.FormatJSON).WriteJSON(entries)
+	}
+
+	// A single key prints just its value, for scripts
+	if len(args) == 1 {
+		if len(entries) == 0 {
+			return fmt.Errorf("%s is not set", args[0])
+		}
+		fmt.Println(entries[0].Value)
+		return nil
+	}
+
+	if len(entries) == 0 {
+		color.Yellow("No defaults set. Use 'zd config set <key> <value>' to add one.\n")
+		return nil
+	}
+
+	color.Cyan("%-18s %-30s %s\n", "KEY", "VALUE", "SOURCE")
+	color.White(strings.Repeat("─", 80) + "\n")
+	for _, entry := range entries {
+		fmt.Printf("%-18s %-30s %s\n", entry.Key, entry.Value, entry.Source)
+	}
+
+	return nil
+}
+
+// configDefaultsScope returns the defaults config set and unset change: the
+// instance named with --instance, or the global ones
+func configDefaultsScope(cmd *cobra.Command, cfg *config.Config) (config.Defaults, string, error) {
+	name, _ := cmd.Flags().GetString("instance")
+	if name == "" {
+		if cfg.Defaults == nil {
+			cfg.Defaults = make(config.Defaults)
+		}
+		return cfg.Defaults, "global", nil
+	}
+
+	instance, err := cfg.GetInstance(name)
+	if err != nil {
+		return nil, "", fmt.Errorf("instance '%s' not found. Run 'zd instance list' to see configured instances", name)
+	}
+	if instance.Defaults == nil {
+		instance.Defaults = make(config.Defaults)
+	}
+	return instance.Defaults, "instance " + name, nil
+}
+
+// configInstanceName returns the instance a command runs against, by
+// --instance, $ZD_INSTANCE, or the current instance
+func configInstanceName(cmd *cobra.Command, cfg *config.Config) string {
+	name, _ := cmd.Flags().GetString("instance")
+	if name == "" {
+		name = os.Getenv(config.InstanceEnvVar)
+	}
+	if name == "" {
+		name = cfg.Current
+	}
+	return name
+}
+
+// validateConfigDefault checks a value before it is saved, so a bad default
+// doesn't break every later command
+func validateConfigDefault(key, value string) error {
+	switch key {
+	case "output":
+		switch output.Format(value) {
+		case output.FormatTable, output.FormatJSON, output.FormatCSV, output.FormatMarkdown:
+			return nil
+		}
+		return fmt.Errorf("invalid output %q: use table, json, csv, or markdown", value)
+	case "group":
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return fmt.Errorf("invalid group %q: use a group ID", value)
+		}
+		return nil
+	case "priority":
+		if !containsString(ticketPriorities, value) {
+			return fmt.Errorf("invalid priority %q: use %s", value, strings.Join(ticketPriorities, ", "))
+		}
+		return nil
+	case "per_page":
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 1 || n > 100 {
+			return fmt.Errorf("invalid per_page %q: use a number from 1 to 100", value)
+		}
+		return nil
+	case "summarize_command":
+		if value == "" {
+			return fmt.Errorf("invalid summarize_command: give a command to run")
+		}
+		return nil
+	case "summarize_webhook":
+		if !strings.HasPrefix(value, "https://") && !strings.HasPrefix(value, "http://") {
+			return fmt.Errorf("invalid summarize_webhook %q: use an http:// or https:// URL", value)
+		}
+		return nil
+	}
+	return unknownConfigKeyError(key)
+}
+
+func unknownConfigKeyError(key string) error {
+	return fmt.Errorf("unknown key %q: use %s", key, strings.Join(config.DefaultKeys, ", "))
+}
+
+// useConfigDefault lets a command's flag take its value from a default key
+func useConfigDefault(cmd *cobra.Command, key string) {
+	cmd.Flags().SetAnnotation(configDefaultFlags[key], configDefaultAnnotation, []string{key})
+}
+
+// ApplyConfigDefaults fills in flags the user didn't give from the config
+// defaults of the instance the command runs against. Commands without a
+// config file, or with one that can't be read, run with their own defaults
+// and report any config problem themselves.
+func ApplyConfigDefaults(cmd *cobra.Command) error {
+	cfg, err := loadConfig(cmd)
+	if err != nil {
+		return nil
+	}
+
+	defaults := cfg.DefaultsFor(configInstanceName(cmd, cfg))
+	for key, value := range defaults {
+		flag := cmd.Flags().Lookup(configDefaultFlags[key])
+		if flag == nil || flag.Changed || !configDefaultApplies(flag, key, value) {
+			continue
+		}
+
+		// Set the value without marking the flag as given, so commands
+		// that only honor explicit flags are unaffected
+		if err := flag.Value.Set(value); err != nil {
+			return fmt.Errorf("invalid default %s %q: %w", key, value, err)
+		}
+	}
+
+	return nil
+}
+
+// configDefaultApplies reports whether a default fits the flag it would fill
+func configDefaultApplies(flag *pflag.Flag, key, value string) bool {
+	switch key {
+	case "output":
+		// Not every command supports every format; each lists its own
+		return strings.Contains(flag.Usage, value)
+	case "per_page":
+		return true
+	default:
+		keys := flag.Annotations[configDefaultAnnotation]
+		return len(keys) == 1 && keys[0] == key
+	}
+}
@@ -0,0 +1,486 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"zd-cli/internal/config"
+	"zd-cli/internal/output"
+	"zd-cli/internal/schedule"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// defaultJobTimeout stops jobs that don't set a timeout
+const defaultJobTimeout = time.Hour
+
+// jobStopGrace is how long a job may take to exit after being interrupted
+// before it is killed
+const jobStopGrace = 30 * time.Second
+
+// jobStderrLimit is how much of a failed job's stderr is logged
+const jobStderrLimit = 2000
+
+// daemonConfig is a daemon jobs file
+type daemonConfig struct {
+	PIDFile  string      `yaml:"pid_file"`
+	LogFile  string      `yaml:"log_file"`
+	ZDConfig string      `yaml:"zd_config"` // Config file the jobs use
+	Jobs     []daemonJob `yaml:"jobs"`
+}
+
+// daemonJob is a zd command run on a schedule
+type daemonJob struct {
+	Name       string `yaml:"name"`
+	Schedule   string `yaml:"schedule"`
+	Command    string `yaml:"command"`  // zd arguments, e.g. "export all --out /data"
+	Instance   string `yaml:"instance"` // Instance to run against
+	Output     string `yaml:"output"`   // File to write the command's output to
+	Timeout    string `yaml:"timeout"`
+	RunOnStart bool   `yaml:"run_on_start"`
+
+	schedule schedule.Schedule
+	args     []string
+	timeout  time.Duration
+}
+
+// NewDaemonCommand creates the daemon command
+func NewDaemonCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "daemon",
+		Short: "Run zd commands on a schedule",
+		Long: `Run recurring jobs, such as incremental exports, cache warms, and
+reports, from a YAML jobs file until stopped with Ctrl+C or SIGTERM. Each job
+runs a zd command in its own process on a cron schedule, and the daemon logs
+each start, finish, and failure as a JSON line. A pid file keeps a second daemon from running the
+same jobs.
+
+Schedules are five-field cron expressions (minute hour day month weekday) in
+local time, macros such as @hourly and @daily, or "@every 15m". A job that is
+still running when it is next due is skipped.
+
+  pid_file: /var/run/zd-daemon.pid   # Default: ~/.zd/daemon.pid
+  log_file: /var/log/zd-daemon.log   # Default: stderr
+  zd_config: /etc/zd/config          # Default: $ZD_CONFIG or ~/.zd/config
+  jobs:
+    - name: tickets
+      schedule: "*/15 * * * *"
+      command: ticket export --checkpoint /data/tickets.state --out /data/tickets.ndjson
+      instance: production
+      timeout: 10m
+    - name: warm-users
+      schedule: "@every 30m"
+      command: user list --all --refresh -o json
+      run_on_start: true
+    - name: weekly-groups
+      schedule: "0 7 * * mon"
+      command: group list -o csv
+      output: /data/reports/groups.csv
+
+Examples:
+  zd daemon --config jobs.yaml
+  zd daemon --config jobs.yaml --check
+  zd daemon --config jobs.yaml --run tickets`,
+		Args: cobra.NoArgs,
+		RunE: runDaemon,
+	}
+
+	// Shadows the global --config; jobs use zd_config from the jobs file
+	cmd.Flags().String("config", "", "Jobs file (required)")
+	cmd.Flags().Bool("check", false, "Check the jobs file and show when each job runs next, then exit")
+	cmd.Flags().String("run", "", "Run one job now and exit")
+	cmd.Flags().String("log-format", "json", "Log format: json, text")
+
+	cmd.MarkFlagRequired("config")
+	cmd.MarkFlagFilename("config", "yaml", "yml")
+
+	return cmd
+}
+
+func runDaemon(cmd *cobra.Command, args []string) error {
+	path, _ := cmd.Flags().GetString("config")
+	cfg, err := loadDaemonConfig(path)
+	if err != nil {
+		return err
+	}
+
+	// --instance is the default for jobs that don't name one
+	if instance, _ := cmd.Flags().GetString("instance"); instance != "" {
+		for i := range cfg.Jobs {
+			if cfg.Jobs[i].Instance == "" {
+				cfg.Jobs[i].Instance = instance
+			}
+		}
+	}
+
+	if check, _ := cmd.Flags().GetBool("check"); check {
+		printDaemonSchedule(cfg)
+		return nil
+	}
+
+	logFormat, _ := cmd.Flags().GetString("log-format")
+	logger, closeLog, err := newDaemonLogger(cfg.LogFile, logFormat)
+	if err != nil {
+		return err
+	}
+	defer closeLog()
+
+	// Stop on Ctrl+C or SIGTERM; running jobs are interrupted
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if name, _ := cmd.Flags().GetString("run"); name != "" {
+		for i := range cfg.Jobs {
+			if cfg.Jobs[i].Name == name {
+				return runDaemonJob(ctx, cfg, &cfg.Jobs[i], logger)
+			}
+		}
+		return fmt.Errorf("job %q not found in %s", name, path)
+	}
+
+	pidFile := cfg.PIDFile
+	if pidFile == "" {
+		dir, err := config.GetConfigDir()
+		if err != nil {
+			return err
+		}
+		if err := config.EnsureConfigDir(); err != nil {
+			return err
+		}
+		pidFile = filepath.Join(dir, "daemon.pid")
+	}
+	if err := acquirePIDFile(pidFile); err != nil {
+		return err
+	}
+	defer os.Remove(pidFile)
+
+	logger.Info("daemon started", "pid", os.Getpid(), "jobs", len(cfg.Jobs), "config", path)
+	runDaemonLoop(ctx, cfg, logger)
+	logger.Info("daemon stopped")
+
+	return nil
+}
+
+// loadDaemonConfig reads and checks a jobs file
+func loadDaemonConfig(path string) (*daemonConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read jobs file: %w", err)
+	}
+
+	var cfg daemonConfig
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&cfg); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("invalid jobs file %s: %w", path, err)
+	}
+	if len(cfg.Jobs) == 0 {
+		return nil, fmt.Errorf("no jobs in %s", path)
+	}
+
+	seen := make(map[string]bool)
+	for i := range cfg.Jobs {
+		job := &cfg.Jobs[i]
+		if job.Name == "" {
+			return nil, fmt.Errorf("job %d in %s has no name", i+1, path)
+		}
+		if seen[job.Name] {
+			return nil, fmt.Errorf("duplicate job %q in %s", job.Name, path)
+		}
+		seen[job.Name] = true
+
+		if job.schedule, err = schedule.Parse(job.Schedule); err != nil {
+			return nil, fmt.Errorf("job %s: %w", job.Name, err)
+		}
+
+		if job.args, err = splitCommandLine(job.Command); err != nil {
+			return nil, fmt.Errorf("job %s: invalid command: %w", job.Name, err)
+		}
+		if len(job.args) == 0 {
+			return nil, fmt.Errorf("job %s has no command", job.Name)
+		}
+		if job.args[0] == "zd" {
+			job.args = job.args[1:]
+		}
+		if len(job.args) > 0 && job.args[0] == "daemon" {
+			return nil, fmt.Errorf("job %s can't run the daemon", job.Name)
+		}
+
+		job.timeout = defaultJobTimeout
+		if job.Timeout != "" {
+			if job.timeout, err = time.ParseDuration(job.Timeout); err != nil || job.timeout <= 0 {
+				return nil, fmt.Errorf("job %s: invalid timeout %q", job.Name, job.Timeout)
+			}
+		}
+	}
+
+	return &cfg, nil
+}
+
+// newDaemonLogger returns a structured logger writing to path, or stderr
+// when path is empty
+func newDaemonLogger(path, format string) (*slog.Logger, func(), error) {
+	var w io.Writer = os.Stderr
+	closeLog := func() {}
+	if path != "" {
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to open log file: %w", err)
+		}
+		w = f
+		closeLog = func() { f.Close() }
+	}
+
+	switch format {
+	case "json":
+		return slog.New(slog.NewJSONHandler(w, nil)), closeLog, nil
+	case "text":
+		return slog.New(slog.NewTextHandler(w, nil)), closeLog, nil
+	default:
+		closeLog()
+		return nil, nil, fmt.Errorf("invalid log format %q: use json or text", format)
+	}
+}
+
+// acquirePIDFile writes this process's ID to path, failing if another
+// daemon that is still running holds it. A pid file left behind by a
+// daemon that died is replaced.
+func acquirePIDFile(path string) error {
+	for attempt := 0; attempt < 2; attempt++ {
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+		if err == nil {
+			_, err = fmt.Fprintf(f, "%d\n", os.Getpid())
+			if closeErr := f.Close(); err == nil {
+				err = closeErr
+			}
+			if err != nil {
+				os.Remove(path)
+				return fmt.Errorf("failed to write pid file: %w", err)
+			}
+			return nil
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return fmt.Errorf("failed to create pid file: %w", err)
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read pid file: %w", err)
+		}
+		if pid, err := strconv.Atoi(strings.TrimSpace(string(data))); err == nil && processRunning(pid) {
+			return fmt.Errorf("daemon already running with pid %d (pid file %s)", pid, path)
+		}
+		if err := os.Remove(path); err != nil {
+			return fmt.Errorf("failed to remove stale pid file: %w", err)
+		}
+	}
+	return fmt.Errorf("failed to create pid file %s", path)
+}
+
+// processRunning reports whether a process with this ID exists
+func processRunning(pid int) bool {
+	if pid <= 0 {
+		return false
+	}
+	process, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+	// Windows only finds live processes and can't send signal 0
+	if runtime.GOOS == "windows" {
+		return true
+	}
+	return process.Signal(syscall.Signal(0)) == nil
+}
+
+// runDaemonLoop starts each job when it is due until ctx is done, then
+// waits for running jobs to finish
+func runDaemonLoop(ctx context.Context, cfg *daemonConfig, logger *slog.Logger) {
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	running := make(map[string]bool)
+
+	start := func(job *daemonJob) {
+		mu.Lock()
+		defer mu.Unlock()
+		if running[job.Name] {
+			logger.Warn("job skipped", "job", job.Name, "reason", "still running")
+			return
+		}
+		running[job.Name] = true
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			runDaemonJob(ctx, cfg, job, logger)
+			mu.Lock()
+			delete(running, job.Name)
+			mu.Unlock()
+		}()
+	}
+
+	now := time.Now()
+	next := make([]time.Time, len(cfg.Jobs))
+	for i := range cfg.Jobs {
+		job := &cfg.Jobs[i]
+		next[i] = job.schedule.Next(now)
+		logger.Info("job scheduled", "job", job.Name, "schedule", job.Schedule, "next_run", next[i].Format(time.RFC3339))
+		if job.RunOnStart {
+			start(job)
+		}
+	}
+
+	for {
+		// Sleep until the earliest job is due
+		var wake time.Time
+		for _, t := range next {
+			if !t.IsZero() && (wake.IsZero() || t.Before(wake)) {
+				wake = t
+			}
+		}
+		if wake.IsZero() {
+			logger.Warn("no jobs left to schedule")
+			<-ctx.Done()
+			break
+		}
+
+		timer := time.NewTimer(time.Until(wake))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			wg.Wait()
+			return
+		case <-timer.C:
+		}
+
+		now := time.Now()
+		for i := range cfg.Jobs {
+			if next[i].IsZero() || next[i].After(now) {
+				continue
+			}
+			start(&cfg.Jobs[i])
+			next[i] = cfg.Jobs[i].schedule.Next(now)
+		}
+	}
+
+	wg.Wait()
+}
+
+// runDaemonJob runs one job in a zd subprocess and logs the result
+func runDaemonJob(ctx context.Context, cfg *daemonConfig, job *daemonJob, logger *slog.Logger) error {
+	exe, err := os.Executable()
+	if err != nil {
+		logger.Error("job failed", "job", job.Name, "error", err.Error())
+		return fmt.Errorf("failed to find the zd executable: %w", err)
+	}
+
+	var args []string
+	if cfg.ZDConfig != "" {
+		args = append(args, "--config", cfg.ZDConfig)
+	}
+	if job.Instance != "" {
+		args = append(args, "--instance", job.Instance)
+	}
+	args = append(args, job.args...)
+
+	jobCtx, cancel := context.WithTimeout(ctx, job.timeout)
+	defer cancel()
+
+	process := exec.CommandContext(jobCtx, exe, args...)
+	process.Env = append(os.Environ(), "ZD_DAEMON_JOB="+job.Name)
+	process.Stdout = io.Discard
+	var stderr strings.Builder
+	process.Stderr = &stderr
+
+	// Interrupt rather than kill, so exports can save their checkpoint
+	process.Cancel = func() error { return process.Process.Signal(os.Interrupt) }
+	process.WaitDelay = jobStopGrace
+
+	// Output goes to a temporary file that replaces the real one only when
+	// the job succeeds, so readers never see a partial report
+	var outFile *os.File
+	if job.Output != "" {
+		outFile, err = os.CreateTemp(filepath.Dir(job.Output), "."+filepath.Base(job.Output)+".*")
+		if err != nil {
+			logger.Error("job failed", "job", job.Name, "error", err.Error())
+			return fmt.Errorf("failed to create output file: %w", err)
+		}
+		defer os.Remove(outFile.Name())
+		outFile.Chmod(0644)
+		process.Stdout = outFile
+	}
+
+	logger.Info("job started", "job", job.Name, "args", args)
+	started := time.Now()
+	err = process.Run()
+	duration := time.Since(started).Round(time.Millisecond)
+
+	if outFile != nil {
+		if closeErr := outFile.Close(); err == nil && closeErr != nil {
+			err = closeErr
+		}
+		if err == nil {
+			err = os.Rename(outFile.Name(), job.Output)
+		}
+	}
+
+	if err != nil {
+		attrs := []any{"job", job.Name, "duration_ms", duration.Milliseconds(), "error", err.Error()}
+		if jobCtx.Err() == context.DeadlineExceeded {
+			attrs = append(attrs, "timeout", job.timeout.String())
+		}
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			attrs = append(attrs, "exit_code", exitErr.ExitCode())
+		}
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			if len(msg) > jobStderrLimit {
+				msg = "..." + msg[len(msg)-jobStderrLimit:]
+			}
+			attrs = append(attrs, "stderr", msg)
+		}
+		logger.Error("job failed", attrs...)
+		return fmt.Errorf("job %s failed: %w", job.Name, err)
+	}
+
+	attrs := []any{"job", job.Name, "duration_ms", duration.Milliseconds(), "exit_code", 0}
+	if job.Output != "" {
+		attrs = append(attrs, "output", job.Output)
+	}
+	logger.Info("job finished", attrs...)
+	return nil
+}
+
+// printDaemonSchedule shows each job and when it runs next
+func printDaemonSchedule(cfg *daemonConfig) {
+	color.Green("✓ Jobs file is valid\n")
+	color.Cyan("Jobs (%d)\n", len(cfg.Jobs))
+	color.White(strings.Repeat("─", 80) + "\n")
+
+	now := time.Now()
+	table := output.NewTable("NAME", "SCHEDULE", "NEXT RUN", "INSTANCE", "COMMAND")
+	table.SetFlexColumn(4)
+	for _, job := range cfg.Jobs {
+		nextRun := "never"
+		if t := job.schedule.Next(now); !t.IsZero() {
+			nextRun = t.Format("2006-01-02 15:04")
+		}
+		table.AddRow(job.Name, job.Schedule, nextRun, orDash(job.Instance), strings.Join(job.args, " "))
+	}
+	table.Print()
+}
@@ -0,0 +1,311 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"zd-cli/internal/client"
+	"zd-cli/internal/output"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// diffIgnoredFields differ between instances even when records match, at
+// any depth (dropdown options have their own IDs and URLs)
+var diffIgnoredFields = map[string]bool{
+	"id": true, "url": true, "created_at": true, "updated_at": true, "position": true,
+}
+
+// recordDiff is how one record differs between two instances
+type recordDiff struct {
+	Title   string      `json:"title"`
+	Status  string      `json:"status"` // only_from, only_to, or changed
+	Changes []fieldDiff `json:"changes,omitempty"`
+}
+
+// fieldDiff is one differing value within a record
+type fieldDiff struct {
+	Path string      `json:"path"`
+	From interface{} `json:"from"`
+	To   interface{} `json:"to"`
+}
+
+// NewDiffCommand creates the diff command
+func NewDiffCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff <resource>",
+		Short: "Compare business rules or fields between two instances",
+		Long: fmt.Sprintf(`Compare the triggers, macros, automations, ticket fields, or groups of two
+configured instances, for example to check a sandbox matches production
+before a release.
+
+Resources: %s (fields is short for ticket_fields)
+
+Records are matched by title (groups by name). Custom field and group IDs in
+rules are translated to the target's IDs before comparing, so rules that
+only differ by those IDs are reported as equal. IDs, URLs, timestamps, and
+positions are ignored. Exits with an error when the instances differ.
+Examples:
+  zd diff triggers --from production --to sandbox
+  zd diff macros --from production --to sandbox -o json`, strings.Join(migrateResources, ", ")),
+		Args:      cobra.ExactArgs(1),
+		ValidArgs: append(migrateResources, "fields"),
+		RunE:      runDiff,
+	}
+
+	cmd.Flags().String("from", "", "Instance to compare from")
+	cmd.Flags().String("to", "", "Instance to compare to")
+	cmd.Flags().StringP("output", "o", "table", "Output format: table, json")
+	cmd.MarkFlagRequired("from")
+	cmd.MarkFlagRequired("to")
+
+	cmd.RegisterFlagCompletionFunc("from", CompleteInstanceNames)
+	cmd.RegisterFlagCompletionFunc("to", CompleteInstanceNames)
+
+	return cmd
+}
+
+func runDiff(cmd *cobra.Command, args []string) error {
+	name := args[0]
+	if name == "fields" {
+		name = "ticket_fields"
+	}
+	if !containsString(migrateResources, name) {
+		return fmt.Errorf("unknown resource %q: use %s", args[0], strings.Join(migrateResources, ", "))
+	}
+	res, _ := client.FindExportResource(name)
+
+	from, _ := cmd.Flags().GetString("from")
+	to, _ := cmd.Flags().GetString("to")
+
+	source, err := getClientForInstance(cmd, from)
+	if err != nil {
+		return err
+	}
+	target, err := getClientForInstance(cmd, to)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := runContext(source, 5*time.Minute)
+	defer cancel()
+
+	remap, err := loadRemapper(ctx, source, target)
+	if err != nil {
+		return err
+	}
+
+	sourceRecords, err := fetchRecords(ctx, source, res)
+	if err != nil {
+		return fmt.Errorf("failed to read %s from %s: %w", name, from, err)
+	}
+	targetRecords, err := fetchRecords(ctx, target, res)
+	if err != nil {
+		return fmt.Errorf("failed to read %s from %s: %w", name, to, err)
+	}
+
+	diffs := diffRecords(name, sourceRecords, targetRecords, remap)
+
+	format, _ := cmd.Flags().GetString("output")
+	if output.Format(format) == output.FormatJSON {
+		if diffs == nil {
+			diffs = []recordDiff{}
+		}
+		if err := output.NewWriter(output.FormatJSON).WriteJSON(diffs); err != nil {
+			return err
+		}
+	} else {
+		displayDiffs(name, from, to, diffs, len(sourceRecords))
+	}
+
+	if len(diffs) > 0 {
+		return fmt.Errorf("%d %s differ between %s and %s", len(diffs), name, from, to)
+	}
+
+	return nil
+}
+
+// loadRemapper matches the ticket fields and groups of two instances by
+// title, so IDs in rules can be compared or copied across
+func loadRemapper(ctx context.Context, source, target *client.Client) (*idRemapper, error) {
+	remap := &idRemapper{fields: make(map[string]string), groups: make(map[string]string)}
+
+	for _, name := range []string{"ticket_fields", "groups"} {
+		res, _ := client.FindExportResource(name)
+		sourceRecords, err := fetchRecords(ctx, source, res)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", name, err)
+		}
+		targetRecords, err := fetchRecords(ctx, target, res)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", name, err)
+		}
+
+		byTitle := make(map[string]string)
+		for _, record := range targetRecords {
+			byTitle[recordTitle(name, record)] = recordID(record)
+		}
+		for _, record := range sourceRecords {
+			if id, ok := byTitle[recordTitle(name, record)]; ok {
+				remap.add(name, recordID(record), id)
+			}
+		}
+	}
+
+	return remap, nil
+}
+
+// diffRecords compares the records of two instances, matched by title
+func diffRecords(resource string, sourceRecords, targetRecords []map[string]interface{}, remap *idRemapper) []recordDiff {
+	targets := make(map[string]map[string]interface{})
+	for _, record := range targetRecords {
+		targets[recordTitle(resource, record)] = record
+	}
+
+	var diffs []recordDiff
+	seen := make(map[string]bool)
+	for _, record := range sourceRecords {
+		title := recordTitle(resource, record)
+		seen[title] = true
+
+		match, ok := targets[title]
+		if !ok {
+			diffs = append(diffs, recordDiff{Title: title, Status: "only_from"})
+			continue
+		}
+
+		// Compare in the target's IDs
+		remap.apply(record)
+
+		var changes []fieldDiff
+		diffValues("", record, match, &changes)
+		if len(changes) > 0 {
+			diffs = append(diffs, recordDiff{Title: title, Status: "changed", Changes: changes})
+		}
+	}
+
+	for _, record := range targetRecords {
+		if title := recordTitle(resource, record); !seen[title] {
+			diffs = append(diffs, recordDiff{Title: title, Status: "only_to"})
+		}
+	}
+
+	return diffs
+}
+
+// diffValues appends the differences between two decoded JSON values
+func diffValues(path string, from, to interface{}, changes *[]fieldDiff) {
+	fromMap, fromIsMap := from.(map[string]interface{})
+	toMap, toIsMap := to.(map[string]interface{})
+	if fromIsMap && toIsMap {
+		keys := make(map[string]bool)
+		for key := range fromMap {
+			keys[key] = true
+		}
+		for key := range toMap {
+			keys[key] = true
+		}
+
+		sorted := make([]string, 0, len(keys))
+		for key := range keys {
+			if diffIgnoredFields[key] {
+				continue
+			}
+			sorted = append(sorted, key)
+		}
+		sort.Strings(sorted)
+
+		for _, key := range sorted {
+			diffValues(joinPath(path, key), fromMap[key], toMap[key], changes)
+		}
+		return
+	}
+
+	fromList, fromIsList := from.([]interface{})
+	toList, toIsList := to.([]interface{})
+	if fromIsList && toIsList {
+		for i := 0; i < max(len(fromList), len(toList)); i++ {
+			var a, b interface{}
+			if i < len(fromList) {
+				a = fromList[i]
+			}
+			if i < len(toList) {
+				b = toList[i]
+			}
+			diffValues(fmt.Sprintf("%s[%d]", path, i), a, b, changes)
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(normalizeValue(from), normalizeValue(to)) {
+		*changes = append(*changes, fieldDiff{Path: path, From: from, To: to})
+	}
+}
+
+// normalizeValue makes numbers and numeric strings compare equal, since the
+// API returns some IDs as strings in one place and numbers in another
+func normalizeValue(value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+	switch v := value.(type) {
+	case map[string]interface{}, []interface{}, bool:
+		return v
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// joinPath adds a key to a dotted path
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// displayDiffs prints the differences between two instances
+func displayDiffs(resource, from, to string, diffs []recordDiff, compared int) {
+	color.Cyan("%s: %s → %s\n", resource, from, to)
+	color.White(strings.Repeat("─", 80) + "\n")
+
+	if len(diffs) == 0 {
+		color.Green("✓ All %d %s match\n", compared, resource)
+		return
+	}
+
+	for _, diff := range diffs {
+		switch diff.Status {
+		case "only_from":
+			color.Red("- %s (only in %s)\n", diff.Title, from)
+		case "only_to":
+			color.Green("+ %s (only in %s)\n", diff.Title, to)
+		case "changed":
+			color.Yellow("~ %s\n", diff.Title)
+			for _, change := range diff.Changes {
+				color.White("    %s:\n", change.Path)
+				color.Red("      - %s\n", formatDiffValue(change.From))
+				color.Green("      + %s\n", formatDiffValue(change.To))
+			}
+		}
+	}
+
+	fmt.Println()
+	color.White("%d %s differ\n", len(diffs), resource)
+}
+
+// formatDiffValue shows a value compactly, marking missing values
+func formatDiffValue(value interface{}) string {
+	if value == nil {
+		return "(none)"
+	}
+	if s, ok := value.(string); ok {
+		return fmt.Sprintf("%q", s)
+	}
+	return fmt.Sprint(value)
+}
@@ -0,0 +1,375 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"zd-cli/internal/client"
+	"zd-cli/internal/output"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// NewDynamicContentCommand creates the dynamic-content command
+func NewDynamicContentCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "dynamic-content",
+		Aliases: []string{"dc"},
+		Short:   "Manage dynamic content",
+		Long: `Manage dynamic content: snippets of text with a translation per locale,
+placed in macros, triggers, and automations with placeholders such as
+{{dc.welcome_message}}.`,
+	}
+
+	cmd.AddCommand(newDynamicContentListCommand())
+	cmd.AddCommand(newDynamicContentShowCommand())
+	cmd.AddCommand(newDynamicContentCreateCommand())
+
+	// Add global output format flag to all subcommands
+	cmd.PersistentFlags().StringP("output", "o", "table", "Output format: table, json, csv")
+
+	return cmd
+}
+
+// NewLocaleCommand creates the locale command
+func NewLocaleCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "locale",
+		Short: "Browse the account's locales",
+	}
+
+	cmd.AddCommand(newLocaleListCommand())
+
+	// Add global output format flag to all subcommands
+	cmd.PersistentFlags().StringP("output", "o", "table", "Output format: table, json, csv")
+
+	return cmd
+}
+
+func newDynamicContentListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List dynamic content items",
+		RunE:  withClient(runDynamicContentList),
+	}
+
+	return cmd
+}
+
+func newDynamicContentShowCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show <item-id|name|placeholder>",
+		Short: "Show a dynamic content item and its translations",
+		Args:  cobra.ExactArgs(1),
+		RunE:  withClient(runDynamicContentShow),
+	}
+
+	return cmd
+}
+
+func newDynamicContentCreateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a dynamic content item",
+		Long: `Create a dynamic content item with its text in the default locale, and
+optionally translations with --variant locale=text. Text may name a file
+with @path or stdin with -. Locales are codes such as en-US or locale IDs;
+see 'zd locale list'. Examples:
+  zd dc create --name "Welcome message" --content "Thanks for contacting us!"
+  zd dc create --name "Signature" --locale en-US --content @sig.en.txt --variant fr=@sig.fr.txt --variant de=@sig.de.txt`,
+		Args: cobra.NoArgs,
+		RunE: withClient(runDynamicContentCreate),
+	}
+
+	cmd.Flags().String("name", "", "Item name; the placeholder is derived from it")
+	cmd.Flags().String("content", "", "Text in the default locale (@file or - for stdin)")
+	cmd.Flags().String("locale", "en-US", "Default locale code or ID")
+	cmd.Flags().StringArray("variant", nil, "Translation as locale=text (repeatable, text may be @file)")
+	cmd.MarkFlagRequired("name")
+	cmd.MarkFlagRequired("content")
+
+	return cmd
+}
+
+func newLocaleListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the locales enabled for the account",
+		RunE:  withClient(runLocaleList),
+	}
+
+	cmd.Flags().Bool("refresh", false, "Bypass cache and fetch fresh data")
+
+	return cmd
+}
+
+func runDynamicContentList(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	ctx, cancel := runContext(zdClient, 2*time.Minute)
+	defer cancel()
+
+	items, err := zdClient.ListDynamicContentItems(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list dynamic content: %w", err)
+	}
+
+	if len(items) == 0 {
+		color.Yellow("No dynamic content found.\n")
+		return nil
+	}
+
+	sort.Slice(items, func(i, j int) bool { return strings.ToLower(items[i].Name) < strings.ToLower(items[j].Name) })
+
+	headers := []string{"id", "name", "placeholder", "default_locale_id", "outdated", "created_at", "updated_at"}
+	return writeOutput(cmd, items, headers, func() {
+		locales := localeCodes(ctx, zdClient)
+
+		color.Cyan("Dynamic Content (%d)\n", len(items))
+		color.White(strings.Repeat("─", 80) + "\n")
+
+		table := output.NewTable("ID", "NAME", "PLACEHOLDER", "LOCALES")
+		table.SetFlexColumn(1)
+		for _, item := range items {
+			var codes []string
+			for _, variant := range item.Variants {
+				codes = append(codes, localeCode(locales, variant.LocaleID))
+			}
+			name := item.Name
+			if item.Outdated {
+				name += " " + color.YellowString("(outdated)")
+			}
+			table.AddRow(fmt.Sprintf("%d", item.ID), name, item.Placeholder, strings.Join(codes, ", "))
+		}
+		table.Print()
+	})
+}
+
+func runDynamicContentShow(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	ctx, cancel := runContext(zdClient, 2*time.Minute)
+	defer cancel()
+
+	itemID, err := resolveDynamicContentItem(ctx, zdClient, args[0])
+	if err != nil {
+		return err
+	}
+
+	item, err := zdClient.GetDynamicContentItem(ctx, itemID)
+	if err != nil {
+		return fmt.Errorf("failed to get dynamic content: %w", err)
+	}
+
+	format, _ := cmd.Flags().GetString("output")
+	writer := output.NewWriter(output.Format(format))
+
+	switch output.Format(format) {
+	case output.FormatJSON:
+		return writer.WriteJSON(item)
+
+	case output.FormatCSV:
+		headers := []string{"id", "locale_id", "default", "active", "outdated", "content", "updated_at"}
+		return writer.WriteCSV(item.Variants, headers)
+
+	default:
+		// Table format (default)
+		displayDynamicContentItem(item, localeCodes(ctx, zdClient))
+		return nil
+	}
+}
+
+func runDynamicContentCreate(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	name, _ := cmd.Flags().GetString("name")
+	content, err := messageFromFlag(cmd, "content")
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(content) == "" {
+		return fmt.Errorf("--content cannot be empty")
+	}
+
+	// Read every translation before contacting Zendesk, so a bad flag fails fast
+	type translation struct{ locale, text string }
+	var translations []translation
+	variants, _ := cmd.Flags().GetStringArray("variant")
+	for _, variant := range variants {
+		code, value, ok := strings.Cut(variant, "=")
+		if !ok || strings.TrimSpace(code) == "" {
+			return fmt.Errorf("invalid variant %q: use locale=text", variant)
+		}
+		text, err := readTextArg(value)
+		if err != nil {
+			return fmt.Errorf("--variant %s: %w", code, err)
+		}
+		if value == "-" || (strings.HasPrefix(value, "@") && !strings.HasPrefix(value, "@@")) {
+			text = strings.TrimRight(text, "\r\n")
+		}
+		translations = append(translations, translation{strings.TrimSpace(code), text})
+	}
+
+	ctx := context.Background()
+
+	locales, err := zdClient.ListLocales(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list locales: %w", err)
+	}
+
+	localeFlag, _ := cmd.Flags().GetString("locale")
+	defaultLocale, err := resolveLocale(locales, localeFlag)
+	if err != nil {
+		return err
+	}
+
+	item := client.DynamicContentItem{
+		Name:            name,
+		DefaultLocaleID: defaultLocale,
+		Variants: []client.DynamicContentVariant{
+			{LocaleID: defaultLocale, Content: content, Default: true, Active: true},
+		},
+	}
+
+	for _, t := range translations {
+		localeID, err := resolveLocale(locales, t.locale)
+		if err != nil {
+			return err
+		}
+		item.Variants = append(item.Variants, client.DynamicContentVariant{LocaleID: localeID, Content: t.text, Active: true})
+	}
+
+	created, err := zdClient.CreateDynamicContentItem(ctx, item)
+	if err != nil {
+		return fmt.Errorf("failed to create dynamic content: %w", err)
+	}
+
+	color.Green("✓ Dynamic content created successfully!\n")
+	color.White("Item ID:      %d\n", created.ID)
+	color.White("Placeholder:  %s\n", created.Placeholder)
+
+	return nil
+}
+
+func runLocaleList(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	ctx := context.Background()
+
+	locales, err := zdClient.ListLocales(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list locales: %w", err)
+	}
+
+	headers := []string{"id", "locale", "name"}
+	return writeOutput(cmd, locales, headers, func() {
+		color.Cyan("Locales (%d)\n", len(locales))
+		color.White(strings.Repeat("─", 80) + "\n")
+
+		table := output.NewTable("ID", "LOCALE", "NAME")
+		table.SetFlexColumn(2)
+		for _, locale := range locales {
+			table.AddRow(fmt.Sprintf("%d", locale.ID), locale.Locale, locale.Name)
+		}
+		table.Print()
+	})
+}
+
+// resolveDynamicContentItem turns an item ID, name, or placeholder into an
+// item ID
+func resolveDynamicContentItem(ctx context.Context, zdClient *client.Client, value string) (int64, error) {
+	if id, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return id, nil
+	}
+
+	items, err := zdClient.ListDynamicContentItems(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list dynamic content: %w", err)
+	}
+
+	for _, item := range items {
+		if strings.EqualFold(item.Name, value) || item.Placeholder == value {
+			return item.ID, nil
+		}
+	}
+
+	return 0, fmt.Errorf("dynamic content not found: %s (see 'zd dynamic-content list')", value)
+}
+
+// resolveLocale turns a locale code, such as en-US, or a locale ID into a
+// locale ID. A language without a region, such as fr, matches the first
+// enabled locale for it.
+func resolveLocale(locales []client.Locale, value string) (int64, error) {
+	if id, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return id, nil
+	}
+
+	for _, locale := range locales {
+		if strings.EqualFold(locale.Locale, value) {
+			return locale.ID, nil
+		}
+	}
+	for _, locale := range locales {
+		if language, _, _ := strings.Cut(locale.Locale, "-"); strings.EqualFold(language, value) {
+			return locale.ID, nil
+		}
+	}
+
+	return 0, fmt.Errorf("locale not enabled: %s (see 'zd locale list')", value)
+}
+
+// localeCodes maps locale IDs to codes. When the locales can't be listed
+// the map is empty and IDs are shown instead.
+func localeCodes(ctx context.Context, zdClient *client.Client) map[int64]string {
+	codes := make(map[int64]string)
+	locales, err := zdClient.ListLocales(ctx)
+	if err != nil {
+		return codes
+	}
+	for _, locale := range locales {
+		codes[locale.ID] = locale.Locale
+	}
+	return codes
+}
+
+// localeCode returns the code of a locale, or its ID when it isn't known
+func localeCode(codes map[int64]string, localeID int64) string {
+	return nameOrID(codes, localeID)
+}
+
+// Display full dynamic content details
+func displayDynamicContentItem(item *client.DynamicContentItem, locales map[int64]string) {
+	color.Cyan("Dynamic Content: %s\n", item.Name)
+	color.White(strings.Repeat("─", 80) + "\n")
+
+	color.White("ID:           %d\n", item.ID)
+	color.White("Placeholder:  %s\n", item.Placeholder)
+	color.White("Default:      %s\n", localeCode(locales, item.DefaultLocaleID))
+	if item.Outdated {
+		color.Yellow("Outdated:     some translations need updating\n")
+	}
+
+	// Dates
+	color.White("\nDates:\n")
+	color.White("  Created:      %s\n", formatDate(item.CreatedAt))
+	color.White("  Last Updated: %s\n", formatDate(item.UpdatedAt))
+
+	for _, variant := range item.Variants {
+		var badges []string
+		if variant.Default {
+			badges = append(badges, color.GreenString("default"))
+		}
+		if !variant.Active {
+			badges = append(badges, color.YellowString("inactive"))
+		}
+		if variant.Outdated {
+			badges = append(badges, color.YellowString("outdated"))
+		}
+
+		header := localeCode(locales, variant.LocaleID)
+		if len(badges) > 0 {
+			header += " (" + strings.Join(badges, ", ") + ")"
+		}
+		color.White("\n%s:\n", header)
+		for _, line := range strings.Split(variant.Content, "\n") {
+			fmt.Printf("  %s\n", line)
+		}
+	}
+}
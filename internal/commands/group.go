@@ -8,12 +8,14 @@ import (
 	"time"
 
 	"zd-cli/internal/client"
-	"zd-cli/internal/output"
 
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 )
 
+// groupListHeaders are the CSV columns for group lists
+var groupListHeaders = []string{"id", "name", "description", "default", "deleted", "created_at", "updated_at"}
+
 // NewGroupCommand creates the group management command
 func NewGroupCommand() *cobra.Command {
 	cmd := &cobra.Command{
@@ -37,12 +39,13 @@ func newGroupListCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "List all groups",
-		RunE:  runGroupList,
+		RunE:  withClient(runGroupList),
 	}
 
 	cmd.Flags().Int("page", 1, "Page number")
 	cmd.Flags().Int("per-page", 100, "Results per page (max 100)")
 	cmd.Flags().Bool("refresh", false, "Bypass cache and fetch fresh data")
+	cmd.Flags().Bool("all", false, "Fetch every page using cursor pagination, streaming results as they arrive")
 
 	return cmd
 }
@@ -52,7 +55,7 @@ func newGroupShowCommand() *cobra.Command {
 		Use:   "show <group-id>",
 		Short: "Show detailed information for a specific group",
 		Args:  cobra.ExactArgs(1),
-		RunE:  runGroupShow,
+		RunE:  withClient(runGroupShow),
 	}
 
 	cmd.Flags().Bool("refresh", false, "Bypass cache and fetch fresh data")
@@ -65,7 +68,7 @@ func newGroupUsersCommand() *cobra.Command {
 		Use:   "users <group-id>",
 		Short: "List users in a group",
 		Args:  cobra.ExactArgs(1),
-		RunE:  runGroupUsers,
+		RunE:  withClient(runGroupUsers),
 	}
 
 	cmd.Flags().Int("page", 1, "Page number")
@@ -80,7 +83,7 @@ func newGroupMembershipsCommand() *cobra.Command {
 		Use:   "memberships <group-id>",
 		Short: "List memberships for a group",
 		Args:  cobra.ExactArgs(1),
-		RunE:  runGroupMemberships,
+		RunE:  withClient(runGroupMemberships),
 	}
 
 	cmd.Flags().Int("page", 1, "Page number")
@@ -90,21 +93,28 @@ func newGroupMembershipsCommand() *cobra.Command {
 	return cmd
 }
 
-func runGroupList(cmd *cobra.Command, args []string) error {
-	zdClient, err := getClientFromFlags(cmd)
-	if err != nil {
-		return err
-	}
-
+func runGroupList(cmd *cobra.Command, args []string, zdClient *client.Client) error {
 	page, _ := cmd.Flags().GetInt("page")
 	perPage, _ := cmd.Flags().GetInt("per-page")
+	all, _ := cmd.Flags().GetBool("all")
 
 	if perPage > 100 {
 		perPage = 100
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
+	if all {
+		ctx, cancel := runContext(zdClient, 30*time.Minute)
+		defer cancel()
+
+		stream := newListStream(cmd, zdClient, "groups", groupListHeaders, printGroupTable)
+		defer stream.stop()
+		if err := zdClient.ListAllGroups(ctx, stream.write); err != nil {
+			return fmt.Errorf("failed to list groups: %w", err)
+		}
+		return stream.finish()
+	}
+
+	ctx := context.Background()
 
 	resp, err := zdClient.ListGroups(ctx, page, perPage)
 	if err != nil {
@@ -119,19 +129,13 @@ func runGroupList(cmd *cobra.Command, args []string) error {
 	return outputGroups(cmd, resp.Groups, page, resp.Count, resp.NextPage)
 }
 
-func runGroupShow(cmd *cobra.Command, args []string) error {
-	zdClient, err := getClientFromFlags(cmd)
-	if err != nil {
-		return err
-	}
-
+func runGroupShow(cmd *cobra.Command, args []string, zdClient *client.Client) error {
 	groupID, err := strconv.ParseInt(args[0], 10, 64)
 	if err != nil {
 		return fmt.Errorf("invalid group ID: %s", args[0])
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
+	ctx := context.Background()
 
 	group, err := zdClient.GetGroup(ctx, groupID)
 	if err != nil {
@@ -141,12 +145,7 @@ func runGroupShow(cmd *cobra.Command, args []string) error {
 	return outputGroup(cmd, group, true)
 }
 
-func runGroupUsers(cmd *cobra.Command, args []string) error {
-	zdClient, err := getClientFromFlags(cmd)
-	if err != nil {
-		return err
-	}
-
+func runGroupUsers(cmd *cobra.Command, args []string, zdClient *client.Client) error {
 	groupID, err := strconv.ParseInt(args[0], 10, 64)
 	if err != nil {
 		return fmt.Errorf("invalid group ID: %s", args[0])
@@ -159,8 +158,7 @@ func runGroupUsers(cmd *cobra.Command, args []string) error {
 		perPage = 100
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
+	ctx := context.Background()
 
 	resp, err := zdClient.GetGroupUsers(ctx, groupID, page, perPage)
 	if err != nil {
@@ -175,12 +173,7 @@ func runGroupUsers(cmd *cobra.Command, args []string) error {
 	return outputUsers(cmd, resp.Users, page, resp.Count, resp.NextPage)
 }
 
-func runGroupMemberships(cmd *cobra.Command, args []string) error {
-	zdClient, err := getClientFromFlags(cmd)
-	if err != nil {
-		return err
-	}
-
+func runGroupMemberships(cmd *cobra.Command, args []string, zdClient *client.Client) error {
 	groupID, err := strconv.ParseInt(args[0], 10, 64)
 	if err != nil {
 		return fmt.Errorf("invalid group ID: %s", args[0])
@@ -193,8 +186,7 @@ func runGroupMemberships(cmd *cobra.Command, args []string) error {
 		perPage = 100
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
+	ctx := context.Background()
 
 	resp, err := zdClient.GetGroupMemberships(ctx, groupID, page, perPage)
 	if err != nil {
@@ -211,39 +203,15 @@ func runGroupMemberships(cmd *cobra.Command, args []string) error {
 
 // outputGroup outputs a single group in the requested format
 func outputGroup(cmd *cobra.Command, group *client.Group, detailed bool) error {
-	format, _ := cmd.Flags().GetString("output")
-	writer := output.NewWriter(output.Format(format))
-
-	switch output.Format(format) {
-	case output.FormatJSON:
-		return writer.WriteJSON(group)
-
-	case output.FormatCSV:
-		headers := []string{"id", "name", "description", "default", "deleted", "created_at", "updated_at"}
-		return writer.WriteCSV(group, headers)
-
-	default:
-		// Table format (default)
+	headers := []string{"id", "name", "description", "default", "deleted", "created_at", "updated_at"}
+	return writeOutput(cmd, group, headers, func() {
 		displayGroup(group, detailed)
-		return nil
-	}
+	})
 }
 
 // outputGroups outputs multiple groups in the requested format
 func outputGroups(cmd *cobra.Command, groups []client.Group, page, total int, nextPage string) error {
-	format, _ := cmd.Flags().GetString("output")
-	writer := output.NewWriter(output.Format(format))
-
-	switch output.Format(format) {
-	case output.FormatJSON:
-		return writer.WriteJSON(groups)
-
-	case output.FormatCSV:
-		headers := []string{"id", "name", "description", "default", "deleted", "created_at", "updated_at"}
-		return writer.WriteCSV(groups, headers)
-
-	default:
-		// Table format (default)
+	return writeOutput(cmd, groups, groupListHeaders, func() {
 		if page > 0 {
 			color.Cyan("Groups (Page %d, showing %d of %d total)\n", page, len(groups), total)
 		} else {
@@ -251,35 +219,20 @@ func outputGroups(cmd *cobra.Command, groups []client.Group, page, total int, ne
 		}
 		color.White(strings.Repeat("─", 80) + "\n\n")
 
-		for i, group := range groups {
-			displayGroupSummary(&group, i+1)
-		}
+		printGroupTable(groups, 1, true)
 
 		// Show pagination info
 		if nextPage != "" {
 			fmt.Println()
 			color.White("More results available. Use --page %d to see next page.\n", page+1)
 		}
-
-		return nil
-	}
+	})
 }
 
 // outputMemberships outputs memberships in the requested format
 func outputMemberships(cmd *cobra.Command, memberships []client.GroupMembership, page, total int, nextPage string) error {
-	format, _ := cmd.Flags().GetString("output")
-	writer := output.NewWriter(output.Format(format))
-
-	switch output.Format(format) {
-	case output.FormatJSON:
-		return writer.WriteJSON(memberships)
-
-	case output.FormatCSV:
-		headers := []string{"id", "user_id", "group_id", "default", "created_at", "updated_at"}
-		return writer.WriteCSV(memberships, headers)
-
-	default:
-		// Table format (default)
+	headers := []string{"id", "user_id", "group_id", "default", "created_at", "updated_at"}
+	return writeOutput(cmd, memberships, headers, func() {
 		if page > 0 {
 			color.Cyan("Group Memberships (Page %d, showing %d of %d total)\n", page, len(memberships), total)
 		} else {
@@ -296,28 +249,7 @@ func outputMemberships(cmd *cobra.Command, memberships []client.GroupMembership,
 			fmt.Println()
 			color.White("More results available. Use --page %d to see next page.\n", page+1)
 		}
-
-		return nil
-	}
-}
-
-// Display a group summary (compact format)
-func displayGroupSummary(group *client.Group, index int) {
-	defaultBadge := ""
-	if group.Default {
-		defaultBadge = " | " + color.GreenString("default")
-	}
-	deletedBadge := ""
-	if group.Deleted {
-		deletedBadge = " | " + color.RedString("deleted")
-	}
-
-	fmt.Printf("#%-3d %s | ID: %d%s%s\n",
-		index,
-		color.CyanString(group.Name),
-		group.ID,
-		defaultBadge,
-		deletedBadge)
+	})
 }
 
 // Display full group details
@@ -362,3 +294,34 @@ func displayMembershipSummary(membership *client.GroupMembership, index int) {
 		membership.UserID,
 		defaultBadge)
 }
+
+// completeGroupIDs completes group IDs, described by group name, from the
+// cached group list. Errors are swallowed since completion must never print
+// output.
+func completeGroupIDs(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	zdClient, err := getClientFromFlags(cmd)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var completions []string
+	for page := 1; ; page++ {
+		resp, err := zdClient.ListGroups(ctx, page, 100)
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		for _, group := range resp.Groups {
+			if !group.Deleted {
+				completions = append(completions, fmt.Sprintf("%d\t%s", group.ID, group.Name))
+			}
+		}
+		if resp.NextPage == "" {
+			break
+		}
+	}
+
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
@@ -0,0 +1,540 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"zd-cli/internal/client"
+	"zd-cli/internal/markdown"
+	"zd-cli/internal/output"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// articleListHeaders are the CSV columns for article lists
+var articleListHeaders = []string{"id", "title", "section_id", "locale", "draft", "promoted", "updated_at", "html_url"}
+
+// defaultArticleLocale is the locale new articles are written in unless
+// --locale is given
+const defaultArticleLocale = "en-us"
+
+// NewHelpCenterCommand creates the Help Center command
+func NewHelpCenterCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "hc",
+		Aliases: []string{"help-center"},
+		Short:   "Manage the Help Center knowledge base",
+		Long:    "Browse, search, and edit Help Center articles, sections, and categories, or export them to Markdown.",
+	}
+
+	cmd.AddCommand(newHCArticleCommand())
+	cmd.AddCommand(newHCSectionCommand())
+	cmd.AddCommand(newHCCategoryCommand())
+	cmd.AddCommand(newHCExportCommand())
+
+	return cmd
+}
+
+func newHCArticleCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "article",
+		Aliases: []string{"articles"},
+		Short:   "Manage Help Center articles",
+		Long: `List, search, show, create, and update Help Center articles.
+
+Article bodies are HTML. 'show -o markdown' converts a body to Markdown, and
+create and update convert a Markdown body to HTML with --markdown, which is
+the default when the body is read from a .md file.`,
+	}
+
+	cmd.AddCommand(newHCArticleListCommand())
+	cmd.AddCommand(newHCArticleShowCommand())
+	cmd.AddCommand(newHCArticleSearchCommand())
+	cmd.AddCommand(newHCArticleCreateCommand())
+	cmd.AddCommand(newHCArticleUpdateCommand())
+
+	return cmd
+}
+
+func newHCArticleListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List articles",
+		Args:  cobra.NoArgs,
+		RunE:  withClient(runHCArticleList),
+	}
+
+	addArticleFilterFlags(cmd)
+	cmd.Flags().Int("page", 1, "Page number")
+	cmd.Flags().Int("per-page", 30, "Results per page (max 100)")
+	cmd.Flags().Bool("refresh", false, "Bypass cache and fetch fresh data")
+	cmd.Flags().StringP("output", "o", "table", "Output format: table, json, csv")
+
+	return cmd
+}
+
+func newHCArticleSearchCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "search <query>",
+		Short: "Search articles",
+		Args:  cobra.ExactArgs(1),
+		RunE:  withClient(runHCArticleSearch),
+	}
+
+	addArticleFilterFlags(cmd)
+	cmd.Flags().Int("page", 1, "Page number")
+	cmd.Flags().Int("per-page", 30, "Results per page (max 100)")
+	cmd.Flags().StringP("output", "o", "table", "Output format: table, json, csv")
+
+	return cmd
+}
+
+func newHCArticleShowCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show <article-id>",
+		Short: "Show an article",
+		Args:  cobra.ExactArgs(1),
+		RunE:  withClient(runHCArticleShow),
+	}
+
+	cmd.Flags().String("locale", "", "Show this translation (default: the Help Center's default locale)")
+	cmd.Flags().Bool("refresh", false, "Bypass cache and fetch fresh data")
+	cmd.Flags().StringP("output", "o", "table", "Output format: table, json, markdown")
+
+	return cmd
+}
+
+func newHCArticleCreateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create an article",
+		Long: `Create an article in a section. Section subscribers are not notified.
+
+Without --permission-group, the instance's only permission group is used.
+Without --user-segment, the article is visible to everyone. Examples:
+  zd hc article create --section 360001 --title "Resetting your password" --body @reset.md
+  zd hc article create --section 360001 --title "Draft" --body "<p>TODO</p>" --draft`,
+		Args: cobra.NoArgs,
+		RunE: withClient(runHCArticleCreate),
+	}
+
+	cmd.Flags().Int64("section", 0, "Section to create the article in")
+	cmd.Flags().String("title", "", "Article title")
+	cmd.Flags().String("body", "", "Article body (@file to read a file, - for stdin)")
+	cmd.Flags().Bool("markdown", false, "Convert the body from Markdown to HTML (default for @file.md)")
+	cmd.Flags().String("locale", defaultArticleLocale, "Locale to write the article in")
+	cmd.Flags().Int64("permission-group", 0, "Permission group that can edit the article")
+	cmd.Flags().Int64("user-segment", 0, "User segment that can see the article (default: everyone)")
+	cmd.Flags().StringSlice("labels", nil, "Labels (comma-separated)")
+	cmd.Flags().Bool("draft", false, "Create the article as a draft")
+	cmd.Flags().Bool("promoted", false, "Promote the article")
+	cmd.MarkFlagRequired("section")
+	cmd.MarkFlagRequired("title")
+
+	return cmd
+}
+
+func newHCArticleUpdateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update <article-id>",
+		Short: "Update an article",
+		Long: `Update an article's title, body, or draft state in one locale, or its
+section, labels, and other settings shared by every locale. Examples:
+  zd hc article update 360002 --body @reset.md
+  zd hc article update 360002 --title "Resetting your password" --publish
+  zd hc article update 360002 --section 360005 --labels password,account`,
+		Args: cobra.ExactArgs(1),
+		RunE: withClient(runHCArticleUpdate),
+	}
+
+	cmd.Flags().String("title", "", "New title")
+	cmd.Flags().String("body", "", "New body (@file to read a file, - for stdin)")
+	cmd.Flags().Bool("markdown", false, "Convert the body from Markdown to HTML (default for @file.md)")
+	cmd.Flags().String("locale", "", "Locale of the translation to update (default: the article's source locale)")
+	cmd.Flags().Bool("draft", false, "Unpublish the translation")
+	cmd.Flags().Bool("publish", false, "Publish the translation")
+	cmd.Flags().Int64("section", 0, "Move the article to this section")
+	cmd.Flags().Int64("permission-group", 0, "New permission group")
+	cmd.Flags().StringSlice("labels", nil, "Replace the labels (comma-separated)")
+	cmd.Flags().Bool("promoted", false, "Promote the article (--promoted=false to stop)")
+	cmd.MarkFlagsMutuallyExclusive("draft", "publish")
+
+	return cmd
+}
+
+// addArticleFilterFlags adds the section, category, and locale filters of
+// article lists and searches
+func addArticleFilterFlags(cmd *cobra.Command) {
+	cmd.Flags().Int64("section", 0, "Only articles in this section")
+	cmd.Flags().Int64("category", 0, "Only articles in this category")
+	cmd.Flags().String("locale", "", "Locale to list (default: the Help Center's default locale)")
+	cmd.MarkFlagsMutuallyExclusive("section", "category")
+}
+
+// articleListOptionsFromFlags reads the filter and paging flags
+func articleListOptionsFromFlags(cmd *cobra.Command) client.ArticleListOptions {
+	opts := client.ArticleListOptions{}
+	opts.SectionID, _ = cmd.Flags().GetInt64("section")
+	opts.CategoryID, _ = cmd.Flags().GetInt64("category")
+	opts.Locale, _ = cmd.Flags().GetString("locale")
+	opts.Page, _ = cmd.Flags().GetInt("page")
+	opts.PerPage, _ = cmd.Flags().GetInt("per-page")
+	if opts.PerPage > 100 {
+		opts.PerPage = 100
+	}
+	return opts
+}
+
+func runHCArticleList(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	opts := articleListOptionsFromFlags(cmd)
+
+	ctx := context.Background()
+
+	resp, err := zdClient.ListArticles(ctx, opts)
+	if err != nil {
+		return fmt.Errorf("failed to list articles: %w", err)
+	}
+
+	return outputArticles(cmd, resp, opts.Page)
+}
+
+func runHCArticleSearch(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	opts := articleListOptionsFromFlags(cmd)
+
+	ctx := context.Background()
+
+	resp, err := zdClient.SearchArticles(ctx, args[0], opts)
+	if err != nil {
+		return fmt.Errorf("failed to search articles: %w", err)
+	}
+
+	return outputArticles(cmd, resp, opts.Page)
+}
+
+func runHCArticleShow(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	articleID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid article ID: %s", args[0])
+	}
+
+	locale, _ := cmd.Flags().GetString("locale")
+
+	ctx := context.Background()
+
+	article, err := zdClient.GetArticle(ctx, articleID, locale)
+	if err != nil {
+		return fmt.Errorf("failed to get article: %w", err)
+	}
+
+	format, _ := cmd.Flags().GetString("output")
+	switch output.Format(format) {
+	case output.FormatJSON:
+		return output.NewWriter(output.FormatJSON).WriteJSON(article)
+	case output.FormatMarkdown:
+		return writeArticleMarkdown(os.Stdout, article, nil)
+	default:
+		displayArticle(article)
+		return nil
+	}
+}
+
+func runHCArticleCreate(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	sectionID, _ := cmd.Flags().GetInt64("section")
+	title, _ := cmd.Flags().GetString("title")
+	locale, _ := cmd.Flags().GetString("locale")
+	labels, _ := cmd.Flags().GetStringSlice("labels")
+	draft, _ := cmd.Flags().GetBool("draft")
+	promoted, _ := cmd.Flags().GetBool("promoted")
+
+	body, err := articleBodyFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+	if body == "" {
+		return fmt.Errorf("--body is required")
+	}
+
+	ctx := context.Background()
+
+	permissionGroupID, _ := cmd.Flags().GetInt64("permission-group")
+	if permissionGroupID == 0 {
+		permissionGroupID, err = defaultPermissionGroup(ctx, zdClient)
+		if err != nil {
+			return err
+		}
+	}
+
+	req := client.CreateArticleRequest{
+		Title:             title,
+		Body:              body,
+		Locale:            locale,
+		PermissionGroupID: permissionGroupID,
+		Draft:             draft,
+		Promoted:          promoted,
+		LabelNames:        labels,
+	}
+	if segmentID, _ := cmd.Flags().GetInt64("user-segment"); segmentID != 0 {
+		req.UserSegmentID = &segmentID
+	}
+
+	article, err := zdClient.CreateArticle(ctx, sectionID, req)
+	if err != nil {
+		return fmt.Errorf("failed to create article: %w", err)
+	}
+
+	color.Green("✓ Article created successfully!\n")
+	color.White("Article ID: %d\n", article.ID)
+	color.White("URL: %s\n", article.HTMLURL)
+
+	return nil
+}
+
+func runHCArticleUpdate(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	articleID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid article ID: %s", args[0])
+	}
+
+	// Settings shared by every locale
+	var settings client.UpdateArticleRequest
+	settingsChanged := false
+	if cmd.Flags().Changed("section") {
+		sectionID, _ := cmd.Flags().GetInt64("section")
+		settings.SectionID = &sectionID
+		settingsChanged = true
+	}
+	if cmd.Flags().Changed("permission-group") {
+		groupID, _ := cmd.Flags().GetInt64("permission-group")
+		settings.PermissionGroupID = &groupID
+		settingsChanged = true
+	}
+	if cmd.Flags().Changed("labels") {
+		labels, _ := cmd.Flags().GetStringSlice("labels")
+		settings.LabelNames = &labels
+		settingsChanged = true
+	}
+	if cmd.Flags().Changed("promoted") {
+		promoted, _ := cmd.Flags().GetBool("promoted")
+		settings.Promoted = &promoted
+		settingsChanged = true
+	}
+
+	// The translation in one locale
+	var translation client.UpdateTranslationRequest
+	translation.Title, _ = cmd.Flags().GetString("title")
+	translation.Body, err = articleBodyFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+	if cmd.Flags().Changed("draft") || cmd.Flags().Changed("publish") {
+		draft, _ := cmd.Flags().GetBool("draft")
+		if cmd.Flags().Changed("publish") {
+			publish, _ := cmd.Flags().GetBool("publish")
+			draft = !publish
+		}
+		translation.Draft = &draft
+	}
+	translationChanged := translation.Title != "" || translation.Body != "" || translation.Draft != nil
+
+	if !settingsChanged && !translationChanged {
+		return fmt.Errorf("nothing to update: give at least one of --title, --body, --draft, --publish, --section, --permission-group, --labels, or --promoted")
+	}
+
+	ctx := context.Background()
+
+	if settingsChanged {
+		if _, err := zdClient.UpdateArticle(ctx, articleID, settings); err != nil {
+			return fmt.Errorf("failed to update article: %w", err)
+		}
+	}
+
+	if translationChanged {
+		locale, _ := cmd.Flags().GetString("locale")
+		if locale == "" {
+			article, err := zdClient.GetArticle(ctx, articleID, "")
+			if err != nil {
+				return fmt.Errorf("failed to get article: %w", err)
+			}
+			locale = article.SourceLocale
+			if locale == "" {
+				locale = article.Locale
+			}
+		}
+
+		if err := zdClient.UpdateArticleTranslation(ctx, articleID, locale, translation); err != nil {
+			return fmt.Errorf("failed to update article translation: %w", err)
+		}
+	}
+
+	color.Green("✓ Article %d updated successfully!\n", articleID)
+
+	return nil
+}
+
+// articleBodyFromFlags reads --body, converting it from Markdown to HTML
+// with --markdown or when it is read from a .md file
+func articleBodyFromFlags(cmd *cobra.Command) (string, error) {
+	body, err := messageFromFlag(cmd, "body")
+	if err != nil || body == "" {
+		return body, err
+	}
+
+	convert, _ := cmd.Flags().GetBool("markdown")
+	if !cmd.Flags().Changed("markdown") {
+		value, _ := cmd.Flags().GetString("body")
+		lower := strings.ToLower(value)
+		convert = strings.HasPrefix(value, "@") && (strings.HasSuffix(lower, ".md") || strings.HasSuffix(lower, ".markdown"))
+	}
+	if convert {
+		body = markdown.ToHTML(body)
+	}
+
+	return body, nil
+}
+
+// defaultPermissionGroup returns the instance's permission group when there
+// is only one
+func defaultPermissionGroup(ctx context.Context, zdClient *client.Client) (int64, error) {
+	groups, err := zdClient.ListPermissionGroups(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list permission groups: %w", err)
+	}
+
+	if len(groups) == 1 {
+		return groups[0].ID, nil
+	}
+
+	var choices []string
+	for _, group := range groups {
+		choices = append(choices, fmt.Sprintf("%d (%s)", group.ID, group.Name))
+	}
+	return 0, fmt.Errorf("--permission-group is required: choose one of %s", strings.Join(choices, ", "))
+}
+
+// outputArticles outputs a page of articles in the requested format
+func outputArticles(cmd *cobra.Command, resp *client.ArticlesResponse, page int) error {
+	format, _ := cmd.Flags().GetString("output")
+	writer := output.NewWriter(output.Format(format))
+
+	switch output.Format(format) {
+	case output.FormatJSON:
+		articles := resp.Articles
+		if articles == nil {
+			articles = []client.Article{}
+		}
+		return writer.WriteJSON(articles)
+
+	case output.FormatCSV:
+		return writer.WriteCSV(resp.Articles, articleListHeaders)
+
+	default:
+		if len(resp.Articles) == 0 {
+			color.Yellow("No articles found.\n")
+			return nil
+		}
+
+		color.Cyan("Articles (Page %d, showing %d of %d total)\n", page, len(resp.Articles), resp.Count)
+		color.White(strings.Repeat("─", 80) + "\n")
+
+		table := output.NewTable("#", "ID", "TITLE", "SECTION", "STATE", "UPDATED")
+		table.SetFlexColumn(2)
+		for i, article := range resp.Articles {
+			table.AddRow(
+				fmt.Sprintf("%d", i+1),
+				fmt.Sprintf("%d", article.ID),
+				article.Title,
+				fmt.Sprintf("%d", article.SectionID),
+				articleState(&article),
+				formatDate(article.UpdatedAt))
+		}
+		table.Print()
+
+		if resp.NextPage != "" {
+			fmt.Println()
+			color.White("More results available. Use --page %d to see next page.\n", page+1)
+		}
+
+		return nil
+	}
+}
+
+// articleState describes whether an article is published, a draft, or promoted
+func articleState(article *client.Article) string {
+	state := color.GreenString("published")
+	if article.Draft {
+		state = color.YellowString("draft")
+	}
+	if article.Promoted {
+		state += ", promoted"
+	}
+	return state
+}
+
+// displayArticle prints an article's details and its body as text
+func displayArticle(article *client.Article) {
+	color.Cyan("Article: %s\n", article.Title)
+	color.White(strings.Repeat("─", 80) + "\n")
+
+	color.White("ID:          %d\n", article.ID)
+	fmt.Printf("State:       %s\n", articleState(article))
+	color.White("Locale:      %s\n", article.Locale)
+	color.White("Section ID:  %d\n", article.SectionID)
+	color.White("Author ID:   %d\n", article.AuthorID)
+	if len(article.LabelNames) > 0 {
+		color.White("Labels:      %s\n", strings.Join(article.LabelNames, ", "))
+	}
+	color.White("Votes:       %d\n", article.VoteSum)
+	color.White("Created:     %s\n", formatDate(article.CreatedAt))
+	color.White("Updated:     %s\n", formatDate(article.UpdatedAt))
+	color.White("URL:         %s\n", article.HTMLURL)
+
+	fmt.Println()
+	fmt.Println(output.RenderHTML(article.Body))
+}
+
+// writeArticleMarkdown writes an article as Markdown: YAML front matter with
+// the article's metadata, plus any extra fields, then its body
+func writeArticleMarkdown(w io.Writer, article *client.Article, extra map[string]string) error {
+	var b strings.Builder
+
+	b.WriteString("---\n")
+	fmt.Fprintf(&b, "id: %d\n", article.ID)
+	fmt.Fprintf(&b, "title: %s\n", strconv.Quote(article.Title))
+	fmt.Fprintf(&b, "locale: %s\n", article.Locale)
+	fmt.Fprintf(&b, "section_id: %d\n", article.SectionID)
+	for _, key := range []string{"section", "category"} {
+		if value, ok := extra[key]; ok {
+			fmt.Fprintf(&b, "%s: %s\n", key, strconv.Quote(value))
+		}
+	}
+	fmt.Fprintf(&b, "author_id: %d\n", article.AuthorID)
+	fmt.Fprintf(&b, "draft: %t\n", article.Draft)
+	fmt.Fprintf(&b, "promoted: %t\n", article.Promoted)
+	if len(article.LabelNames) > 0 {
+		quoted := make([]string, len(article.LabelNames))
+		for i, label := range article.LabelNames {
+			quoted[i] = strconv.Quote(label)
+		}
+		fmt.Fprintf(&b, "labels: [%s]\n", strings.Join(quoted, ", "))
+	}
+	fmt.Fprintf(&b, "permission_group_id: %d\n", article.PermissionGroupID)
+	if article.UserSegmentID != nil {
+		fmt.Fprintf(&b, "user_segment_id: %d\n", *article.UserSegmentID)
+	}
+	fmt.Fprintf(&b, "created_at: %s\n", article.CreatedAt)
+	fmt.Fprintf(&b, "updated_at: %s\n", article.UpdatedAt)
+	if article.HTMLURL != "" {
+		fmt.Fprintf(&b, "url: %s\n", article.HTMLURL)
+	}
+	b.WriteString("---\n\n")
+
+	b.WriteString(markdown.FromHTML(article.Body))
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
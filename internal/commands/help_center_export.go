@@ -0,0 +1,360 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+
+	"zd-cli/internal/client"
+	"zd-cli/internal/progress"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// hcExportKind marks a manifest as written by hc export, so it is never
+// mistaken for the manifest of an instance backup
+const hcExportKind = "help_center"
+
+// hcUnsortedDir holds articles whose section isn't visible to the exporter
+const hcUnsortedDir = "_unsorted"
+
+// hcExportManifest records which file each article was written to, so a
+// later export into the same directory can remove the files of articles
+// that were deleted, moved, or renamed since. It has no timestamp, so
+// re-exporting unchanged articles leaves nothing to commit.
+type hcExportManifest struct {
+	Kind      string           `json:"kind"`
+	Subdomain string           `json:"subdomain"`
+	Locale    string           `json:"locale,omitempty"`
+	Articles  map[int64]string `json:"articles"`
+}
+
+func newHCExportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export every article to a Markdown tree",
+		Long: `Export every Help Center article to a directory as Markdown, one folder per
+category and section (nested sections become nested folders) and one file
+per article, named <article-id>-<title>.md. Each file starts with YAML front
+matter holding the article's metadata.
+
+The export is meant to be committed to git so documentation changes can be
+reviewed as diffs. A manifest.json records where each article was written;
+re-running the export into the same directory rewrites every article and
+removes the files of articles that were deleted, moved, or renamed since.
+Examples:
+  zd hc export --out ./kb
+  zd hc export --out ./kb-de --locale de`,
+		Args: cobra.NoArgs,
+		RunE: withClient(runHCExport),
+	}
+
+	cmd.Flags().String("out", "", "Directory to write the export to")
+	cmd.Flags().String("locale", "", "Locale to export (default: the Help Center's default locale)")
+	cmd.Flags().Bool("skip-drafts", false, "Don't export draft articles")
+	cmd.MarkFlagRequired("out")
+
+	return cmd
+}
+
+func runHCExport(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	outDir, _ := cmd.Flags().GetString("out")
+	locale, _ := cmd.Flags().GetString("locale")
+	skipDrafts, _ := cmd.Flags().GetBool("skip-drafts")
+
+	if err := os.MkdirAll(outDir, 0755); err != nil {
+		return fmt.Errorf("failed to create %s: %w", outDir, err)
+	}
+
+	manifestPath := filepath.Join(outDir, exportManifestFile)
+	previous, err := loadHCExportManifest(manifestPath)
+	if err != nil {
+		return err
+	}
+	if previous != nil && previous.Subdomain != zdClient.Subdomain() {
+		return fmt.Errorf("%s holds an export of %s, not %s", outDir, previous.Subdomain, zdClient.Subdomain())
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	fmt.Fprint(os.Stderr, "Fetching categories and sections...")
+	categories, err := zdClient.ListAllCategories(ctx, locale)
+	if err != nil {
+		fmt.Fprintln(os.Stderr)
+		return fmt.Errorf("failed to list categories: %w", err)
+	}
+	sections, err := zdClient.ListAllSections(ctx, locale)
+	if err != nil {
+		fmt.Fprintln(os.Stderr)
+		return fmt.Errorf("failed to list sections: %w", err)
+	}
+	fmt.Fprint(os.Stderr, "\r\033[K")
+
+	tree := newHCTree(categories, sections)
+
+	manifest := &hcExportManifest{
+		Kind:      hcExportKind,
+		Subdomain: zdClient.Subdomain(),
+		Locale:    locale,
+		Articles:  make(map[int64]string),
+	}
+
+	bar := progress.NewBar("Exporting articles", 0)
+	zdClient.OnListTotal(bar.SetTotal)
+
+	exported := 0
+	err = zdClient.ListAllArticles(ctx, locale, func(articles []client.Article) error {
+		for i := range articles {
+			article := &articles[i]
+			if skipDrafts && article.Draft {
+				continue
+			}
+
+			rel := filepath.Join(tree.sectionDir(article.SectionID), fmt.Sprintf("%d-%s.md", article.ID, slugify(article.Title)))
+			if err := writeHCArticleFile(filepath.Join(outDir, rel), article, tree.context(article.SectionID)); err != nil {
+				return err
+			}
+			manifest.Articles[article.ID] = filepath.ToSlash(rel)
+			exported++
+		}
+
+		bar.Add(len(articles))
+		return ctx.Err()
+	})
+	bar.Finish()
+	if err != nil {
+		if errors.Is(ctx.Err(), context.Canceled) {
+			return fmt.Errorf("export interrupted")
+		}
+		return fmt.Errorf("failed to export articles: %w", err)
+	}
+
+	// Only remove files once every article is written, so an interrupted
+	// export never loses one
+	removed := 0
+	if previous != nil {
+		for id, rel := range previous.Articles {
+			if manifest.Articles[id] == rel {
+				continue
+			}
+			path := filepath.Join(outDir, filepath.FromSlash(rel))
+			if err := os.Remove(path); err == nil {
+				removed++
+				removeEmptyDirs(filepath.Dir(path), outDir)
+			}
+		}
+	}
+
+	if err := saveHCExportManifest(manifestPath, manifest); err != nil {
+		return err
+	}
+
+	color.New(color.FgGreen).Fprintf(os.Stderr, "✓ Exported %d article(s) to %s\n", exported, outDir)
+	if removed > 0 {
+		color.New(color.FgYellow).Fprintf(os.Stderr, "Removed %d file(s) of articles deleted, moved, or renamed since the last export\n", removed)
+	}
+
+	return nil
+}
+
+// hcTree maps sections to their folders in an export
+type hcTree struct {
+	categories map[int64]*client.Category
+	sections   map[int64]*client.Section
+	dirs       map[int64]string // Section ID to folder, relative to the export
+}
+
+// newHCTree lays out one folder per category and section. Folders are named
+// after the category or section, with its ID appended when two siblings
+// would otherwise share a folder.
+func newHCTree(categories []client.Category, sections []client.Section) *hcTree {
+	tree := &hcTree{
+		categories: make(map[int64]*client.Category),
+		sections:   make(map[int64]*client.Section),
+		dirs:       make(map[int64]string),
+	}
+
+	sort.Slice(categories, func(i, j int) bool {
+		if categories[i].Position != categories[j].Position {
+			return categories[i].Position < categories[j].Position
+		}
+		return categories[i].ID < categories[j].ID
+	})
+	sort.Slice(sections, func(i, j int) bool {
+		if sections[i].Position != sections[j].Position {
+			return sections[i].Position < sections[j].Position
+		}
+		return sections[i].ID < sections[j].ID
+	})
+
+	taken := make(map[string]bool)
+	folder := func(parent, name string, id int64) string {
+		dir := filepath.Join(parent, slugify(name))
+		if taken[dir] {
+			dir = fmt.Sprintf("%s-%d", dir, id)
+		}
+		taken[dir] = true
+		return dir
+	}
+
+	categoryDirs := make(map[int64]string)
+	for i := range categories {
+		category := &categories[i]
+		tree.categories[category.ID] = category
+		categoryDirs[category.ID] = folder("", category.Name, category.ID)
+	}
+	for i := range sections {
+		tree.sections[sections[i].ID] = &sections[i]
+	}
+
+	// Parents are laid out before their children
+	var place func(section *client.Section, depth int) string
+	place = func(section *client.Section, depth int) string {
+		if dir, ok := tree.dirs[section.ID]; ok {
+			return dir
+		}
+
+		parent, ok := categoryDirs[section.CategoryID]
+		if !ok {
+			parent = hcUnsortedDir
+		}
+		if section.ParentSectionID != nil && depth < len(sections) {
+			if parentSection, ok := tree.sections[*section.ParentSectionID]; ok {
+				parent = place(parentSection, depth+1)
+			}
+		}
+
+		dir := folder(parent, section.Name, section.ID)
+		tree.dirs[section.ID] = dir
+		return dir
+	}
+	for i := range sections {
+		place(&sections[i], 0)
+	}
+
+	return tree
+}
+
+// sectionDir returns the folder of a section's articles
+func (t *hcTree) sectionDir(sectionID int64) string {
+	if dir, ok := t.dirs[sectionID]; ok {
+		return dir
+	}
+	return hcUnsortedDir
+}
+
+// context returns the section and category names written to the front
+// matter of an article in sectionID
+func (t *hcTree) context(sectionID int64) map[string]string {
+	extra := make(map[string]string)
+	section, ok := t.sections[sectionID]
+	if !ok {
+		return extra
+	}
+	extra["section"] = section.Name
+	if category, ok := t.categories[section.CategoryID]; ok {
+		extra["category"] = category.Name
+	}
+	return extra
+}
+
+// writeHCArticleFile writes an article as Markdown to path, creating its
+// folder if needed
+func writeHCArticleFile(path string, article *client.Article, extra map[string]string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create %s: %w", filepath.Dir(path), err)
+	}
+
+	var buf bytes.Buffer
+	if err := writeArticleMarkdown(&buf, article, extra); err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(path, buf.Bytes(), 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
+
+// removeEmptyDirs removes dir and then each of its parents up to, but not
+// including, root, stopping at the first one that isn't empty
+func removeEmptyDirs(dir, root string) {
+	root = filepath.Clean(root)
+	for dir = filepath.Clean(dir); dir != root && strings.HasPrefix(dir, root); dir = filepath.Dir(dir) {
+		if os.Remove(dir) != nil {
+			return
+		}
+	}
+}
+
+// slugify turns a title into a lowercase file name of letters, digits, and
+// dashes
+func slugify(title string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(title) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if dash && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(r)
+			dash = false
+		} else {
+			dash = true
+		}
+		if b.Len() >= 60 {
+			break
+		}
+	}
+
+	if b.Len() == 0 {
+		return "untitled"
+	}
+	return b.String()
+}
+
+// loadHCExportManifest reads a Help Center export manifest, returning nil if
+// it doesn't exist
+func loadHCExportManifest(path string) (*hcExportManifest, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest: %w", err)
+	}
+
+	var manifest hcExportManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("invalid manifest %s: %w", path, err)
+	}
+	if manifest.Kind != hcExportKind {
+		return nil, fmt.Errorf("%s holds a different kind of export; choose another directory", filepath.Dir(path))
+	}
+
+	return &manifest, nil
+}
+
+// saveHCExportManifest writes a Help Center export manifest
+func saveHCExportManifest(path string, manifest *hcExportManifest) error {
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode manifest: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to save manifest: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,436 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"zd-cli/internal/client"
+	"zd-cli/internal/output"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// categoryListHeaders are the CSV columns for category lists
+var categoryListHeaders = []string{"id", "name", "description", "locale", "position", "updated_at", "html_url"}
+
+// sectionListHeaders are the CSV columns for section lists
+var sectionListHeaders = []string{"id", "name", "category_id", "parent_section_id", "locale", "position", "updated_at", "html_url"}
+
+func newHCCategoryCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "category",
+		Aliases: []string{"categories"},
+		Short:   "Manage Help Center categories",
+		Long:    "List, show, and create Help Center categories, the top level of the knowledge base.",
+	}
+
+	cmd.AddCommand(newHCCategoryListCommand())
+	cmd.AddCommand(newHCCategoryShowCommand())
+	cmd.AddCommand(newHCCategoryCreateCommand())
+
+	return cmd
+}
+
+func newHCSectionCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "section",
+		Aliases: []string{"sections"},
+		Short:   "Manage Help Center sections",
+		Long:    "List, show, and create Help Center sections, which hold articles within a category.",
+	}
+
+	cmd.AddCommand(newHCSectionListCommand())
+	cmd.AddCommand(newHCSectionShowCommand())
+	cmd.AddCommand(newHCSectionCreateCommand())
+
+	return cmd
+}
+
+func newHCCategoryListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List categories",
+		Args:  cobra.NoArgs,
+		RunE:  withClient(runHCCategoryList),
+	}
+
+	cmd.Flags().String("locale", "", "Locale to list (default: the Help Center's default locale)")
+	cmd.Flags().Int("page", 1, "Page number")
+	cmd.Flags().Int("per-page", 100, "Results per page (max 100)")
+	cmd.Flags().Bool("refresh", false, "Bypass cache and fetch fresh data")
+	cmd.Flags().StringP("output", "o", "table", "Output format: table, json, csv")
+
+	return cmd
+}
+
+func newHCCategoryShowCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show <category-id>",
+		Short: "Show a category and its sections",
+		Args:  cobra.ExactArgs(1),
+		RunE:  withClient(runHCCategoryShow),
+	}
+
+	cmd.Flags().String("locale", "", "Show this translation (default: the Help Center's default locale)")
+	cmd.Flags().Bool("refresh", false, "Bypass cache and fetch fresh data")
+	cmd.Flags().StringP("output", "o", "table", "Output format: table, json")
+
+	return cmd
+}
+
+func newHCCategoryCreateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a category",
+		Long: `Create a category. Example:
+  zd hc category create --name "Billing" --description "Invoices, payments, and plans"`,
+		Args: cobra.NoArgs,
+		RunE: withClient(runHCCategoryCreate),
+	}
+
+	cmd.Flags().String("name", "", "Category name")
+	cmd.Flags().String("description", "", "Category description")
+	cmd.Flags().String("locale", defaultArticleLocale, "Locale to write the category in")
+	cmd.Flags().Int("position", 0, "Position among the categories (default: last)")
+	cmd.MarkFlagRequired("name")
+
+	return cmd
+}
+
+func newHCSectionListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List sections",
+		Args:  cobra.NoArgs,
+		RunE:  withClient(runHCSectionList),
+	}
+
+	cmd.Flags().Int64("category", 0, "Only sections in this category")
+	cmd.Flags().String("locale", "", "Locale to list (default: the Help Center's default locale)")
+	cmd.Flags().Int("page", 1, "Page number")
+	cmd.Flags().Int("per-page", 100, "Results per page (max 100)")
+	cmd.Flags().Bool("refresh", false, "Bypass cache and fetch fresh data")
+	cmd.Flags().StringP("output", "o", "table", "Output format: table, json, csv")
+
+	return cmd
+}
+
+func newHCSectionShowCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show <section-id>",
+		Short: "Show a section",
+		Args:  cobra.ExactArgs(1),
+		RunE:  withClient(runHCSectionShow),
+	}
+
+	cmd.Flags().String("locale", "", "Show this translation (default: the Help Center's default locale)")
+	cmd.Flags().Bool("refresh", false, "Bypass cache and fetch fresh data")
+	cmd.Flags().StringP("output", "o", "table", "Output format: table, json")
+
+	return cmd
+}
+
+func newHCSectionCreateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a section",
+		Long: `Create a section in a category, or inside another section with --parent.
+Examples:
+  zd hc section create --category 360000123456 --name "Invoices"
+  zd hc section create --category 360000123456 --parent 360001234567 --name "Refunds"`,
+		Args: cobra.NoArgs,
+		RunE: withClient(runHCSectionCreate),
+	}
+
+	cmd.Flags().Int64("category", 0, "Category to create the section in")
+	cmd.Flags().Int64("parent", 0, "Parent section, to nest the section")
+	cmd.Flags().String("name", "", "Section name")
+	cmd.Flags().String("description", "", "Section description")
+	cmd.Flags().String("locale", defaultArticleLocale, "Locale to write the section in")
+	cmd.Flags().Int("position", 0, "Position among the sections (default: last)")
+	cmd.MarkFlagRequired("category")
+	cmd.MarkFlagRequired("name")
+
+	return cmd
+}
+
+func runHCCategoryList(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	locale, _ := cmd.Flags().GetString("locale")
+	page, _ := cmd.Flags().GetInt("page")
+	perPage, _ := cmd.Flags().GetInt("per-page")
+	if perPage > 100 {
+		perPage = 100
+	}
+
+	ctx := context.Background()
+
+	resp, err := zdClient.ListCategories(ctx, locale, page, perPage)
+	if err != nil {
+		return fmt.Errorf("failed to list categories: %w", err)
+	}
+
+	format, _ := cmd.Flags().GetString("output")
+	writer := output.NewWriter(output.Format(format))
+
+	switch output.Format(format) {
+	case output.FormatJSON:
+		categories := resp.Categories
+		if categories == nil {
+			categories = []client.Category{}
+		}
+		return writer.WriteJSON(categories)
+
+	case output.FormatCSV:
+		return writer.WriteCSV(resp.Categories, categoryListHeaders)
+
+	default:
+		if len(resp.Categories) == 0 {
+			color.Yellow("No categories found.\n")
+			return nil
+		}
+
+		color.Cyan("Categories (Page %d, showing %d of %d total)\n", page, len(resp.Categories), resp.Count)
+		color.White(strings.Repeat("─", 80) + "\n")
+
+		table := output.NewTable("#", "ID", "NAME", "POSITION", "UPDATED")
+		table.SetFlexColumn(2)
+		for i, category := range resp.Categories {
+			table.AddRow(
+				fmt.Sprintf("%d", i+1),
+				fmt.Sprintf("%d", category.ID),
+				category.Name,
+				fmt.Sprintf("%d", category.Position),
+				formatDate(category.UpdatedAt))
+		}
+		table.Print()
+
+		if resp.NextPage != "" {
+			fmt.Println()
+			color.White("More results available. Use --page %d to see next page.\n", page+1)
+		}
+
+		return nil
+	}
+}
+
+func runHCCategoryShow(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	categoryID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid category ID: %s", args[0])
+	}
+
+	locale, _ := cmd.Flags().GetString("locale")
+
+	ctx := context.Background()
+
+	category, err := zdClient.GetCategory(ctx, categoryID, locale)
+	if err != nil {
+		return fmt.Errorf("failed to get category: %w", err)
+	}
+
+	format, _ := cmd.Flags().GetString("output")
+	if output.Format(format) == output.FormatJSON {
+		return output.NewWriter(output.FormatJSON).WriteJSON(category)
+	}
+
+	sections, err := zdClient.ListSections(ctx, categoryID, locale, 1, 100)
+	if err != nil {
+		return fmt.Errorf("failed to list sections: %w", err)
+	}
+
+	color.Cyan("Category: %s\n", category.Name)
+	color.White(strings.Repeat("─", 80) + "\n")
+
+	color.White("ID:           %d\n", category.ID)
+	if category.Description != "" {
+		color.White("Description:  %s\n", category.Description)
+	}
+	color.White("Locale:       %s\n", category.Locale)
+	color.White("Position:     %d\n", category.Position)
+	color.White("Created:      %s\n", formatDate(category.CreatedAt))
+	color.White("Updated:      %s\n", formatDate(category.UpdatedAt))
+	color.White("URL:          %s\n", category.HTMLURL)
+
+	fmt.Println()
+	if len(sections.Sections) == 0 {
+		color.Yellow("No sections in this category.\n")
+		return nil
+	}
+
+	color.Cyan("Sections (%d)\n", sections.Count)
+	printSectionTable(sections.Sections)
+	if sections.NextPage != "" {
+		color.White("More sections available. Use 'zd hc section list --category %d --page 2' to see them.\n", categoryID)
+	}
+
+	return nil
+}
+
+func runHCCategoryCreate(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	req := client.CreateCategoryRequest{}
+	req.Name, _ = cmd.Flags().GetString("name")
+	req.Description, _ = cmd.Flags().GetString("description")
+	req.Locale, _ = cmd.Flags().GetString("locale")
+	if cmd.Flags().Changed("position") {
+		position, _ := cmd.Flags().GetInt("position")
+		req.Position = &position
+	}
+
+	ctx := context.Background()
+
+	category, err := zdClient.CreateCategory(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed to create category: %w", err)
+	}
+
+	color.Green("✓ Category created successfully!\n")
+	color.White("Category ID: %d\n", category.ID)
+	color.White("URL: %s\n", category.HTMLURL)
+
+	return nil
+}
+
+func runHCSectionList(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	categoryID, _ := cmd.Flags().GetInt64("category")
+	locale, _ := cmd.Flags().GetString("locale")
+	page, _ := cmd.Flags().GetInt("page")
+	perPage, _ := cmd.Flags().GetInt("per-page")
+	if perPage > 100 {
+		perPage = 100
+	}
+
+	ctx := context.Background()
+
+	resp, err := zdClient.ListSections(ctx, categoryID, locale, page, perPage)
+	if err != nil {
+		return fmt.Errorf("failed to list sections: %w", err)
+	}
+
+	format, _ := cmd.Flags().GetString("output")
+	writer := output.NewWriter(output.Format(format))
+
+	switch output.Format(format) {
+	case output.FormatJSON:
+		sections := resp.Sections
+		if sections == nil {
+			sections = []client.Section{}
+		}
+		return writer.WriteJSON(sections)
+
+	case output.FormatCSV:
+		return writer.WriteCSV(resp.Sections, sectionListHeaders)
+
+	default:
+		if len(resp.Sections) == 0 {
+			color.Yellow("No sections found.\n")
+			return nil
+		}
+
+		color.Cyan("Sections (Page %d, showing %d of %d total)\n", page, len(resp.Sections), resp.Count)
+		printSectionTable(resp.Sections)
+
+		if resp.NextPage != "" {
+			fmt.Println()
+			color.White("More results available. Use --page %d to see next page.\n", page+1)
+		}
+
+		return nil
+	}
+}
+
+func runHCSectionShow(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	sectionID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid section ID: %s", args[0])
+	}
+
+	locale, _ := cmd.Flags().GetString("locale")
+
+	ctx := context.Background()
+
+	section, err := zdClient.GetSection(ctx, sectionID, locale)
+	if err != nil {
+		return fmt.Errorf("failed to get section: %w", err)
+	}
+
+	format, _ := cmd.Flags().GetString("output")
+	if output.Format(format) == output.FormatJSON {
+		return output.NewWriter(output.FormatJSON).WriteJSON(section)
+	}
+
+	color.Cyan("Section: %s\n", section.Name)
+	color.White(strings.Repeat("─", 80) + "\n")
+
+	color.White("ID:             %d\n", section.ID)
+	if section.Description != "" {
+		color.White("Description:    %s\n", section.Description)
+	}
+	color.White("Category ID:    %d\n", section.CategoryID)
+	if section.ParentSectionID != nil {
+		color.White("Parent Section: %d\n", *section.ParentSectionID)
+	}
+	color.White("Locale:         %s\n", section.Locale)
+	color.White("Position:       %d\n", section.Position)
+	if section.Sorting != "" {
+		color.White("Sorting:        %s\n", section.Sorting)
+	}
+	color.White("Created:        %s\n", formatDate(section.CreatedAt))
+	color.White("Updated:        %s\n", formatDate(section.UpdatedAt))
+	color.White("URL:            %s\n", section.HTMLURL)
+
+	return nil
+}
+
+func runHCSectionCreate(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	categoryID, _ := cmd.Flags().GetInt64("category")
+
+	req := client.CreateSectionRequest{}
+	req.Name, _ = cmd.Flags().GetString("name")
+	req.Description, _ = cmd.Flags().GetString("description")
+	req.Locale, _ = cmd.Flags().GetString("locale")
+	if parentID, _ := cmd.Flags().GetInt64("parent"); parentID != 0 {
+		req.ParentSectionID = &parentID
+	}
+	if cmd.Flags().Changed("position") {
+		position, _ := cmd.Flags().GetInt("position")
+		req.Position = &position
+	}
+
+	ctx := context.Background()
+
+	section, err := zdClient.CreateSection(ctx, categoryID, req)
+	if err != nil {
+		return fmt.Errorf("failed to create section: %w", err)
+	}
+
+	color.Green("✓ Section created successfully!\n")
+	color.White("Section ID: %d\n", section.ID)
+	color.White("URL: %s\n", section.HTMLURL)
+
+	return nil
+}
+
+// printSectionTable prints sections as a table
+func printSectionTable(sections []client.Section) {
+	color.White(strings.Repeat("─", 80) + "\n")
+
+	table := output.NewTable("#", "ID", "NAME", "CATEGORY", "PARENT", "UPDATED")
+	table.SetFlexColumn(2)
+	for i, section := range sections {
+		parent := "-"
+		if section.ParentSectionID != nil {
+			parent = fmt.Sprintf("%d", *section.ParentSectionID)
+		}
+		table.AddRow(
+			fmt.Sprintf("%d", i+1),
+			fmt.Sprintf("%d", section.ID),
+			section.Name,
+			fmt.Sprintf("%d", section.CategoryID),
+			parent,
+			formatDate(section.UpdatedAt))
+	}
+	table.Print()
+}
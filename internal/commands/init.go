@@ -22,13 +22,27 @@ func NewInitCommand() *cobra.Command {
 		RunE:  runInit,
 	}
 
+	addInstanceSetupFlags(cmd)
+	addInsecureStoreFlag(cmd)
+	addOAuthFlags(cmd)
+
 	return cmd
 }
 
 func runInit(cmd *cobra.Command, args []string) error {
+	setup, err := instanceSetupFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
 	// Check if config already exists
-	cfg, err := config.Load()
+	cfg, err := loadConfig(cmd)
 	if err == nil && len(cfg.Instances) > 0 {
+		// Don't ask when everything was given as flags or nobody can answer
+		if setup.complete() || !interactive() {
+			return addInstance(cmd, cfg, setup)
+		}
+
 		color.Yellow("Configuration already exists.")
 		prompt := promptui.Prompt{
 			Label:     "Do you want to add another instance",
@@ -48,18 +62,20 @@ func runInit(cmd *cobra.Command, args []string) error {
 	color.White("Let's set up your first Zendesk instance.\n")
 
 	// Prompt for instance details
-	instance, err := promptForInstance("")
+	instance, err := promptForInstance(setup, oauthOptionsFromFlags(cmd))
 	if err != nil {
 		return err
 	}
 
+	instance.SecretStore = secretStoreFromFlags(cmd)
+
 	// Add instance to config
 	if err := cfg.AddInstance(instance); err != nil {
 		return fmt.Errorf("failed to add instance: %w", err)
 	}
 
 	// Save config
-	if err := config.Save(cfg); err != nil {
+	if err := saveConfig(cmd, cfg); err != nil {
 		return fmt.Errorf("failed to save configuration: %w", err)
 	}
 
@@ -70,28 +86,123 @@ func runInit(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-func promptForInstance(defaultName string) (*config.Instance, error) {
+// instanceSetup holds the instance details given as flags to init and
+// instance add. Prompts only ask for what wasn't given, so an instance can be
+// set up from a script without a terminal.
+type instanceSetup struct {
+	name         string
+	subdomain    string
+	authType     config.AuthType
+	email        string
+	apiToken     string
+	clientID     string
+	clientSecret string
+}
+
+// addInstanceSetupFlags adds the flags read by instanceSetupFromFlags
+func addInstanceSetupFlags(cmd *cobra.Command) {
+	cmd.Flags().String("name", "", "Instance name")
+	cmd.Flags().String("subdomain", "", "Zendesk subdomain, e.g. mycompany for mycompany.zendesk.com")
+	cmd.Flags().String("auth-type", "", "Authentication method: token or oauth")
+	cmd.Flags().String("email", "", "Email address for API token authentication")
+	cmd.Flags().String("api-token", "", "API token (- to read it from stdin)")
+	cmd.Flags().String("client-id", "", "OAuth client ID")
+	cmd.Flags().String("client-secret", "", "OAuth client secret (- to read it from stdin)")
+}
+
+// instanceSetupFromFlags reads the flags added by addInstanceSetupFlags. A
+// secret of "-" is read from stdin so it stays out of shell history.
+func instanceSetupFromFlags(cmd *cobra.Command) (instanceSetup, error) {
+	var setup instanceSetup
+	setup.name, _ = cmd.Flags().GetString("name")
+	setup.subdomain, _ = cmd.Flags().GetString("subdomain")
+	authType, _ := cmd.Flags().GetString("auth-type")
+	setup.authType = config.AuthType(authType)
+	setup.email, _ = cmd.Flags().GetString("email")
+	setup.apiToken, _ = cmd.Flags().GetString("api-token")
+	setup.clientID, _ = cmd.Flags().GetString("client-id")
+	setup.clientSecret, _ = cmd.Flags().GetString("client-secret")
+
+	if setup.apiToken == "-" && setup.clientSecret == "-" {
+		return setup, fmt.Errorf("only one of --api-token and --client-secret can be read from stdin")
+	}
+	for _, secret := range []*string{&setup.apiToken, &setup.clientSecret} {
+		if *secret != "-" {
+			continue
+		}
+		value, err := readTextArg("-")
+		if err != nil {
+			return setup, err
+		}
+		*secret = strings.TrimSpace(value)
+	}
+
+	switch setup.authType {
+	case "":
+		// Token details imply token auth, OAuth client details imply OAuth
+		if setup.email != "" || setup.apiToken != "" {
+			setup.authType = config.AuthTypeToken
+		} else if setup.clientID != "" || setup.clientSecret != "" {
+			setup.authType = config.AuthTypeOAuth
+		}
+	case config.AuthTypeToken, config.AuthTypeOAuth:
+	default:
+		return setup, fmt.Errorf("invalid --auth-type %q: use token or oauth", setup.authType)
+	}
+
+	return setup, nil
+}
+
+// complete reports whether every detail of a token instance was given, so
+// init can skip asking whether to add another instance
+func (s instanceSetup) complete() bool {
+	return s.name != "" && s.subdomain != "" && s.authType == config.AuthTypeToken && s.email != "" && s.apiToken != ""
+}
+
+// valueOrPrompt returns the value given for a flag, checked with the
+// prompt's validation, or prompts for it when it wasn't given. Without a
+// terminal to prompt on, a missing value is an error.
+func valueOrPrompt(value, flag string, prompt promptui.Prompt) (string, error) {
+	if value != "" {
+		if prompt.Validate != nil {
+			if err := prompt.Validate(value); err != nil {
+				return "", fmt.Errorf("invalid --%s: %w", flag, err)
+			}
+		}
+		return strings.TrimSpace(value), nil
+	}
+
+	if !interactive() {
+		return "", fmt.Errorf("--%s is required when not running interactively", flag)
+	}
+
+	result, err := prompt.Run()
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(result), nil
+}
+
+func promptForInstance(setup instanceSetup, oauthOpts oauthOptions) (*config.Instance, error) {
 	instance := &config.Instance{}
+	var err error
 
 	// Instance name
-	namePrompt := promptui.Prompt{
-		Label:   "Instance name",
-		Default: defaultName,
+	instance.Name, err = valueOrPrompt(setup.name, "name", promptui.Prompt{
+		Label: "Instance name",
 		Validate: func(input string) error {
 			if strings.TrimSpace(input) == "" {
 				return fmt.Errorf("instance name cannot be empty")
 			}
 			return nil
 		},
-	}
-	name, err := namePrompt.Run()
+	})
 	if err != nil {
 		return nil, err
 	}
-	instance.Name = strings.TrimSpace(name)
 
 	// Subdomain
-	subdomainPrompt := promptui.Prompt{
+	instance.Subdomain, err = valueOrPrompt(setup.subdomain, "subdomain", promptui.Prompt{
 		Label: "Zendesk subdomain (e.g., 'mycompany' for mycompany.zendesk.com)",
 		Validate: func(input string) error {
 			if strings.TrimSpace(input) == "" {
@@ -99,29 +210,37 @@ func promptForInstance(defaultName string) (*config.Instance, error) {
 			}
 			return nil
 		},
-	}
-	subdomain, err := subdomainPrompt.Run()
+	})
 	if err != nil {
 		return nil, err
 	}
-	instance.Subdomain = strings.TrimSpace(subdomain)
 
 	// Auth type
-	authTypePrompt := promptui.Select{
-		Label: "Authentication method",
-		Items: []string{"API Token", "OAuth"},
-	}
-	authTypeIdx, _, err := authTypePrompt.Run()
-	if err != nil {
-		return nil, err
+	authType := setup.authType
+	if authType == "" {
+		if !interactive() {
+			return nil, fmt.Errorf("--auth-type is required when not running interactively")
+		}
+		authTypePrompt := promptui.Select{
+			Label: "Authentication method",
+			Items: []string{"API Token", "OAuth"},
+		}
+		authTypeIdx, _, err := authTypePrompt.Run()
+		if err != nil {
+			return nil, err
+		}
+		authType = config.AuthTypeToken
+		if authTypeIdx == 1 {
+			authType = config.AuthTypeOAuth
+		}
 	}
 
-	if authTypeIdx == 0 {
+	if authType == config.AuthTypeToken {
 		// API Token Authentication
 		instance.AuthType = config.AuthTypeToken
 
 		// Email
-		emailPrompt := promptui.Prompt{
+		instance.Email, err = valueOrPrompt(setup.email, "email", promptui.Prompt{
 			Label: "Email address",
 			Validate: func(input string) error {
 				if strings.TrimSpace(input) == "" {
@@ -132,15 +251,13 @@ func promptForInstance(defaultName string) (*config.Instance, error) {
 				}
 				return nil
 			},
-		}
-		email, err := emailPrompt.Run()
+		})
 		if err != nil {
 			return nil, err
 		}
-		instance.Email = strings.TrimSpace(email)
 
 		// API Token
-		tokenPrompt := promptui.Prompt{
+		instance.APIToken, err = valueOrPrompt(setup.apiToken, "api-token", promptui.Prompt{
 			Label: "API Token",
 			Mask:  '*',
 			Validate: func(input string) error {
@@ -149,16 +266,14 @@ func promptForInstance(defaultName string) (*config.Instance, error) {
 				}
 				return nil
 			},
-		}
-		token, err := tokenPrompt.Run()
+		})
 		if err != nil {
 			return nil, err
 		}
-		instance.APIToken = strings.TrimSpace(token)
 
 	} else {
 		// OAuth Authentication
-		if err := setupOAuth(instance); err != nil {
+		if err := setupOAuth(instance, setup, oauthOpts); err != nil {
 			return nil, err
 		}
 	}
@@ -166,16 +281,21 @@ func promptForInstance(defaultName string) (*config.Instance, error) {
 	return instance, nil
 }
 
-func setupOAuth(instance *config.Instance) error {
+func setupOAuth(instance *config.Instance, setup instanceSetup, oauthOpts oauthOptions) error {
 	instance.AuthType = config.AuthTypeOAuth
+	oauthOpts.apply(instance)
 
 	color.Cyan("\nOAuth Setup\n")
 	color.White("You need to create an OAuth client in your Zendesk instance first.\n")
 	color.White("Go to: Admin Center → Apps and integrations → APIs → Zendesk API → OAuth Clients\n")
-	color.White("Use redirect URL: %s\n\n", "http://localhost:8080/callback")
+	if instance.OAuthPKCE {
+		color.White("Set Client Kind to \"Public\" (PKCE, no client secret).\n")
+	}
+	color.White("Use redirect URL: %s\n\n", auth.RedirectURLForPort(instance.OAuthPort))
 
 	// OAuth Client ID
-	clientIDPrompt := promptui.Prompt{
+	var err error
+	instance.OAuthClientID, err = valueOrPrompt(setup.clientID, "client-id", promptui.Prompt{
 		Label: "OAuth Client ID",
 		Validate: func(input string) error {
 			if strings.TrimSpace(input) == "" {
@@ -183,29 +303,27 @@ func setupOAuth(instance *config.Instance) error {
 			}
 			return nil
 		},
-	}
-	clientID, err := clientIDPrompt.Run()
+	})
 	if err != nil {
 		return err
 	}
-	instance.OAuthClientID = strings.TrimSpace(clientID)
 
-	// OAuth Client Secret
-	secretPrompt := promptui.Prompt{
-		Label: "OAuth Client Secret",
-		Mask:  '*',
-		Validate: func(input string) error {
-			if strings.TrimSpace(input) == "" {
-				return fmt.Errorf("client secret cannot be empty")
-			}
-			return nil
-		},
-	}
-	secret, err := secretPrompt.Run()
-	if err != nil {
-		return err
+	// OAuth Client Secret (public PKCE clients don't have one)
+	if !instance.OAuthPKCE {
+		instance.OAuthSecret, err = valueOrPrompt(setup.clientSecret, "client-secret", promptui.Prompt{
+			Label: "OAuth Client Secret",
+			Mask:  '*',
+			Validate: func(input string) error {
+				if strings.TrimSpace(input) == "" {
+					return fmt.Errorf("client secret cannot be empty")
+				}
+				return nil
+			},
+		})
+		if err != nil {
+			return err
+		}
 	}
-	instance.OAuthSecret = strings.TrimSpace(secret)
 
 	// Perform OAuth flow
 	color.Cyan("\nStarting OAuth authorization flow...\n")
@@ -213,12 +331,8 @@ func setupOAuth(instance *config.Instance) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
 	defer cancel()
 
-	oauthCfg := auth.OAuthConfig{
-		ClientID:     instance.OAuthClientID,
-		ClientSecret: instance.OAuthSecret,
-		Subdomain:    instance.Subdomain,
-		RedirectURL:  auth.DefaultRedirectURL,
-	}
+	oauthCfg := oauthConfigFor(instance)
+	oauthCfg.NoBrowser = oauthOpts.noBrowser
 
 	token, err := auth.PerformOAuthFlow(ctx, oauthCfg)
 	if err != nil {
@@ -234,3 +348,68 @@ func setupOAuth(instance *config.Instance) error {
 
 	return nil
 }
+
+// oauthOptions are the OAuth settings chosen with --scopes, --pkce,
+// --callback-port, and --no-browser
+type oauthOptions struct {
+	scopes    []string
+	pkce      bool
+	port      int
+	noBrowser bool
+}
+
+// addOAuthFlags adds the flags that configure the OAuth authorization flow
+func addOAuthFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSlice("scopes", nil, "OAuth scopes to request, e.g. read or tickets:read,users:read (default: read,write)")
+	cmd.Flags().Bool("pkce", false, "Use PKCE instead of a client secret (for public OAuth clients)")
+	cmd.Flags().Int("callback-port", auth.CallbackPort, "Local port for the OAuth callback server")
+	cmd.Flags().Bool("no-browser", false, "Print the authorization URL and paste back the redirect URL or code (for SSH sessions)")
+}
+
+// oauthOptionsFromFlags reads the OAuth flags added by addOAuthFlags
+func oauthOptionsFromFlags(cmd *cobra.Command) oauthOptions {
+	scopes, _ := cmd.Flags().GetStringSlice("scopes")
+	pkce, _ := cmd.Flags().GetBool("pkce")
+	port, _ := cmd.Flags().GetInt("callback-port")
+	noBrowser, _ := cmd.Flags().GetBool("no-browser")
+	return oauthOptions{scopes: scopes, pkce: pkce, port: port, noBrowser: noBrowser}
+}
+
+// apply stores the options on an instance so later re-authorizations reuse
+// them. --no-browser only affects the current run and isn't stored.
+func (o oauthOptions) apply(instance *config.Instance) {
+	instance.SetOAuthScopes(o.scopes)
+	instance.OAuthPKCE = o.pkce
+	instance.OAuthPort = 0
+	if o.port != auth.CallbackPort {
+		instance.OAuthPort = o.port
+	}
+}
+
+// applyChangedOAuthFlags updates an existing instance with only the OAuth
+// flags that were given explicitly
+func applyChangedOAuthFlags(cmd *cobra.Command, instance *config.Instance) {
+	opts := oauthOptionsFromFlags(cmd)
+	if cmd.Flags().Changed("scopes") {
+		instance.SetOAuthScopes(opts.scopes)
+	}
+	if cmd.Flags().Changed("pkce") {
+		instance.OAuthPKCE = opts.pkce
+	}
+	if cmd.Flags().Changed("callback-port") {
+		instance.OAuthPort = opts.port
+	}
+}
+
+// oauthConfigFor builds the OAuth flow configuration for an instance
+func oauthConfigFor(instance *config.Instance) auth.OAuthConfig {
+	return auth.OAuthConfig{
+		ClientID:     instance.OAuthClientID,
+		ClientSecret: instance.OAuthSecret,
+		Subdomain:    instance.Subdomain,
+		RedirectURL:  auth.RedirectURLForPort(instance.OAuthPort),
+		Scopes:       instance.GetOAuthScopes(),
+		PKCE:         instance.OAuthPKCE,
+		CallbackPort: instance.OAuthPort,
+	}
+}
@@ -0,0 +1,415 @@
+package commands
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"zd-cli/internal/client"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+	"gopkg.in/yaml.v3"
+)
+
+// NonInteractiveEnvVar names the environment variable that turns on
+// --non-interactive, e.g. for CI jobs
+const NonInteractiveEnvVar = "ZD_NON_INTERACTIVE"
+
+// nonInteractive is set by --non-interactive or $ZD_NON_INTERACTIVE
+var nonInteractive bool
+
+// ApplyNonInteractive reads the global --non-interactive flag, falling back
+// to $ZD_NON_INTERACTIVE
+func ApplyNonInteractive(cmd *cobra.Command) error {
+	if cmd.Flags().Changed("non-interactive") {
+		nonInteractive, _ = cmd.Flags().GetBool("non-interactive")
+		return nil
+	}
+
+	if value := os.Getenv(NonInteractiveEnvVar); value != "" {
+		on, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("invalid %s %q: use true or false", NonInteractiveEnvVar, value)
+		}
+		nonInteractive = on
+	}
+	return nil
+}
+
+// stdinIsTerminal reports whether stdin is a terminal
+func stdinIsTerminal() bool {
+	return term.IsTerminal(int(os.Stdin.Fd()))
+}
+
+// interactive reports whether prompts can be shown: stdin is a terminal and
+// --non-interactive isn't set. Otherwise commands fail, naming the flag to
+// pass, instead of waiting for an answer.
+func interactive() bool {
+	return !nonInteractive && stdinIsTerminal()
+}
+
+// collectIDs gathers resource IDs from positional arguments and an optional
+// file. A file path of "-" reads from stdin. IDs may be separated by
+// whitespace or commas, and lines starting with '#' are ignored.
+// Duplicate IDs are dropped while preserving order.
+func collectIDs(args []string, fromFile string) ([]int64, error) {
+	var tokens []string
+	tokens = append(tokens, args...)
+
+	if fromFile != "" {
+		var r io.Reader
+		if fromFile == "-" {
+			r = os.Stdin
+		} else {
+			f, err := os.Open(fromFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to open %s: %w", fromFile, err)
+			}
+			defer f.Close()
+			r = f
+		}
+
+		scanner := bufio.NewScanner(r)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			tokens = append(tokens, line)
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, fmt.Errorf("failed to read IDs: %w", err)
+		}
+	}
+
+	seen := make(map[int64]bool)
+	var ids []int64
+	for _, token := range tokens {
+		fields := strings.FieldsFunc(token, func(r rune) bool {
+			return r == ',' || r == ' ' || r == '\t'
+		})
+		for _, field := range fields {
+			id, err := strconv.ParseInt(field, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid ID: %s", field)
+			}
+			if !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+	}
+
+	return ids, nil
+}
+
+// chunkIDs splits ids into batches of at most size elements
+func chunkIDs(ids []int64, size int) [][]int64 {
+	var chunks [][]int64
+	for start := 0; start < len(ids); start += size {
+		end := start + size
+		if end > len(ids) {
+			end = len(ids)
+		}
+		chunks = append(chunks, ids[start:end])
+	}
+	return chunks
+}
+
+// stringFieldTypes are the ticket field types whose values are always sent
+// as strings, so an order number like 0042 or 123456789012345678 in a text
+// field isn't turned into a number
+var stringFieldTypes = []string{"text", "textarea", "regexp", "partialcreditcard", "date", "tagger"}
+
+// parseCustomFields builds custom field values from repeated --field
+// id=value flags and an optional --field-json document. A --field value for
+// a text, date, or dropdown field is sent as given; for other fields, values
+// that parse as JSON keep their type (numbers, booleans, arrays, null) and
+// anything else is sent as a string. --field-json accepts either
+// [{"id":1,"value":"x"}] or {"1":"x"}; --field entries override it for the
+// same field ID.
+func parseCustomFields(zdClient *client.Client, fields []string, fieldJSON string) ([]client.CustomField, error) {
+	var result []client.CustomField
+	index := map[int64]int{}
+
+	set := func(id int64, value interface{}) {
+		if i, ok := index[id]; ok {
+			result[i].Value = value
+			return
+		}
+		index[id] = len(result)
+		result = append(result, client.CustomField{ID: id, Value: value})
+	}
+
+	if fieldJSON != "" {
+		document, err := decodeJSONValue(fieldJSON)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --field-json: expected [{\"id\":...,\"value\":...}] or {\"<id>\": value}")
+		}
+
+		switch document := document.(type) {
+		case []interface{}:
+			for _, item := range document {
+				field, ok := item.(map[string]interface{})
+				if !ok {
+					return nil, fmt.Errorf("invalid --field-json: expected [{\"id\":...,\"value\":...}]")
+				}
+				id, err := strconv.ParseInt(fmt.Sprint(field["id"]), 10, 64)
+				if err != nil {
+					return nil, fmt.Errorf("invalid field ID in --field-json: %v", field["id"])
+				}
+				set(id, field["value"])
+			}
+		case map[string]interface{}:
+			ids := make([]string, 0, len(document))
+			for key := range document {
+				ids = append(ids, key)
+			}
+			sort.Strings(ids)
+			for _, key := range ids {
+				id, err := strconv.ParseInt(key, 10, 64)
+				if err != nil {
+					return nil, fmt.Errorf("invalid field ID in --field-json: %s", key)
+				}
+				set(id, document[key])
+			}
+		default:
+			return nil, fmt.Errorf("invalid --field-json: expected [{\"id\":...,\"value\":...}] or {\"<id>\": value}")
+		}
+	}
+
+	ctx := context.Background()
+
+	for _, field := range fields {
+		key, raw, ok := strings.Cut(field, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid --field %q: expected <id>=<value>", field)
+		}
+		id, err := strconv.ParseInt(strings.TrimSpace(key), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid field ID in --field %q", field)
+		}
+
+		definition, err := zdClient.GetTicketField(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get ticket field %d for --field: %w", id, err)
+		}
+		if slices.Contains(stringFieldTypes, definition.Type) {
+			set(id, raw)
+		} else {
+			set(id, parseFieldValue(raw))
+		}
+	}
+
+	return result, nil
+}
+
+// parseFieldValue types a --field value: valid JSON keeps its JSON type,
+// anything else is treated as a plain string
+func parseFieldValue(raw string) interface{} {
+	if value, err := decodeJSONValue(raw); err == nil {
+		return value
+	}
+	return raw
+}
+
+// decodeJSONValue decodes a single JSON value, keeping numbers as
+// json.Number so large IDs and account numbers are sent exactly
+func decodeJSONValue(data string) (interface{}, error) {
+	decoder := json.NewDecoder(strings.NewReader(data))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, fmt.Errorf("unexpected data after JSON value")
+	}
+	return value, nil
+}
+
+// readDefinition reads a JSON or YAML object from a file ("-" for stdin) for
+// create and update commands. A document wrapped in the resource key, as
+// returned by the API (e.g. {"automation": {...}}), is unwrapped, and
+// read-only fields are dropped so exported resources can be re-imported.
+func readDefinition(path string, key string) (map[string]interface{}, error) {
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	// JSON is valid YAML, but decoding it as JSON keeps large IDs exact
+	var definition map[string]interface{}
+	if json.Valid(data) {
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.UseNumber()
+		if err := decoder.Decode(&definition); err != nil {
+			return nil, fmt.Errorf("invalid JSON in %s: %w", path, err)
+		}
+	} else if err := yaml.Unmarshal(data, &definition); err != nil {
+		return nil, fmt.Errorf("invalid JSON or YAML in %s: %w", path, err)
+	}
+	if definition == nil {
+		return nil, fmt.Errorf("%s does not contain an object", path)
+	}
+
+	if wrapped, ok := definition[key].(map[string]interface{}); ok && len(definition) == 1 {
+		definition = wrapped
+	}
+
+	for _, field := range []string{"id", "url", "created_at", "updated_at"} {
+		delete(definition, field)
+	}
+
+	return definition, nil
+}
+
+// readDefinitions reads a list of JSON objects from a file ("-" for stdin)
+// for import commands. The file may hold a JSON array, an object wrapping
+// the array in the resource key (e.g. {"tickets": [...]}), or one object per
+// line as written by the export commands. The id and url fields are
+// dropped; timestamps are kept so imports can preserve them.
+func readDefinitions(path string, key string) ([]map[string]interface{}, error) {
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	var definitions []map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	for {
+		var value interface{}
+		if err := decoder.Decode(&value); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("invalid JSON in %s: %w", path, err)
+		}
+
+		if wrapped, ok := value.(map[string]interface{}); ok {
+			if list, ok := wrapped[key].([]interface{}); ok && len(wrapped) == 1 {
+				value = list
+			}
+		}
+
+		switch v := value.(type) {
+		case map[string]interface{}:
+			definitions = append(definitions, v)
+		case []interface{}:
+			for i, item := range v {
+				definition, ok := item.(map[string]interface{})
+				if !ok {
+					return nil, fmt.Errorf("item %d in %s is not an object", i+1, path)
+				}
+				definitions = append(definitions, definition)
+			}
+		default:
+			return nil, fmt.Errorf("%s must contain objects or an array of objects", path)
+		}
+	}
+
+	for _, definition := range definitions {
+		delete(definition, "id")
+		delete(definition, "url")
+	}
+
+	return definitions, nil
+}
+
+// mergeDefinition copies the fields of overlay onto definition, merging
+// nested objects so a flag can set one field of, say, the comment without
+// dropping the rest of it from the file
+func mergeDefinition(definition, overlay map[string]interface{}) map[string]interface{} {
+	if definition == nil {
+		definition = make(map[string]interface{})
+	}
+	for key, value := range overlay {
+		nested, ok := value.(map[string]interface{})
+		existing, exists := definition[key].(map[string]interface{})
+		if ok && exists {
+			definition[key] = mergeDefinition(existing, nested)
+			continue
+		}
+		definition[key] = value
+	}
+	return definition
+}
+
+// toDefinition converts a request struct to the generic form used by
+// definitions read from files, keeping only the fields it sets
+func toDefinition(v interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	var definition map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&definition); err != nil {
+		return nil, fmt.Errorf("failed to decode request: %w", err)
+	}
+	return definition, nil
+}
+
+// readTextArg resolves a flag value that may name its content elsewhere:
+// "-" reads stdin, "@path" reads a file, "@@..." is a literal "@...", and
+// anything else is used as is
+func readTextArg(value string) (string, error) {
+	var data []byte
+	var err error
+	switch {
+	case strings.HasPrefix(value, "@@"):
+		return value[1:], nil
+	case value == "-":
+		data, err = io.ReadAll(os.Stdin)
+		if err != nil {
+			return "", fmt.Errorf("failed to read stdin: %w", err)
+		}
+	case strings.HasPrefix(value, "@"):
+		data, err = os.ReadFile(value[1:])
+		if err != nil {
+			return "", fmt.Errorf("failed to read %s: %w", value[1:], err)
+		}
+	default:
+		return value, nil
+	}
+	return string(data), nil
+}
+
+// messageFromFlag reads a comment or description flag, which may name a
+// file with @path or stdin with -. Line breaks are kept as written, apart
+// from the trailing newline files and pipes usually end with.
+func messageFromFlag(cmd *cobra.Command, name string) (string, error) {
+	value, _ := cmd.Flags().GetString(name)
+	message, err := readTextArg(value)
+	if err != nil {
+		return "", fmt.Errorf("--%s: %w", name, err)
+	}
+	if value == "-" || (strings.HasPrefix(value, "@") && !strings.HasPrefix(value, "@@")) {
+		message = strings.TrimRight(message, "\r\n")
+	}
+	return message, nil
+}
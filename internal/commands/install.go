@@ -48,6 +48,9 @@ func runInstall(cmd *cobra.Command, args []string) error {
 	// Check if file exists at target
 	if _, err := os.Stat(targetPath); err == nil {
 		color.Yellow("A file already exists at %s\n", targetPath)
+		if !interactive() {
+			return fmt.Errorf("can't confirm overwriting %s when not running interactively: remove it first", targetPath)
+		}
 		prompt := promptui.Prompt{
 			Label:     "Overwrite it",
 			IsConfirm: true,
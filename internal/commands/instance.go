@@ -2,6 +2,7 @@ package commands
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"zd-cli/internal/config"
@@ -22,31 +23,56 @@ func NewInstanceCommand() *cobra.Command {
 	cmd.AddCommand(newInstanceSwitchCommand())
 	cmd.AddCommand(newInstanceRemoveCommand())
 	cmd.AddCommand(newInstanceCurrentCommand())
+	cmd.AddCommand(newInstanceMigrateSecretsCommand())
 
 	return cmd
 }
 
 func newInstanceAddCommand() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "add",
 		Short: "Add a new Zendesk instance",
 		RunE:  runAddInstance,
 	}
+
+	addInstanceSetupFlags(cmd)
+	addInsecureStoreFlag(cmd)
+	addOAuthFlags(cmd)
+
+	return cmd
 }
 
 func runAddInstance(cmd *cobra.Command, args []string) error {
+	setup, err := instanceSetupFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
 	// Load existing config
-	cfg, err := config.LoadOrCreate()
+	cfg, err := loadOrCreateConfig(cmd)
 	if err != nil {
 		return fmt.Errorf("failed to load configuration: %w", err)
 	}
 
+	return addInstance(cmd, cfg, setup)
+}
+
+// addInstance asks for the details setup doesn't give, then adds the
+// instance to cfg and makes it current
+func addInstance(cmd *cobra.Command, cfg *config.Config, setup instanceSetup) error {
+	// Fail before prompting when the name given is taken
+	if _, exists := cfg.Instances[setup.name]; exists {
+		return fmt.Errorf("instance '%s' already exists", setup.name)
+	}
+
 	// Prompt for instance details
-	instance, err := promptForInstance("")
+	instance, err := promptForInstance(setup, oauthOptionsFromFlags(cmd))
 	if err != nil {
 		return err
 	}
 
+	instance.SecretStore = secretStoreFromFlags(cmd)
+
 	// Check if instance already exists
 	if _, exists := cfg.Instances[instance.Name]; exists {
 		return fmt.Errorf("instance '%s' already exists", instance.Name)
@@ -58,7 +84,7 @@ func runAddInstance(cmd *cobra.Command, args []string) error {
 	}
 
 	// Save config
-	if err := config.Save(cfg); err != nil {
+	if err := saveConfig(cmd, cfg); err != nil {
 		return fmt.Errorf("failed to save configuration: %w", err)
 	}
 
@@ -77,7 +103,7 @@ func newInstanceListCommand() *cobra.Command {
 }
 
 func runInstanceList(cmd *cobra.Command, args []string) error {
-	cfg, err := config.Load()
+	cfg, err := loadConfig(cmd)
 	if err == config.ErrConfigNotFound {
 		color.Yellow("No instances configured. Run 'zd init' to get started.\n")
 		return nil
@@ -92,8 +118,8 @@ func runInstanceList(cmd *cobra.Command, args []string) error {
 	}
 
 	// Print header
-	fmt.Printf("%-3s %-20s %-30s %-10s %s\n", "", "NAME", "SUBDOMAIN", "AUTH TYPE", "EMAIL")
-	fmt.Println(strings.Repeat("-", 80))
+	fmt.Printf("%-3s %-20s %-30s %-10s %-22s %s\n", "", "NAME", "SUBDOMAIN", "AUTH TYPE", "ENVIRONMENT", "EMAIL")
+	fmt.Println(strings.Repeat("-", 103))
 
 	// Print instances
 	for name, instance := range cfg.Instances {
@@ -107,11 +133,12 @@ func runInstanceList(cmd *cobra.Command, args []string) error {
 			email = "(OAuth)"
 		}
 
-		fmt.Printf("%-3s %-20s %-30s %-10s %s\n",
+		fmt.Printf("%-3s %-20s %-30s %-10s %-22s %s\n",
 			current,
 			name,
 			instance.Subdomain+".zendesk.com",
 			string(instance.AuthType),
+			environmentLabel(instance),
 			email,
 		)
 	}
@@ -121,17 +148,18 @@ func runInstanceList(cmd *cobra.Command, args []string) error {
 
 func newInstanceSwitchCommand() *cobra.Command {
 	return &cobra.Command{
-		Use:   "switch <name>",
-		Short: "Switch to a different instance",
-		Args:  cobra.ExactArgs(1),
-		RunE:  runInstanceSwitch,
+		Use:               "switch <name>",
+		Short:             "Switch to a different instance",
+		Args:              cobra.ExactArgs(1),
+		RunE:              runInstanceSwitch,
+		ValidArgsFunction: completeFirstArg(CompleteInstanceNames),
 	}
 }
 
 func runInstanceSwitch(cmd *cobra.Command, args []string) error {
 	name := args[0]
 
-	cfg, err := config.Load()
+	cfg, err := loadConfig(cmd)
 	if err != nil {
 		return fmt.Errorf("failed to load configuration: %w", err)
 	}
@@ -140,7 +168,7 @@ func runInstanceSwitch(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to switch instance: %w", err)
 	}
 
-	if err := config.Save(cfg); err != nil {
+	if err := saveConfig(cmd, cfg); err != nil {
 		return fmt.Errorf("failed to save configuration: %w", err)
 	}
 
@@ -151,29 +179,41 @@ func runInstanceSwitch(cmd *cobra.Command, args []string) error {
 
 func newInstanceRemoveCommand() *cobra.Command {
 	return &cobra.Command{
-		Use:   "remove <name>",
-		Short: "Remove an instance",
-		Args:  cobra.ExactArgs(1),
-		RunE:  runInstanceRemove,
+		Use:               "remove <name>",
+		Short:             "Remove an instance",
+		Args:              cobra.ExactArgs(1),
+		RunE:              runInstanceRemove,
+		ValidArgsFunction: completeFirstArg(CompleteInstanceNames),
 	}
 }
 
 func runInstanceRemove(cmd *cobra.Command, args []string) error {
 	name := args[0]
 
-	cfg, err := config.Load()
+	cfg, err := loadConfig(cmd)
 	if err != nil {
 		return fmt.Errorf("failed to load configuration: %w", err)
 	}
 
+	instance, err := cfg.GetInstance(name)
+	if err != nil {
+		return fmt.Errorf("failed to remove instance: %w", err)
+	}
+
 	if err := cfg.RemoveInstance(name); err != nil {
 		return fmt.Errorf("failed to remove instance: %w", err)
 	}
 
-	if err := config.Save(cfg); err != nil {
+	if err := saveConfig(cmd, cfg); err != nil {
 		return fmt.Errorf("failed to save configuration: %w", err)
 	}
 
+	if instance.UsesKeychain() {
+		if err := config.DeleteSecrets(name); err != nil {
+			return err
+		}
+	}
+
 	color.Green("✓ Instance '%s' removed\n", name)
 
 	if cfg.Current != "" {
@@ -192,7 +232,7 @@ func newInstanceCurrentCommand() *cobra.Command {
 }
 
 func runInstanceCurrent(cmd *cobra.Command, args []string) error {
-	cfg, err := config.Load()
+	cfg, err := loadConfig(cmd)
 	if err != nil {
 		return fmt.Errorf("failed to load configuration: %w", err)
 	}
@@ -213,6 +253,143 @@ func runInstanceCurrent(cmd *cobra.Command, args []string) error {
 	if instance.Email != "" {
 		color.White("  Email: %s\n", instance.Email)
 	}
+	if instance.Environment != "" || instance.ReadOnly {
+		color.White("  Environment: %s\n", environmentLabel(instance))
+	}
+
+	return nil
+}
+
+// environmentLabel describes an instance's environment and whether it's
+// read-only, e.g. "production, read-only"
+func environmentLabel(instance *config.Instance) string {
+	var parts []string
+	if instance.Environment != "" {
+		parts = append(parts, strings.ToLower(string(instance.Environment)))
+	}
+	if instance.ReadOnly {
+		parts = append(parts, "read-only")
+	}
+	if len(parts) == 0 {
+		return "-"
+	}
+	return strings.Join(parts, ", ")
+}
+
+func newInstanceMigrateSecretsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-secrets [name...]",
+		Short: "Move instance secrets into the OS keychain",
+		Long: `Move API tokens and OAuth secrets out of the plaintext config file and into
+the OS keychain (macOS Keychain, Windows Credential Manager, or libsecret on
+Linux). Migrates every instance unless names are given.
+
+Use --to-file to move secrets back into the config file.`,
+		RunE:              runInstanceMigrateSecrets,
+		ValidArgsFunction: CompleteInstanceNames,
+	}
+
+	cmd.Flags().Bool("to-file", false, "Move secrets from the OS keychain back into the config file")
+
+	return cmd
+}
+
+func runInstanceMigrateSecrets(cmd *cobra.Command, args []string) error {
+	toFile, _ := cmd.Flags().GetBool("to-file")
+
+	cfg, err := loadConfig(cmd)
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	names := args
+	if len(names) == 0 {
+		for name := range cfg.Instances {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+	}
+
+	target := config.SecretStoreKeychain
+	if toFile {
+		target = config.SecretStoreFile
+	}
+
+	var migrated []string
+	for _, name := range names {
+		instance, err := cfg.GetInstance(name)
+		if err != nil {
+			return fmt.Errorf("instance '%s' not found", name)
+		}
+		if instance.UsesKeychain() == !toFile {
+			continue
+		}
+		instance.SecretStore = target
+		migrated = append(migrated, name)
+	}
+
+	if len(migrated) == 0 {
+		color.Yellow("Nothing to migrate: secrets are already stored in the %s.\n", secretStoreLabel(target))
+		return nil
+	}
+
+	if err := saveConfig(cmd, cfg); err != nil {
+		return fmt.Errorf("failed to save configuration: %w", err)
+	}
+
+	// Secrets are now in the config file, so drop the keychain copies
+	if toFile {
+		for _, name := range migrated {
+			if err := config.DeleteSecrets(name); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, name := range migrated {
+		color.Green("✓ Moved secrets for '%s' to the %s\n", name, secretStoreLabel(target))
+	}
 
 	return nil
 }
+
+// secretStoreLabel describes a secret store for messages
+func secretStoreLabel(store string) string {
+	if store == config.SecretStoreKeychain {
+		return "OS keychain"
+	}
+	return "config file"
+}
+
+// addInsecureStoreFlag adds the --insecure-store flag to commands that create instances
+func addInsecureStoreFlag(cmd *cobra.Command) {
+	cmd.Flags().Bool("insecure-store", false, "Store secrets in plaintext in the config file instead of the OS keychain")
+}
+
+// secretStoreFromFlags returns where a new instance's secrets should be stored
+func secretStoreFromFlags(cmd *cobra.Command) string {
+	if insecure, _ := cmd.Flags().GetBool("insecure-store"); insecure {
+		return config.SecretStoreFile
+	}
+	return config.SecretStoreKeychain
+}
+
+// CompleteInstanceNames completes the names of configured instances, for
+// instance arguments and flags. Errors are swallowed since completion must
+// never print output.
+func CompleteInstanceNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	cfg, err := loadConfig(cmd)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	var names []string
+	for name := range cfg.Instances {
+		if !containsString(args, name) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
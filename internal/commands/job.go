@@ -0,0 +1,222 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"zd-cli/internal/client"
+	"zd-cli/internal/output"
+	"zd-cli/internal/progress"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// jobResultHeaders are the CSV columns for job results
+var jobResultHeaders = []string{"id", "index", "action", "success", "status", "error", "details"}
+
+// NewJobCommand creates the job command for background jobs
+func NewJobCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "job",
+		Short: "Track Zendesk background jobs",
+		Long: `Check on background jobs such as bulk updates, imports, and merges.
+
+Commands that start a job print its ID; use it with these commands to see
+how far along the job is or wait for it to finish.`,
+	}
+
+	cmd.AddCommand(newJobStatusCommand())
+	cmd.AddCommand(newJobWaitCommand())
+
+	cmd.PersistentFlags().StringP("output", "o", "table", "Output format: table, json, csv (csv lists per-item results)")
+
+	return cmd
+}
+
+func newJobStatusCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status <job-id>",
+		Short: "Show the status of a background job",
+		Args:  cobra.ExactArgs(1),
+		RunE:  withClient(runJobStatus),
+	}
+
+	return cmd
+}
+
+func newJobWaitCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wait <job-id>",
+		Short: "Wait for a background job to finish",
+		Long: `Poll a background job until it finishes, showing its progress, then print
+its final status. Exits with an error if the job failed or was killed.`,
+		Args: cobra.ExactArgs(1),
+		RunE: withClient(runJobWait),
+	}
+
+	cmd.Flags().Duration("timeout", 30*time.Minute, "Give up waiting after this long")
+
+	return cmd
+}
+
+func runJobStatus(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	ctx := context.Background()
+
+	job, err := zdClient.GetJobStatus(ctx, args[0])
+	if err != nil {
+		return fmt.Errorf("failed to get job status: %w", err)
+	}
+
+	return outputJobStatus(cmd, job)
+}
+
+func runJobWait(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	timeout, _ := cmd.Flags().GetDuration("timeout")
+	ctx, cancel := context.WithTimeout(client.WithoutTimeout(context.Background()), timeout)
+	defer cancel()
+
+	job, err := zdClient.GetJobStatus(ctx, args[0])
+	if err != nil {
+		return fmt.Errorf("failed to get job status: %w", err)
+	}
+
+	// The bar is drawn on stderr, so JSON and CSV output stay clean
+	var report func(*client.JobStatus)
+	if !job.IsFinished() {
+		bar := progress.NewBar("Job "+job.ID, job.Total)
+		defer bar.Finish()
+		report = func(job *client.JobStatus) {
+			if job.Total > 0 {
+				bar.SetTotal(job.Total)
+			}
+			bar.Set(job.Progress)
+			if job.IsFinished() {
+				bar.Finish()
+			}
+		}
+	}
+
+	job, err = zdClient.WaitForJob(ctx, job, report)
+	if err != nil {
+		return fmt.Errorf("failed waiting for job: %w", err)
+	}
+
+	if err := outputJobStatus(cmd, job); err != nil {
+		return err
+	}
+
+	if job.Status != "completed" {
+		return fmt.Errorf("job %s %s", job.ID, job.Status)
+	}
+
+	return nil
+}
+
+// runBulkBatches splits ids into batches of size, submits each with submit,
+// and waits for its job before sending the next, showing progress under
+// message. It returns one result per ID; batches whose job didn't complete
+// are reported on stderr and their missing results count as failures. If a
+// batch can't be submitted or tracked, the results of the batches before it
+// are returned with the error, since those changes were already made.
+func runBulkBatches(ctx context.Context, zdClient *client.Client, message string, ids []int64, size int, submit func(batch []int64) (*client.JobStatus, error)) ([]client.JobStatusResult, error) {
+	batches := chunkIDs(ids, size)
+	var results []client.JobStatusResult
+
+	bar := progress.NewBar(message, len(ids))
+	defer bar.Finish()
+	done := 0
+
+	for i, batch := range batches {
+		job, err := submit(batch)
+		if err != nil {
+			return results, fmt.Errorf("failed to submit batch %d/%d: %w", i+1, len(batches), err)
+		}
+
+		job, err = zdClient.WaitForJob(ctx, job, func(job *client.JobStatus) {
+			bar.Set(done + job.Progress)
+		})
+		if err != nil {
+			return results, fmt.Errorf("failed to track batch %d/%d: %w", i+1, len(batches), err)
+		}
+
+		if job.Status != "completed" {
+			bar.Clear()
+			color.Red("✗ Batch %d/%d %s: %s\n", i+1, len(batches), job.Status, job.Message)
+		}
+		results = append(results, jobResultsFor(job, batch)...)
+		done += len(batch)
+		bar.Set(done)
+	}
+
+	return results, nil
+}
+
+// outputJobStatus outputs a job status in the requested format
+func outputJobStatus(cmd *cobra.Command, job *client.JobStatus) error {
+	format, _ := cmd.Flags().GetString("output")
+	writer := output.NewWriter(output.Format(format))
+
+	switch output.Format(format) {
+	case output.FormatJSON:
+		return writer.WriteJSON(job)
+
+	case output.FormatCSV:
+		return writer.WriteCSV(job.Results, jobResultHeaders)
+
+	default:
+		displayJobStatus(job)
+		return nil
+	}
+}
+
+// displayJobStatus prints a job status and any failed items
+func displayJobStatus(job *client.JobStatus) {
+	color.Cyan("Job %s\n", job.ID)
+	color.White(strings.Repeat("─", 80) + "\n")
+	color.White("  Status:    %s\n", getColoredJobStatus(job.Status))
+	if job.Total > 0 {
+		color.White("  Progress:  %d/%d\n", job.Progress, job.Total)
+	}
+	if job.Message != "" {
+		color.White("  Message:   %s\n", job.Message)
+	}
+
+	if len(job.Results) == 0 {
+		return
+	}
+
+	var failures []client.JobStatusResult
+	for _, result := range job.Results {
+		if result.Error != "" || result.Status == "Failed" {
+			failures = append(failures, result)
+		}
+	}
+
+	color.White("  Results:   %d item(s), %d failed\n", len(job.Results), len(failures))
+	for _, failure := range failures {
+		reason := failure.Error
+		if failure.Details != "" {
+			reason = fmt.Sprintf("%s (%s)", reason, failure.Details)
+		}
+		if failure.ID != 0 {
+			color.Red("    ✗ #%d: %s\n", failure.ID, reason)
+		} else {
+			color.Red("    ✗ Item %d: %s\n", failure.Index, reason)
+		}
+	}
+}
+
+// getColoredJobStatus colors a job status by outcome
+func getColoredJobStatus(status string) string {
+	switch status {
+	case "completed":
+		return color.GreenString(status)
+	case "failed", "killed":
+		return color.RedString(status)
+	default:
+		return color.YellowString(status)
+	}
+}
@@ -0,0 +1,206 @@
+package commands
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"zd-cli/internal/config"
+
+	"github.com/spf13/cobra"
+)
+
+// anyResource stands for a link to any resource, which picks the instance
+// but is left for the command to read
+const anyResource = "*"
+
+// linkResources maps the ID placeholders in command usage lines to the
+// resource a link in that position must point to
+var linkResources = map[string]string{
+	"ticket-id":  "tickets",
+	"request-id": "tickets",
+	"user-id":    "users",
+	"org-id":     "organizations",
+	"link":       anyResource,
+}
+
+// linkNouns names each resource in errors
+var linkNouns = map[string]string{
+	"tickets":       "ticket",
+	"users":         "user",
+	"organizations": "organization",
+}
+
+// ApplyResourceLinks lets Zendesk links stand in for ticket, user, and
+// organization IDs, such as https://acme.zendesk.com/agent/tickets/123 for
+// <ticket-id>. Each link is replaced with its ID in args, and unless an
+// instance is named, the configured instance with the link's subdomain is
+// used, so a link pasted from the browser goes to the instance it came from.
+func ApplyResourceLinks(cmd *cobra.Command, args []string) error {
+	subdomain := ""
+	for i, resource := range argResources(cmd, len(args)) {
+		if resource == "" || !looksLikeLink(args[i]) {
+			continue
+		}
+
+		// The message says what's wrong with a link better than usage would
+		cmd.SilenceUsage = true
+
+		linkSubdomain, linkResource, id, err := parseResourceLink(args[i])
+		if err != nil {
+			return err
+		}
+		if resource != anyResource && linkResource != resource {
+			return fmt.Errorf("%s is a link to a %s, not a %s", args[i], linkNouns[linkResource], linkNouns[resource])
+		}
+		if linkSubdomain != "" {
+			if subdomain != "" && linkSubdomain != subdomain {
+				return fmt.Errorf("links are from different instances: %s.zendesk.com and %s.zendesk.com", subdomain, linkSubdomain)
+			}
+			subdomain = linkSubdomain
+		}
+
+		if resource != anyResource {
+			args[i] = strconv.FormatInt(id, 10)
+		}
+	}
+
+	if subdomain == "" {
+		return nil
+	}
+	return selectInstanceForLink(cmd, subdomain)
+}
+
+// argResources returns the resource each of n arguments must link to, read
+// from the placeholders in the command's usage line, or "" where an argument
+// isn't an ID. A placeholder ending in "..." covers the rest of the arguments.
+func argResources(cmd *cobra.Command, n int) []string {
+	resources := make([]string, n)
+	placeholders := strings.Fields(cmd.Use)
+	if len(placeholders) > 0 {
+		placeholders = placeholders[1:]
+	}
+
+	for i := range resources {
+		if len(placeholders) == 0 {
+			break
+		}
+
+		placeholder := placeholders[0]
+		variadic := strings.HasSuffix(strings.TrimRight(placeholder, ">]"), "...")
+		if !variadic || len(placeholders) > 1 {
+			placeholders = placeholders[1:]
+		}
+
+		// "<user-id|email|name>" takes a user link too
+		name := strings.Trim(placeholder, "<>[].")
+		name, _, _ = strings.Cut(name, "|")
+		resources[i] = linkResources[name]
+	}
+
+	return resources
+}
+
+// looksLikeLink reports whether an argument is a URL rather than an ID, name,
+// or email address
+func looksLikeLink(arg string) bool {
+	return strings.Contains(arg, "://") || strings.Contains(arg, ".zendesk.com/")
+}
+
+// parseResourceLink returns the subdomain, resource, and ID a Zendesk link
+// points to. Agent interface, API, and help center request links are
+// understood, e.g. /agent/tickets/123, /api/v2/users/456.json, and
+// /hc/en-us/requests/123. The subdomain is empty for a host-mapped domain.
+func parseResourceLink(link string) (string, string, int64, error) {
+	raw := link
+	if !strings.Contains(raw, "://") {
+		raw = "https://" + raw
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return "", "", 0, fmt.Errorf("invalid link: %s", link)
+	}
+
+	subdomain := ""
+	if host := strings.ToLower(u.Hostname()); strings.HasSuffix(host, ".zendesk.com") {
+		subdomain = strings.TrimSuffix(host, ".zendesk.com")
+	}
+
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	for i := 0; i+1 < len(segments); i++ {
+		resource := segments[i]
+		if resource == "requests" {
+			resource = "tickets"
+		}
+		if linkNouns[resource] == "" {
+			continue
+		}
+
+		id, err := strconv.ParseInt(strings.TrimSuffix(segments[i+1], ".json"), 10, 64)
+		if err != nil {
+			continue
+		}
+		return subdomain, resource, id, nil
+	}
+
+	return "", "", 0, fmt.Errorf("%s doesn't link to a ticket, user, or organization", link)
+}
+
+// selectInstanceForLink makes sure the command talks to the instance a link
+// came from. A named instance, or credentials in the environment, must
+// match it; otherwise the configured instance with the link's subdomain is
+// chosen, preferring the current one.
+func selectInstanceForLink(cmd *cobra.Command, subdomain string) error {
+	name, _ := cmd.Flags().GetString("instance")
+	if name == "" {
+		name = os.Getenv(config.InstanceEnvVar)
+	}
+
+	if name == "" {
+		if envSubdomain := os.Getenv(config.SubdomainEnvVar); envSubdomain != "" {
+			if !strings.EqualFold(envSubdomain, subdomain) {
+				return fmt.Errorf("link is for %s.zendesk.com, but %s is %s", subdomain, config.SubdomainEnvVar, envSubdomain)
+			}
+			return nil
+		}
+	}
+
+	cfg, err := loadConfig(cmd)
+	if err != nil {
+		// Commands report a missing or broken config themselves
+		return nil
+	}
+
+	if name != "" {
+		instance, err := cfg.GetInstance(name)
+		if err != nil {
+			return nil
+		}
+		if !strings.EqualFold(instance.Subdomain, subdomain) {
+			return fmt.Errorf("link is for %s.zendesk.com, but instance '%s' is %s.zendesk.com", subdomain, name, instance.Subdomain)
+		}
+		return nil
+	}
+
+	if current, ok := cfg.Instances[cfg.Current]; ok && strings.EqualFold(current.Subdomain, subdomain) {
+		return nil
+	}
+
+	names := make([]string, 0, len(cfg.Instances))
+	for name := range cfg.Instances {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if strings.EqualFold(cfg.Instances[name].Subdomain, subdomain) {
+			return cmd.Flags().Set("instance", name)
+		}
+	}
+
+	return fmt.Errorf("no instance is configured for %s.zendesk.com. Run 'zd instance add' to add it", subdomain)
+}
@@ -0,0 +1,130 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"zd-cli/internal/client"
+	"zd-cli/internal/output"
+	"zd-cli/internal/progress"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+// listStream writes the results of an --all listing page by page as they
+// arrive. Table and CSV output are written immediately; JSON output is
+// buffered so it stays a single valid array. A progress bar on stderr shows
+// how far the listing has got, unless rows are going to the terminal.
+type listStream[T any] struct {
+	format    output.Format
+	writer    *output.Writer
+	noun      string
+	headers   []string
+	printPage func(items []T, start int, header bool)
+	count     int
+	items     []T
+	bar       *progress.Bar
+}
+
+// newListStream creates a listStream for the command's output format. noun
+// is the plural name of the items, used in headings and messages; printPage
+// renders one page of items as a table. The bar's total comes from the
+// first page zdClient fetches.
+func newListStream[T any](cmd *cobra.Command, zdClient *client.Client, noun string, headers []string, printPage func([]T, int, bool)) *listStream[T] {
+	format, _ := cmd.Flags().GetString("output")
+	s := &listStream[T]{
+		format:    output.Format(format),
+		writer:    output.NewWriter(output.Format(format)),
+		noun:      noun,
+		headers:   headers,
+		printPage: printPage,
+	}
+
+	// Rows streaming to the terminal show progress themselves, and a bar
+	// would be drawn over them
+	if s.format == output.FormatJSON || !term.IsTerminal(int(os.Stdout.Fd())) {
+		s.bar = progress.NewBar("Fetching "+noun, 0)
+		zdClient.OnListTotal(s.bar.SetTotal)
+	}
+
+	return s
+}
+
+// write outputs one page of items
+func (s *listStream[T]) write(items []T) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	first := s.count == 0
+	s.count += len(items)
+	if s.bar != nil {
+		s.bar.Set(s.count)
+	}
+
+	switch s.format {
+	case output.FormatJSON:
+		s.items = append(s.items, items...)
+		return nil
+
+	case output.FormatCSV:
+		if first {
+			if err := s.writer.WriteCSVHeader(s.headers); err != nil {
+				return err
+			}
+		}
+		return s.writer.WriteCSVRows(items, s.headers)
+
+	default:
+		// Table format (default)
+		if first {
+			color.Cyan("All %s\n", s.capitalized())
+			color.White(strings.Repeat("─", 80) + "\n\n")
+		}
+		s.printPage(items, s.count-len(items)+1, first)
+		return nil
+	}
+}
+
+// finish completes the output once every page has been written
+func (s *listStream[T]) finish() error {
+	s.stop()
+
+	switch s.format {
+	case output.FormatJSON:
+		if s.items == nil {
+			s.items = []T{}
+		}
+		return s.writer.WriteJSON(s.items)
+
+	case output.FormatCSV:
+		if s.count == 0 {
+			return s.writer.WriteCSVHeader(s.headers)
+		}
+		return nil
+
+	default:
+		// Table format (default)
+		if s.count == 0 {
+			color.Yellow("No %s found.\n", s.noun)
+			return nil
+		}
+		fmt.Println()
+		color.White("Fetched %d %s.\n", s.count, s.noun)
+		return nil
+	}
+}
+
+// stop clears the progress bar, so an error can be printed in its place
+func (s *listStream[T]) stop() {
+	if s.bar != nil {
+		s.bar.Finish()
+	}
+}
+
+func (s *listStream[T]) capitalized() string {
+	return strings.ToUpper(s.noun[:1]) + s.noun[1:]
+}
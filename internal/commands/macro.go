@@ -0,0 +1,317 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"zd-cli/internal/client"
+	"zd-cli/internal/output"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// NewMacroCommand creates the macro management command
+func NewMacroCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "macro",
+		Short: "Manage Zendesk macros",
+		Long:  "List, inspect, and apply Zendesk macros.",
+	}
+
+	cmd.AddCommand(newMacroListCommand())
+	cmd.AddCommand(newMacroShowCommand())
+	cmd.AddCommand(newMacroApplyCommand())
+
+	// Add global output format flag to all subcommands
+	cmd.PersistentFlags().StringP("output", "o", "table", "Output format: table, json, csv")
+
+	return cmd
+}
+
+func newMacroListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List macros",
+		RunE:  withClient(runMacroList),
+	}
+
+	cmd.Flags().Int("page", 1, "Page number")
+	cmd.Flags().Int("per-page", 100, "Results per page (max 100)")
+	cmd.Flags().Bool("active", false, "Only show active macros")
+	cmd.Flags().Bool("refresh", false, "Bypass cache and fetch fresh data")
+
+	return cmd
+}
+
+func newMacroShowCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "show <macro-id>",
+		Short:             "Show detailed information for a specific macro",
+		Args:              cobra.ExactArgs(1),
+		RunE:              withClient(runMacroShow),
+		ValidArgsFunction: completeMacroIDs,
+	}
+
+	cmd.Flags().Bool("refresh", false, "Bypass cache and fetch fresh data")
+
+	return cmd
+}
+
+func newMacroApplyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apply <macro-id> <ticket-id>",
+		Short: "Apply a macro to a ticket",
+		Long: `Apply a macro to a ticket. Use --dry-run to preview the changes
+the macro would make without saving them.`,
+		Args:              cobra.ExactArgs(2),
+		RunE:              withClient(runMacroApply),
+		ValidArgsFunction: completeFirstArg(completeMacroIDs),
+	}
+
+	cmd.Flags().Bool("dry-run", false, "Preview the changes without applying them")
+
+	return cmd
+}
+
+func runMacroList(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	page, _ := cmd.Flags().GetInt("page")
+	perPage, _ := cmd.Flags().GetInt("per-page")
+	activeOnly, _ := cmd.Flags().GetBool("active")
+
+	if perPage > 100 {
+		perPage = 100
+	}
+
+	ctx := context.Background()
+
+	resp, err := zdClient.ListMacros(ctx, page, perPage, activeOnly)
+	if err != nil {
+		return fmt.Errorf("failed to list macros: %w", err)
+	}
+
+	if len(resp.Macros) == 0 {
+		color.Yellow("No macros found.\n")
+		return nil
+	}
+
+	return outputMacros(cmd, resp.Macros, page, resp.Count, resp.NextPage)
+}
+
+func runMacroShow(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	macroID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid macro ID: %s", args[0])
+	}
+
+	ctx := context.Background()
+
+	macro, err := zdClient.GetMacro(ctx, macroID)
+	if err != nil {
+		return fmt.Errorf("failed to get macro: %w", err)
+	}
+
+	return outputMacro(cmd, macro)
+}
+
+func runMacroApply(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	macroID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid macro ID: %s", args[0])
+	}
+
+	ticketID, err := strconv.ParseInt(args[1], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid ticket ID: %s", args[1])
+	}
+
+	ctx := context.Background()
+
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	if dryRun {
+		result, err := zdClient.PreviewMacro(ctx, macroID, ticketID)
+		if err != nil {
+			return fmt.Errorf("failed to preview macro: %w", err)
+		}
+		return outputMacroResult(cmd, result, ticketID)
+	}
+
+	ticket, err := zdClient.ApplyMacro(ctx, macroID, ticketID)
+	if err != nil {
+		return fmt.Errorf("failed to apply macro: %w", err)
+	}
+
+	color.Green("✓ Applied macro %d to ticket #%d\n", macroID, ticket.ID)
+	displayTicket(ticket, false)
+
+	return nil
+}
+
+// outputMacro outputs a single macro in the requested format
+func outputMacro(cmd *cobra.Command, macro *client.Macro) error {
+	headers := []string{"id", "title", "active", "description", "created_at", "updated_at"}
+	return writeOutput(cmd, macro, headers, func() {
+		displayMacro(macro)
+	})
+}
+
+// outputMacros outputs multiple macros in the requested format
+func outputMacros(cmd *cobra.Command, macros []client.Macro, page, total int, nextPage string) error {
+	headers := []string{"id", "title", "active", "description", "position", "created_at", "updated_at"}
+	return writeOutput(cmd, macros, headers, func() {
+		if page > 0 {
+			color.Cyan("Macros (Page %d, showing %d of %d total)\n", page, len(macros), total)
+		} else {
+			color.Cyan("Found %d macro(s)\n", len(macros))
+		}
+		color.White(strings.Repeat("─", 80) + "\n\n")
+
+		for i, macro := range macros {
+			displayMacroSummary(&macro, i+1)
+		}
+
+		// Show pagination info
+		if nextPage != "" {
+			fmt.Println()
+			color.White("More results available. Use --page %d to see next page.\n", page+1)
+		}
+	})
+}
+
+// outputMacroResult outputs a macro preview in the requested format
+func outputMacroResult(cmd *cobra.Command, result *client.MacroResult, ticketID int64) error {
+	format, _ := cmd.Flags().GetString("output")
+	writer := output.NewWriter(output.Format(format))
+
+	if output.Format(format) == output.FormatJSON {
+		return writer.WriteJSON(result)
+	}
+
+	color.Cyan("Macro preview for ticket #%d (not applied)\n", ticketID)
+	color.White(strings.Repeat("─", 80) + "\n")
+
+	keys := make([]string, 0, len(result.Ticket))
+	for key := range result.Ticket {
+		if key == "id" || key == "url" {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	color.White("Ticket changes:\n")
+	for _, key := range keys {
+		color.White("  %-14s %s\n", key+":", formatMacroValue(result.Ticket[key]))
+	}
+
+	if body, ok := result.Comment["body"].(string); ok && strings.TrimSpace(body) != "" {
+		visibility := "Public"
+		if public, ok := result.Comment["public"].(bool); ok && !public {
+			visibility = color.YellowString("Private")
+		}
+		color.White("\nComment [%s]:\n%s\n", visibility, body)
+	}
+
+	return nil
+}
+
+// Display a macro summary (compact format)
+func displayMacroSummary(macro *client.Macro, index int) {
+	activeBadge := ""
+	if !macro.Active {
+		activeBadge = " | " + color.YellowString("inactive")
+	}
+
+	fmt.Printf("#%-3d %s | ID: %d%s\n",
+		index,
+		color.CyanString(macro.Title),
+		macro.ID,
+		activeBadge)
+}
+
+// Display full macro details
+func displayMacro(macro *client.Macro) {
+	color.Cyan("Macro: %s\n", macro.Title)
+	color.White(strings.Repeat("─", 80) + "\n")
+
+	color.White("ID:           %d\n", macro.ID)
+	if macro.Active {
+		color.Green("Status:       active\n")
+	} else {
+		color.Yellow("Status:       inactive\n")
+	}
+
+	if macro.Description != "" {
+		color.White("Description:  %s\n", macro.Description)
+	}
+
+	// Actions
+	if len(macro.Actions) > 0 {
+		color.White("\nActions:\n")
+		for _, action := range macro.Actions {
+			color.White("  %-20s %s\n", action.Field, formatMacroValue(action.Value))
+		}
+	}
+
+	// Dates
+	color.White("\nDates:\n")
+	color.White("  Created:      %s\n", formatDate(macro.CreatedAt))
+	color.White("  Last Updated: %s\n", formatDate(macro.UpdatedAt))
+
+	color.White("\nURL: %s\n", macro.URL)
+}
+
+// formatMacroValue renders an action or change value on a single line
+func formatMacroValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "(none)"
+	case string:
+		return strings.ReplaceAll(v, "\n", " ")
+	case []interface{}:
+		parts := make([]string, len(v))
+		for i, item := range v {
+			parts[i] = formatMacroValue(item)
+		}
+		return strings.Join(parts, ", ")
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprintf("%v", v)
+		}
+		return string(data)
+	}
+}
+
+// completeMacroIDs completes the IDs of active macros, described by title.
+// Errors are swallowed since completion must never print output.
+func completeMacroIDs(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	zdClient, err := getClientFromFlags(cmd)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var completions []string
+	for page := 1; ; page++ {
+		resp, err := zdClient.ListMacros(ctx, page, 100, true)
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		for _, macro := range resp.Macros {
+			completions = append(completions, fmt.Sprintf("%d\t%s", macro.ID, macro.Title))
+		}
+		if resp.NextPage == "" {
+			break
+		}
+	}
+
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
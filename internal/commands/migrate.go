@@ -0,0 +1,473 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"zd-cli/internal/client"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// migrateResources are the resources migrate can copy, in the order they are
+// copied so the fields and groups rules refer to exist before the rules
+var migrateResources = []string{"ticket_fields", "groups", "macros", "triggers", "automations"}
+
+// recordTitleKeys name the field that identifies a record across instances
+var recordTitleKeys = map[string]string{
+	"groups": "name",
+}
+
+// readOnlyRecordFields are dropped from records before they are created in
+// another instance
+var readOnlyRecordFields = []string{"id", "url", "created_at", "updated_at"}
+
+// customFieldPrefix starts the condition and action field names that refer
+// to a custom ticket field by ID
+const customFieldPrefix = "custom_fields_"
+
+// migrateResult is the outcome of copying one record
+type migrateResult struct {
+	Resource string
+	Title    string
+	Action   string // create, exists, or skip
+	Reason   string
+	Remapped []string
+	NewID    string
+}
+
+// NewMigrateCommand creates the migrate command
+func NewMigrateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Copy business rules and fields from one instance to another",
+		Long: fmt.Sprintf(`Copy ticket fields, groups, macros, triggers, and automations from one
+configured instance to another, for example to promote changes from a
+sandbox to production.
+
+Resources: %s
+
+Records are matched by title (groups by name); records that already exist in
+the target are left alone. Rules refer to custom fields and groups by ID, so
+those IDs, including the groups a macro is restricted to, are remapped to the
+matching field or group in the target. Rules that refer to a field or group
+missing from the target are skipped. User,
+brand, and form IDs aren't remapped; they are listed so you can check them.
+
+Use --dry-run to see what would be created without changing anything. Examples:
+  zd migrate --from staging --to prod --resources macros,triggers --dry-run
+  zd migrate --from staging --to prod --resources ticket_fields,macros,triggers`, strings.Join(migrateResources, ", ")),
+		Args: cobra.NoArgs,
+		RunE: runMigrate,
+	}
+
+	cmd.Flags().String("from", "", "Instance to copy from")
+	cmd.Flags().String("to", "", "Instance to copy to")
+	cmd.Flags().StringSlice("resources", nil, "Resources to copy (comma-separated)")
+	cmd.Flags().Bool("dry-run", false, "Report what would be copied without changing anything")
+	cmd.MarkFlagRequired("from")
+	cmd.MarkFlagRequired("to")
+	cmd.MarkFlagRequired("resources")
+
+	cmd.RegisterFlagCompletionFunc("from", CompleteInstanceNames)
+	cmd.RegisterFlagCompletionFunc("to", CompleteInstanceNames)
+	cmd.RegisterFlagCompletionFunc("resources", completeListFlag(migrateResources))
+
+	return cmd
+}
+
+func runMigrate(cmd *cobra.Command, args []string) error {
+	from, _ := cmd.Flags().GetString("from")
+	to, _ := cmd.Flags().GetString("to")
+	names, _ := cmd.Flags().GetStringSlice("resources")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+	if from == to {
+		return fmt.Errorf("--from and --to must be different instances")
+	}
+
+	// Copy in dependency order, whatever order the flag lists them in
+	selected := make(map[string]bool)
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if !containsString(migrateResources, name) {
+			return fmt.Errorf("unknown resource %q: use %s", name, strings.Join(migrateResources, ", "))
+		}
+		selected[name] = true
+	}
+
+	source, err := getClientForInstance(cmd, from)
+	if err != nil {
+		return err
+	}
+	target, err := getClientForInstance(cmd, to)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := runContext(source, 10*time.Minute)
+	defer cancel()
+
+	// Fields and groups are always read so rules can be remapped, even when
+	// they aren't being copied
+	remap := &idRemapper{fields: make(map[string]string), groups: make(map[string]string)}
+
+	if dryRun {
+		color.Cyan("Migration plan: %s → %s (dry run)\n", from, to)
+	} else {
+		color.Cyan("Migrating %s → %s\n", from, to)
+	}
+	color.White(strings.Repeat("─", 80) + "\n")
+
+	var results []migrateResult
+	for _, name := range migrateResources {
+		isLookup := name == "ticket_fields" || name == "groups"
+		if !selected[name] && !isLookup {
+			continue
+		}
+
+		res, _ := client.FindExportResource(name)
+		sourceRecords, err := fetchRecords(ctx, source, res)
+		if err != nil {
+			return fmt.Errorf("failed to read %s from %s: %w", name, from, err)
+		}
+		targetRecords, err := fetchRecords(ctx, target, res)
+		if err != nil {
+			return fmt.Errorf("failed to read %s from %s: %w", name, to, err)
+		}
+
+		existing := make(map[string]map[string]interface{})
+		for _, record := range targetRecords {
+			existing[recordTitle(name, record)] = record
+		}
+
+		for _, record := range sourceRecords {
+			title := recordTitle(name, record)
+			if name == "ticket_fields" && record["removable"] == false {
+				// System fields exist in every instance
+				if match, ok := existing[title]; ok {
+					remap.add(name, recordID(record), recordID(match))
+				}
+				continue
+			}
+
+			if match, ok := existing[title]; ok {
+				remap.add(name, recordID(record), recordID(match))
+				if selected[name] {
+					results = append(results, migrateResult{Resource: name, Title: title, Action: "exists"})
+				}
+				continue
+			}
+			if !selected[name] {
+				continue
+			}
+
+			result := migrateResult{Resource: name, Title: title, Action: "create"}
+			definition := cleanRecord(record)
+			remapped, missing, notes := remap.apply(definition)
+			result.Remapped = append(remapped, notes...)
+			if len(missing) > 0 {
+				result.Action = "skip"
+				result.Reason = "not in target: " + strings.Join(missing, ", ")
+				results = append(results, result)
+				continue
+			}
+
+			if dryRun {
+				// Later records may refer to this one; assume it maps
+				remap.add(name, recordID(record), "(new)")
+				results = append(results, result)
+				continue
+			}
+
+			created, err := target.CreateRecord(ctx, res, definition)
+			if err != nil {
+				result.Action = "skip"
+				result.Reason = err.Error()
+			} else {
+				result.NewID = recordID(created)
+				remap.add(name, recordID(record), result.NewID)
+			}
+			results = append(results, result)
+		}
+	}
+
+	displayMigrateResults(results, dryRun)
+
+	if !dryRun {
+		for _, result := range results {
+			if result.Action == "skip" {
+				return fmt.Errorf("some records could not be copied")
+			}
+		}
+	}
+
+	return nil
+}
+
+// fetchRecords reads every record of res from an instance in the API's own
+// format, keeping large IDs exact
+func fetchRecords(ctx context.Context, zdClient *client.Client, res client.ExportResource) ([]map[string]interface{}, error) {
+	var records []map[string]interface{}
+	err := zdClient.ExportRecords(ctx, res, 0, "", func(page []json.RawMessage, cursor string) error {
+		for _, raw := range page {
+			record, err := decodeRecord(raw)
+			if err != nil {
+				return err
+			}
+			records = append(records, record)
+		}
+		return nil
+	})
+	return records, err
+}
+
+// decodeRecord decodes a raw record, keeping numbers as json.Number
+func decodeRecord(raw json.RawMessage) (map[string]interface{}, error) {
+	var record map[string]interface{}
+	decoder := json.NewDecoder(strings.NewReader(string(raw)))
+	decoder.UseNumber()
+	if err := decoder.Decode(&record); err != nil {
+		return nil, fmt.Errorf("failed to decode record: %w", err)
+	}
+	return record, nil
+}
+
+// recordTitle returns the title or name that identifies a record
+func recordTitle(resource string, record map[string]interface{}) string {
+	key := recordTitleKeys[resource]
+	if key == "" {
+		key = "title"
+	}
+	title, _ := record[key].(string)
+	return title
+}
+
+// recordID returns a record's ID as a string
+func recordID(record map[string]interface{}) string {
+	if record == nil {
+		return ""
+	}
+	return fmt.Sprint(record["id"])
+}
+
+// cleanRecord copies a record without its read-only fields, including the
+// IDs of dropdown options
+func cleanRecord(record map[string]interface{}) map[string]interface{} {
+	definition := make(map[string]interface{}, len(record))
+	for key, value := range record {
+		definition[key] = value
+	}
+	for _, field := range readOnlyRecordFields {
+		delete(definition, field)
+	}
+
+	if options, ok := definition["custom_field_options"].([]interface{}); ok {
+		cleaned := make([]interface{}, len(options))
+		for i, option := range options {
+			if o, ok := option.(map[string]interface{}); ok {
+				o = cleanRecord(o)
+				delete(o, "default")
+				cleaned[i] = o
+			} else {
+				cleaned[i] = option
+			}
+		}
+		definition["custom_field_options"] = cleaned
+	}
+
+	return definition
+}
+
+// idRemapper maps custom field and group IDs of the source instance to
+// those of the target
+type idRemapper struct {
+	fields map[string]string
+	groups map[string]string
+}
+
+// add records that a source record corresponds to a target record
+func (r *idRemapper) add(resource, sourceID, targetID string) {
+	switch resource {
+	case "ticket_fields":
+		r.fields[sourceID] = targetID
+	case "groups":
+		r.groups[sourceID] = targetID
+	}
+}
+
+// apply rewrites the field and group references in a rule definition's
+// conditions, actions, and restriction. It returns the rewrites made, the
+// references with no match in the target, and references to other IDs that
+// are kept as is.
+func (r *idRemapper) apply(definition map[string]interface{}) (remapped, missing, notes []string) {
+	// group returns the target ID of a source group, or "" if it's missing
+	group := func(id string) string {
+		targetID, found := r.groups[id]
+		if !found {
+			missing = append(missing, "group "+id)
+			return ""
+		}
+		remapped = append(remapped, fmt.Sprintf("group %s → %s", id, targetID))
+		return targetID
+	}
+
+	var items []map[string]interface{}
+	if actions, ok := definition["actions"].([]interface{}); ok {
+		items = append(items, ruleItems(actions)...)
+	}
+	if conditions, ok := definition["conditions"].(map[string]interface{}); ok {
+		for _, key := range []string{"all", "any"} {
+			if list, ok := conditions[key].([]interface{}); ok {
+				items = append(items, ruleItems(list)...)
+			}
+		}
+	}
+
+	for _, item := range items {
+		field, _ := item["field"].(string)
+
+		if id, ok := strings.CutPrefix(field, customFieldPrefix); ok {
+			targetID, found := r.fields[id]
+			if !found {
+				missing = append(missing, "field "+id)
+				continue
+			}
+			if targetID != id && targetID != "(new)" {
+				item["field"] = customFieldPrefix + targetID
+			}
+			remapped = append(remapped, fmt.Sprintf("field %s → %s", id, targetID))
+			continue
+		}
+
+		switch field {
+		case "group_id":
+			id := fmt.Sprint(ruleValue(item["value"]))
+			if _, err := json.Number(id).Int64(); err != nil {
+				// current_groups and similar placeholders need no remapping
+				continue
+			}
+			if targetID := group(id); targetID != "" && targetID != "(new)" {
+				setRuleValue(item, targetID)
+			}
+		case "assignee_id", "requester_id", "brand_id", "ticket_form_id":
+			id := fmt.Sprint(ruleValue(item["value"]))
+			if _, err := json.Number(id).Int64(); err == nil {
+				notes = append(notes, fmt.Sprintf("%s %s kept as is", strings.TrimSuffix(field, "_id"), id))
+			}
+		}
+	}
+
+	// A macro restricted to groups lists them in ids, with the first in id
+	if restriction, ok := definition["restriction"].(map[string]interface{}); ok {
+		switch restriction["type"] {
+		case "Group":
+			targets := make(map[string]string)
+			remapRestricted := func(value interface{}) interface{} {
+				id := fmt.Sprint(value)
+				targetID, seen := targets[id]
+				if !seen {
+					targetID = group(id)
+					targets[id] = targetID
+				}
+				if targetID == "" || targetID == "(new)" {
+					return value
+				}
+				return json.Number(targetID)
+			}
+			if ids, ok := restriction["ids"].([]interface{}); ok {
+				for i, id := range ids {
+					ids[i] = remapRestricted(id)
+				}
+			}
+			if id, ok := restriction["id"]; ok && id != nil {
+				restriction["id"] = remapRestricted(id)
+			}
+		case "User":
+			notes = append(notes, fmt.Sprintf("restricted to user %v, kept as is", restriction["id"]))
+		}
+	}
+
+	sort.Strings(missing)
+	return remapped, missing, notes
+}
+
+// ruleItems returns the objects in a list of conditions or actions
+func ruleItems(list []interface{}) []map[string]interface{} {
+	var items []map[string]interface{}
+	for _, entry := range list {
+		if item, ok := entry.(map[string]interface{}); ok {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// ruleValue returns the value of a condition or action; macro actions hold
+// some values in one-element lists
+func ruleValue(value interface{}) interface{} {
+	if list, ok := value.([]interface{}); ok && len(list) == 1 {
+		return list[0]
+	}
+	return value
+}
+
+// setRuleValue replaces the value of a condition or action, keeping its shape
+func setRuleValue(item map[string]interface{}, value string) {
+	if list, ok := item["value"].([]interface{}); ok && len(list) == 1 {
+		item["value"] = []interface{}{value}
+		return
+	}
+	item["value"] = value
+}
+
+// displayMigrateResults prints what was (or would be) copied
+func displayMigrateResults(results []migrateResult, dryRun bool) {
+	counts := make(map[string]int)
+	for _, result := range results {
+		counts[result.Action]++
+
+		switch result.Action {
+		case "create":
+			line := fmt.Sprintf("  + %s: %s", result.Resource, result.Title)
+			if result.NewID != "" {
+				line += fmt.Sprintf(" (ID: %s)", result.NewID)
+			}
+			color.Green("%s\n", line)
+			for _, change := range result.Remapped {
+				color.White("      %s\n", change)
+			}
+		case "exists":
+			color.White("  = %s: %s (already exists)\n", result.Resource, result.Title)
+		case "skip":
+			color.Red("  ✗ %s: %s (%s)\n", result.Resource, result.Title, result.Reason)
+		}
+	}
+
+	if len(results) == 0 {
+		color.Yellow("Nothing to copy.\n")
+		return
+	}
+
+	fmt.Println()
+	verb := "Created"
+	if dryRun {
+		verb = "Would create"
+	}
+	color.White("%s %d, %d already exist, %d skipped\n", verb, counts["create"], counts["exists"], counts["skip"])
+}
+
+// containsString reports whether list contains s
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
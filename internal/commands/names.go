@@ -0,0 +1,262 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"zd-cli/internal/client"
+
+	"github.com/spf13/cobra"
+)
+
+// names resolves user, organization, and group IDs to display names for
+// table output. It is set up by getClientFromFlags; when it is nil (with
+// --no-resolve, or before a client exists) IDs are shown as-is.
+var names *nameResolver
+
+// nameResolver looks up and remembers display names, fetching them in
+// batches with the show_many endpoints where the API offers them
+type nameResolver struct {
+	client *client.Client
+	users  map[int64]string
+	orgs   map[int64]string
+	groups map[int64]string
+	roles  map[int64]string
+}
+
+// setNameResolver sets up name resolution for zdClient unless --no-resolve is given
+func setNameResolver(cmd *cobra.Command, zdClient *client.Client) {
+	if noResolve, _ := cmd.Flags().GetBool("no-resolve"); noResolve {
+		names = nil
+		return
+	}
+
+	names = &nameResolver{
+		client: zdClient,
+		users:  make(map[int64]string),
+		orgs:   make(map[int64]string),
+		groups: make(map[int64]string),
+		roles:  make(map[int64]string),
+	}
+}
+
+// prefetchTickets looks up the names of everyone and everything the tickets
+// refer to, in as few requests as possible. Lookups that fail leave the IDs
+// unresolved.
+func (r *nameResolver) prefetchTickets(tickets []client.Ticket) {
+	if r == nil {
+		return
+	}
+
+	var userIDs, orgIDs, groupIDs []int64
+	for _, ticket := range tickets {
+		userIDs = append(userIDs, ticket.RequesterID, ticket.SubmitterID)
+		if ticket.AssigneeID != nil {
+			userIDs = append(userIDs, *ticket.AssigneeID)
+		}
+		if ticket.OrganizationID != nil {
+			orgIDs = append(orgIDs, *ticket.OrganizationID)
+		}
+		if ticket.GroupID != nil {
+			groupIDs = append(groupIDs, *ticket.GroupID)
+		}
+	}
+
+	r.prefetchUsers(userIDs)
+	r.prefetchOrganizations(orgIDs)
+	r.prefetchGroups(groupIDs)
+}
+
+// addSideloads remembers the names of records sideloaded with an API
+// response, so they need no lookup of their own
+func (r *nameResolver) addSideloads(sideloads client.Sideloads) {
+	if r == nil {
+		return
+	}
+
+	for _, user := range sideloads.Users {
+		r.users[user.ID] = user.Name
+	}
+	for _, org := range sideloads.Organizations {
+		r.orgs[org.ID] = org.Name
+	}
+	for _, group := range sideloads.Groups {
+		r.groups[group.ID] = group.Name
+	}
+}
+
+// sideloads returns what to include= with ticket requests so names can be
+// shown without extra lookups, or nil when names aren't needed
+func (r *nameResolver) sideloads(needed bool) []string {
+	if r == nil || !needed {
+		return nil
+	}
+	return client.TicketSideloads
+}
+
+// prefetchUsers looks up the names of users not seen yet
+func (r *nameResolver) prefetchUsers(userIDs []int64) {
+	if r == nil {
+		return
+	}
+
+	missing := unresolved(r.users, userIDs)
+	if len(missing) == 0 {
+		return
+	}
+	markAttempted(r.users, missing)
+
+	ctx := context.Background()
+
+	users, err := r.client.GetUsersByIDs(ctx, missing)
+	if err != nil {
+		return
+	}
+	for _, user := range users {
+		r.users[user.ID] = user.Name
+	}
+}
+
+// prefetchOrganizations looks up the names of organizations not seen yet
+func (r *nameResolver) prefetchOrganizations(orgIDs []int64) {
+	if r == nil {
+		return
+	}
+
+	missing := unresolved(r.orgs, orgIDs)
+	if len(missing) == 0 {
+		return
+	}
+	markAttempted(r.orgs, missing)
+
+	ctx := context.Background()
+
+	orgs, err := r.client.GetOrganizationsByIDs(ctx, missing)
+	if err != nil {
+		return
+	}
+	for _, org := range orgs {
+		r.orgs[org.ID] = org.Name
+	}
+}
+
+// prefetchGroups looks up the names of groups not seen yet. Groups have no
+// show_many endpoint, but there are few of them and each lookup is cached.
+func (r *nameResolver) prefetchGroups(groupIDs []int64) {
+	if r == nil {
+		return
+	}
+
+	missing := unresolved(r.groups, groupIDs)
+	if len(missing) == 0 {
+		return
+	}
+	markAttempted(r.groups, missing)
+
+	ctx := context.Background()
+
+	for _, groupID := range missing {
+		group, err := r.client.GetGroup(ctx, groupID)
+		if err != nil {
+			continue
+		}
+		r.groups[groupID] = group.Name
+	}
+}
+
+// prefetchRoles looks up the names of custom roles not seen yet. One
+// request lists every role, so all of them are remembered at once.
+func (r *nameResolver) prefetchRoles(roleIDs []int64) {
+	if r == nil {
+		return
+	}
+
+	missing := unresolved(r.roles, roleIDs)
+	if len(missing) == 0 {
+		return
+	}
+	markAttempted(r.roles, missing)
+
+	ctx := context.Background()
+
+	roles, err := r.client.ListCustomRoles(ctx)
+	if err != nil {
+		return
+	}
+	for _, role := range roles {
+		r.roles[role.ID] = role.Name
+	}
+}
+
+// userName returns the user's name, or their ID when it isn't known
+func (r *nameResolver) userName(userID int64) string {
+	if r == nil {
+		return fmt.Sprintf("%d", userID)
+	}
+	return nameOrID(r.users, userID)
+}
+
+// orgName returns the organization's name, or its ID when it isn't known
+func (r *nameResolver) orgName(orgID int64) string {
+	if r == nil {
+		return fmt.Sprintf("%d", orgID)
+	}
+	return nameOrID(r.orgs, orgID)
+}
+
+// groupName returns the group's name, or its ID when it isn't known
+func (r *nameResolver) groupName(groupID int64) string {
+	if r == nil {
+		return fmt.Sprintf("%d", groupID)
+	}
+	return nameOrID(r.groups, groupID)
+}
+
+// roleName returns the custom role's name, or its ID when it isn't known
+func (r *nameResolver) roleName(roleID int64) string {
+	if r == nil {
+		return fmt.Sprintf("%d", roleID)
+	}
+	return nameOrID(r.roles, roleID)
+}
+
+// withID formats a resolved name together with its ID, e.g. "Jane Doe (123)".
+// Unresolved names are just the ID.
+func withID(name string, id int64) string {
+	if name == fmt.Sprintf("%d", id) {
+		return name
+	}
+	return fmt.Sprintf("%s (%d)", name, id)
+}
+
+// nameOrID returns the known name for id, or the ID itself
+func nameOrID(known map[int64]string, id int64) string {
+	if name := known[id]; name != "" {
+		return name
+	}
+	return fmt.Sprintf("%d", id)
+}
+
+// markAttempted records ids as looked up, so IDs that can't be resolved
+// aren't requested again
+func markAttempted(known map[int64]string, ids []int64) {
+	for _, id := range ids {
+		known[id] = ""
+	}
+}
+
+// unresolved returns the IDs that haven't been looked up yet
+func unresolved(known map[int64]string, ids []int64) []int64 {
+	seen := make(map[int64]bool)
+	var missing []int64
+	for _, id := range ids {
+		if id == 0 || seen[id] {
+			continue
+		}
+		seen[id] = true
+		if _, ok := known[id]; !ok {
+			missing = append(missing, id)
+		}
+	}
+	return missing
+}
@@ -0,0 +1,456 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	"zd-cli/internal/client"
+	"zd-cli/internal/config"
+	"zd-cli/internal/output"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// notifyTimeout is how long a hook's command or webhook may take
+const notifyTimeout = 30 * time.Second
+
+// notifyFilterSep separates a hook's filter expressions in the config file.
+// The INI parser treats '#' and ';' as comments.
+const notifyFilterSep = " and "
+
+// NewNotifyCommand creates the notify command
+func NewNotifyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "notify",
+		Short: "Manage notification hooks for tail and ticket watch",
+		Long: `Manage hooks that run a command or POST to a webhook, such as a Slack
+incoming webhook, when 'zd tail' or 'zd ticket watch' sees a matching
+ticket. Hooks are stored in the config file as [notify "name"] sections, and
+both commands run every hook unless given --no-notify.
+
+A command runs in the shell with the ticket in environment variables
+(ZD_EVENT, ZD_TICKET_ID, ZD_TICKET_SUBJECT, ZD_TICKET_STATUS,
+ZD_TICKET_PRIORITY, ZD_TICKET_URL, ZD_CHANGES, ZD_INSTANCE) and the full JSON
+payload on stdin. A webhook receives the same payload, whose "text" field
+makes it readable in Slack. Examples:
+  zd notify add urgent --filter priority=urgent --filter event=create \
+    --webhook https://hooks.slack.com/services/T000/B000/XXXX
+  zd notify add desktop --filter assignee=none --command 'notify-send "$ZD_TICKET_SUBJECT"'
+  zd notify test urgent`,
+	}
+
+	cmd.AddCommand(newNotifyAddCommand())
+	cmd.AddCommand(newNotifyListCommand())
+	cmd.AddCommand(newNotifyDeleteCommand())
+	cmd.AddCommand(newNotifyTestCommand())
+
+	return cmd
+}
+
+func newNotifyAddCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add <name>",
+		Short: "Create or replace a hook",
+		Args:  cobra.ExactArgs(1),
+		RunE:  runNotifyAdd,
+	}
+
+	cmd.Flags().StringArray("filter", nil, "Only notify for tickets matching this expression, as in 'zd tail' (repeatable)")
+	cmd.Flags().String("command", "", "Command to run")
+	cmd.Flags().String("webhook", "", "URL to POST the JSON payload to")
+
+	cmd.RegisterFlagCompletionFunc("filter", cobra.NoFileCompletions)
+
+	return cmd
+}
+
+func newNotifyListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List hooks",
+		Args:    cobra.NoArgs,
+		RunE:    runNotifyList,
+	}
+
+	cmd.Flags().StringP("output", "o", "table", "Output format: table, json")
+
+	return cmd
+}
+
+func newNotifyDeleteCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:               "delete <name>",
+		Aliases:           []string{"rm"},
+		Short:             "Delete a hook",
+		Args:              cobra.ExactArgs(1),
+		RunE:              runNotifyDelete,
+		ValidArgsFunction: completeNotifyHookNames,
+	}
+}
+
+func newNotifyTestCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:               "test <name>",
+		Short:             "Fire a hook with a sample ticket",
+		Long:              "Fire a hook with a sample ticket, ignoring its filter, to check that the command or webhook works.",
+		Args:              cobra.ExactArgs(1),
+		RunE:              withClient(runNotifyTest),
+		ValidArgsFunction: completeNotifyHookNames,
+	}
+}
+
+func runNotifyAdd(cmd *cobra.Command, args []string) error {
+	name := args[0]
+	if name == "" || strings.ContainsAny(name, "\"]") {
+		return fmt.Errorf("invalid hook name %q", name)
+	}
+
+	exprs, _ := cmd.Flags().GetStringArray("filter")
+	if _, err := parseTicketFilters(exprs); err != nil {
+		return err
+	}
+	for _, expr := range exprs {
+		if strings.Contains(expr, notifyFilterSep) || strings.ContainsAny(expr, "#;") {
+			return fmt.Errorf("invalid filter %q: hook filters can't contain %q, '#', or ';'", expr, notifyFilterSep)
+		}
+	}
+
+	hook := &config.NotifyHook{Name: name, Filter: strings.Join(exprs, notifyFilterSep)}
+	hook.Command, _ = cmd.Flags().GetString("command")
+	hook.Webhook, _ = cmd.Flags().GetString("webhook")
+	if hook.Command == "" && hook.Webhook == "" {
+		return fmt.Errorf("give --command, --webhook, or both")
+	}
+	if hook.Webhook != "" && !strings.HasPrefix(hook.Webhook, "https://") && !strings.HasPrefix(hook.Webhook, "http://") {
+		return fmt.Errorf("invalid webhook %q: use an http:// or https:// URL", hook.Webhook)
+	}
+
+	cfg, err := loadOrCreateConfig(cmd)
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	_, replaced := cfg.Notify[name]
+	cfg.Notify[name] = hook
+
+	if err := saveConfig(cmd, cfg); err != nil {
+		return fmt.Errorf("failed to save configuration: %w", err)
+	}
+
+	if replaced {
+		color.Green("✓ Replaced hook %s\n", name)
+	} else {
+		color.Green("✓ Added hook %s\n", name)
+	}
+	color.White("Use 'zd notify test %s' to try it.\n", name)
+
+	return nil
+}
+
+func runNotifyList(cmd *cobra.Command, args []string) error {
+	cfg, err := loadOrCreateConfig(cmd)
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	hooks := sortedNotifyHooks(cfg)
+
+	format, _ := cmd.Flags().GetString("output")
+	if output.Format(format) == output.FormatJSON {
+		entries := []map[string]string{}
+		for _, hook := range hooks {
+			entries = append(entries, map[string]string{
+				"name":    hook.Name,
+				"filter":  hook.Filter,
+				"command": hook.Command,
+				"webhook": hook.Webhook,
+			})
+		}
+		return output.NewWriter(output.FormatJSON).WriteJSON(entries)
+	}
+
+	if len(hooks) == 0 {
+		color.Yellow("No hooks. Use 'zd notify add <name>' to add one.\n")
+		return nil
+	}
+
+	color.Cyan("Notification hooks (%d)\n", len(hooks))
+	color.White(strings.Repeat("─", 80) + "\n")
+
+	table := output.NewTable("NAME", "FILTER", "ACTION")
+	table.SetFlexColumn(2)
+	for _, hook := range hooks {
+		var actions []string
+		if hook.Command != "" {
+			actions = append(actions, "run: "+hook.Command)
+		}
+		if hook.Webhook != "" {
+			actions = append(actions, "post: "+hook.Webhook)
+		}
+		filter := hook.Filter
+		if filter == "" {
+			filter = "every ticket"
+		}
+		table.AddRow(hook.Name, filter, strings.Join(actions, "; "))
+	}
+	table.Print()
+
+	return nil
+}
+
+func runNotifyDelete(cmd *cobra.Command, args []string) error {
+	name := args[0]
+
+	cfg, err := loadConfig(cmd)
+	if err == config.ErrConfigNotFound {
+		return fmt.Errorf("hook %q not found", name)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	if _, ok := cfg.Notify[name]; !ok {
+		return fmt.Errorf("hook %q not found", name)
+	}
+	delete(cfg.Notify, name)
+
+	if err := saveConfig(cmd, cfg); err != nil {
+		return fmt.Errorf("failed to save configuration: %w", err)
+	}
+
+	color.Green("✓ Deleted hook %s\n", name)
+
+	return nil
+}
+
+func runNotifyTest(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	cfg, err := loadConfig(cmd)
+	if err == config.ErrConfigNotFound {
+		return fmt.Errorf("hook %q not found", args[0])
+	}
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	hook, ok := cfg.Notify[args[0]]
+	if !ok {
+		return fmt.Errorf("hook %q not found", args[0])
+	}
+
+	sample := &tailEntry{
+		Time:     time.Now().UTC().Format(time.RFC3339),
+		Event:    "create",
+		TicketID: 12345,
+		Via:      "Web form",
+		Changes:  []string{},
+		Ticket: &client.Ticket{
+			ID:       12345,
+			Subject:  "Test notification from zd",
+			Status:   "new",
+			Priority: "urgent",
+		},
+	}
+
+	n := &notifier{client: zdClient}
+	if err := n.fire(context.Background(), hook, sample); err != nil {
+		return fmt.Errorf("hook %s failed: %w", hook.Name, err)
+	}
+
+	color.Green("✓ Hook %s fired\n", hook.Name)
+
+	return nil
+}
+
+// notifier fires the configured hooks for tickets seen by tail or watch
+type notifier struct {
+	client  *client.Client
+	hooks   []*config.NotifyHook
+	filters map[string][]ticketFilter
+}
+
+// addNotifyFlag adds --no-notify to a command that fires hooks
+func addNotifyFlag(cmd *cobra.Command) {
+	cmd.Flags().Bool("no-notify", false, "Don't run notification hooks")
+}
+
+// newNotifier loads the hooks from the config file, returning nil when there
+// are none or --no-notify is set
+func newNotifier(cmd *cobra.Command, zdClient *client.Client) (*notifier, error) {
+	if off, _ := cmd.Flags().GetBool("no-notify"); off {
+		return nil, nil
+	}
+
+	cfg, err := loadConfig(cmd)
+	if err != nil || len(cfg.Notify) == 0 {
+		return nil, nil
+	}
+
+	n := &notifier{client: zdClient, filters: make(map[string][]ticketFilter)}
+
+	for _, hook := range sortedNotifyHooks(cfg) {
+		var exprs []string
+		if hook.Filter != "" {
+			exprs = strings.Split(hook.Filter, notifyFilterSep)
+		}
+		filters, err := parseTicketFilters(exprs)
+		if err != nil {
+			return nil, fmt.Errorf("notify hook %s: %w", hook.Name, err)
+		}
+		n.hooks = append(n.hooks, hook)
+		n.filters[hook.Name] = filters
+	}
+
+	return n, nil
+}
+
+// describe prints which hooks will fire, for the header of tail and watch
+func (n *notifier) describe() string {
+	if n == nil {
+		return ""
+	}
+	names := make([]string, len(n.hooks))
+	for i, hook := range n.hooks {
+		names[i] = hook.Name
+	}
+	return strings.Join(names, ", ")
+}
+
+// notify fires every hook whose filter matches entry. Failures are printed
+// and never stop the caller.
+func (n *notifier) notify(ctx context.Context, entry *tailEntry) {
+	if n == nil {
+		return
+	}
+	for _, hook := range n.hooks {
+		if !matchTicketFilters(n.filters[hook.Name], entry.Ticket, entry.Event) {
+			continue
+		}
+		if err := n.fire(ctx, hook, entry); err != nil && ctx.Err() == nil {
+			color.New(color.FgRed).Fprintf(os.Stderr, "✗ Notify hook %s failed: %v\n", hook.Name, err)
+		}
+	}
+}
+
+// fire runs one hook's command and webhook for entry
+func (n *notifier) fire(ctx context.Context, hook *config.NotifyHook, entry *tailEntry) error {
+	ctx, cancel := context.WithTimeout(ctx, notifyTimeout)
+	defer cancel()
+
+	ticket := entry.Ticket
+	url := n.client.AgentURL("tickets", ticket.ID)
+	changes := strings.Join(entry.Changes, "; ")
+
+	verb := "updated"
+	if entry.Event == "create" {
+		verb = "created"
+	}
+	text := fmt.Sprintf("Ticket #%d %s: %s (%s", ticket.ID, verb, ticket.Subject, ticket.Status)
+	if ticket.Priority != "" {
+		text += ", " + ticket.Priority
+	}
+	text += ")"
+	if changes != "" {
+		text += " · " + changes
+	}
+	text += "\n" + url
+
+	payload, err := json.Marshal(map[string]interface{}{
+		"text":     text,
+		"instance": n.client.Subdomain(),
+		"event":    entry.Event,
+		"time":     entry.Time,
+		"url":      url,
+		"changes":  entry.Changes,
+		"ticket":   ticket,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode payload: %w", err)
+	}
+
+	if hook.Command != "" {
+		shell, flag := "sh", "-c"
+		if runtime.GOOS == "windows" {
+			shell, flag = "cmd", "/C"
+		}
+		command := exec.CommandContext(ctx, shell, flag, hook.Command)
+		command.Env = append(os.Environ(),
+			"ZD_EVENT="+entry.Event,
+			fmt.Sprintf("ZD_TICKET_ID=%d", ticket.ID),
+			"ZD_TICKET_SUBJECT="+ticket.Subject,
+			"ZD_TICKET_STATUS="+ticket.Status,
+			"ZD_TICKET_PRIORITY="+ticket.Priority,
+			"ZD_TICKET_URL="+url,
+			"ZD_CHANGES="+changes,
+			"ZD_INSTANCE="+n.client.Subdomain(),
+		)
+		command.Stdin = bytes.NewReader(payload)
+		command.Stdout = os.Stderr
+		command.Stderr = os.Stderr
+		if err := command.Run(); err != nil {
+			return fmt.Errorf("command failed: %w", err)
+		}
+	}
+
+	if hook.Webhook != "" {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.Webhook, bytes.NewReader(payload))
+		if err != nil {
+			return fmt.Errorf("failed to create webhook request: %w", err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return fmt.Errorf("webhook failed: %w", err)
+		}
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		resp.Body.Close()
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			return fmt.Errorf("webhook answered %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+		}
+	}
+
+	return nil
+}
+
+// sortedNotifyHooks returns the configured hooks sorted by name
+func sortedNotifyHooks(cfg *config.Config) []*config.NotifyHook {
+	hooks := make([]*config.NotifyHook, 0, len(cfg.Notify))
+	for _, hook := range cfg.Notify {
+		hooks = append(hooks, hook)
+	}
+	sort.Slice(hooks, func(i, j int) bool { return hooks[i].Name < hooks[j].Name })
+	return hooks
+}
+
+// completeNotifyHookNames completes the names of configured hooks
+func completeNotifyHookNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	cfg, err := loadConfig(cmd)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	var names []string
+	for _, hook := range sortedNotifyHooks(cfg) {
+		if strings.HasPrefix(hook.Name, toComplete) {
+			names = append(names, hook.Name)
+		}
+	}
+
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
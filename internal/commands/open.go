@@ -0,0 +1,98 @@
+package commands
+
+import (
+	"fmt"
+	"strconv"
+
+	"zd-cli/internal/auth"
+	"zd-cli/internal/client"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// NewOpenCommand creates the open command, which opens whatever a Zendesk
+// link points to
+func NewOpenCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "open <link>",
+		Short: "Open the ticket, user, or organization a Zendesk link points to",
+		Long: `Open the agent interface page of the ticket, user, or organization a
+Zendesk link points to. Agent interface, API, and help center request links
+all work, and the link's instance is used unless --instance names another.`,
+		Example: `  zd open https://acme.zendesk.com/api/v2/tickets/123.json
+  zd open acme.zendesk.com/hc/en-us/requests/123`,
+		Args: cobra.ExactArgs(1),
+		RunE: withClient(runOpenLink),
+	}
+
+	return cmd
+}
+
+// runOpenLink opens the resource a link points to. The link has already
+// picked the instance, through ApplyResourceLinks.
+func runOpenLink(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	_, resource, id, err := parseResourceLink(args[0])
+	if err != nil {
+		return err
+	}
+
+	return runOpen(zdClient, resource, linkNouns[resource], strconv.FormatInt(id, 10))
+}
+
+func newTicketOpenCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "open <ticket-id>",
+		Short: "Open a ticket in the Zendesk agent interface",
+		Args:  cobra.ExactArgs(1),
+		RunE: withClient(func(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+			return runOpen(zdClient, "tickets", "ticket", args[0])
+		}),
+	}
+
+	return cmd
+}
+
+func newUserOpenCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "open <user-id>",
+		Short: "Open a user's profile in the Zendesk agent interface",
+		Args:  cobra.ExactArgs(1),
+		RunE: withClient(func(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+			return runOpen(zdClient, "users", "user", args[0])
+		}),
+	}
+
+	return cmd
+}
+
+func newOrgOpenCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "open <org-id>",
+		Short: "Open an organization in the Zendesk agent interface",
+		Args:  cobra.ExactArgs(1),
+		RunE: withClient(func(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+			return runOpen(zdClient, "organizations", "organization", args[0])
+		}),
+	}
+
+	return cmd
+}
+
+// runOpen opens the agent interface page of a resource in the default
+// browser, printing the URL so it can be opened by hand if that fails
+func runOpen(zdClient *client.Client, resource, noun, arg string) error {
+	id, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid %s ID: %s", noun, arg)
+	}
+
+	url := zdClient.AgentURL(resource, id)
+	color.White("%s\n", url)
+
+	if err := auth.OpenBrowser(url); err != nil {
+		return fmt.Errorf("failed to open browser: %w", err)
+	}
+
+	return nil
+}
@@ -8,26 +8,33 @@ import (
 	"time"
 
 	"zd-cli/internal/client"
-	"zd-cli/internal/output"
 
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 )
 
+// orgListHeaders are the CSV columns for organization lists
+var orgListHeaders = []string{"id", "name", "created_at", "updated_at", "shared_tickets", "shared_comments", "group_id"}
+
 // NewOrganizationCommand creates the organization management command
 func NewOrganizationCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "org",
 		Aliases: []string{"organization"},
 		Short:   "Manage Zendesk organizations",
-		Long:    "View and search Zendesk organizations.",
+		Long:    "View, search, create, update, and delete Zendesk organizations.",
 	}
 
 	cmd.AddCommand(newOrgListCommand())
 	cmd.AddCommand(newOrgShowCommand())
+	cmd.AddCommand(newOrgOpenCommand())
 	cmd.AddCommand(newOrgSearchCommand())
 	cmd.AddCommand(newOrgUsersCommand())
+	cmd.AddCommand(newOrgAuditDomainsCommand())
 	cmd.AddCommand(newOrgTicketsCommand())
+	cmd.AddCommand(newOrgCreateCommand())
+	cmd.AddCommand(newOrgUpdateCommand())
+	cmd.AddCommand(newOrgDeleteCommand())
 
 	// Add global output format flag to all subcommands
 	cmd.PersistentFlags().StringP("output", "o", "table", "Output format: table, json, csv")
@@ -39,12 +46,13 @@ func newOrgListCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "List all organizations",
-		RunE:  runOrgList,
+		RunE:  withClient(runOrgList),
 	}
 
 	cmd.Flags().Int("page", 1, "Page number")
 	cmd.Flags().Int("per-page", 100, "Results per page (max 100)")
 	cmd.Flags().Bool("refresh", false, "Bypass cache and fetch fresh data")
+	cmd.Flags().Bool("all", false, "Fetch every page using cursor pagination, streaming results as they arrive")
 
 	return cmd
 }
@@ -54,10 +62,11 @@ func newOrgShowCommand() *cobra.Command {
 		Use:   "show <org-id>",
 		Short: "Show detailed information for a specific organization",
 		Args:  cobra.ExactArgs(1),
-		RunE:  runOrgShow,
+		RunE:  withClient(runOrgShow),
 	}
 
 	cmd.Flags().Bool("refresh", false, "Bypass cache and fetch fresh data")
+	addCopyFlag(cmd)
 
 	return cmd
 }
@@ -67,7 +76,7 @@ func newOrgSearchCommand() *cobra.Command {
 		Use:   "search <query>",
 		Short: "Search organizations by name",
 		Args:  cobra.MinimumNArgs(1),
-		RunE:  runOrgSearch,
+		RunE:  withClient(runOrgSearch),
 	}
 
 	cmd.Flags().Bool("refresh", false, "Bypass cache and fetch fresh data")
@@ -80,7 +89,7 @@ func newOrgUsersCommand() *cobra.Command {
 		Use:   "users <org-id>",
 		Short: "List users in an organization",
 		Args:  cobra.ExactArgs(1),
-		RunE:  runOrgUsers,
+		RunE:  withClient(runOrgUsers),
 	}
 
 	cmd.Flags().Int("page", 1, "Page number")
@@ -95,7 +104,7 @@ func newOrgTicketsCommand() *cobra.Command {
 		Use:   "tickets <org-id>",
 		Short: "List tickets for an organization",
 		Args:  cobra.ExactArgs(1),
-		RunE:  runOrgTickets,
+		RunE:  withClient(runOrgTickets),
 	}
 
 	cmd.Flags().Int("page", 1, "Page number")
@@ -105,21 +114,86 @@ func newOrgTicketsCommand() *cobra.Command {
 	return cmd
 }
 
-func runOrgList(cmd *cobra.Command, args []string) error {
-	zdClient, err := getClientFromFlags(cmd)
-	if err != nil {
-		return err
+func newOrgCreateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a new organization",
+		RunE:  withClient(runOrgCreate),
+	}
+
+	cmd.Flags().String("name", "", "Organization name")
+	cmd.Flags().StringSlice("domains", []string{}, "Domain names (comma-separated)")
+	cmd.Flags().StringSlice("tags", []string{}, "Tags (comma-separated)")
+	cmd.Flags().String("details", "", "Details")
+	cmd.Flags().String("notes", "", "Notes")
+	cmd.Flags().Int64("group", 0, "Group ID for new tickets")
+	cmd.Flags().Bool("shared-tickets", false, "Let users see each other's tickets")
+	cmd.Flags().Bool("shared-comments", false, "Let users comment on each other's tickets")
+
+	cmd.RegisterFlagCompletionFunc("tags", completeTags)
+	cmd.RegisterFlagCompletionFunc("group", completeGroupIDs)
+
+	return cmd
+}
+
+func newOrgUpdateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update <org-id>",
+		Short: "Update an organization",
+		Args:  cobra.ExactArgs(1),
+		RunE:  withClient(runOrgUpdate),
 	}
 
+	cmd.Flags().String("name", "", "New name")
+	cmd.Flags().StringSlice("domains", []string{}, "Domain names to set (replaces existing)")
+	cmd.Flags().StringSlice("tags", []string{}, "Tags to set (replaces existing)")
+	cmd.Flags().String("details", "", "New details")
+	cmd.Flags().String("notes", "", "New notes")
+	cmd.Flags().Int64("group", 0, "Group ID for new tickets")
+	cmd.Flags().Bool("shared-tickets", false, "Let users see each other's tickets")
+	cmd.Flags().Bool("shared-comments", false, "Let users comment on each other's tickets")
+
+	cmd.RegisterFlagCompletionFunc("tags", completeTags)
+	cmd.RegisterFlagCompletionFunc("group", completeGroupIDs)
+
+	return cmd
+}
+
+func newOrgDeleteCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete <org-id>",
+		Short: "Delete an organization",
+		Args:  cobra.ExactArgs(1),
+		RunE:  withClient(runOrgDelete),
+	}
+
+	cmd.Flags().Bool("force", false, "Skip confirmation prompt")
+
+	return cmd
+}
+
+func runOrgList(cmd *cobra.Command, args []string, zdClient *client.Client) error {
 	page, _ := cmd.Flags().GetInt("page")
 	perPage, _ := cmd.Flags().GetInt("per-page")
+	all, _ := cmd.Flags().GetBool("all")
 
 	if perPage > 100 {
 		perPage = 100
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
+	if all {
+		ctx, cancel := runContext(zdClient, 30*time.Minute)
+		defer cancel()
+
+		stream := newListStream(cmd, zdClient, "organizations", orgListHeaders, printOrganizationTable)
+		defer stream.stop()
+		if err := zdClient.ListAllOrganizations(ctx, stream.write); err != nil {
+			return fmt.Errorf("failed to list organizations: %w", err)
+		}
+		return stream.finish()
+	}
+
+	ctx := context.Background()
 
 	resp, err := zdClient.ListOrganizations(ctx, page, perPage)
 	if err != nil {
@@ -134,38 +208,30 @@ func runOrgList(cmd *cobra.Command, args []string) error {
 	return outputOrganizations(cmd, resp.Organizations, page, resp.Count, resp.NextPage)
 }
 
-func runOrgShow(cmd *cobra.Command, args []string) error {
-	zdClient, err := getClientFromFlags(cmd)
-	if err != nil {
-		return err
-	}
-
+func runOrgShow(cmd *cobra.Command, args []string, zdClient *client.Client) error {
 	orgID, err := strconv.ParseInt(args[0], 10, 64)
 	if err != nil {
 		return fmt.Errorf("invalid organization ID: %s", args[0])
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
+	ctx := context.Background()
 
 	org, err := zdClient.GetOrganization(ctx, orgID)
 	if err != nil {
 		return fmt.Errorf("failed to get organization: %w", err)
 	}
 
-	return outputOrganization(cmd, org, true)
-}
-
-func runOrgSearch(cmd *cobra.Command, args []string) error {
-	zdClient, err := getClientFromFlags(cmd)
-	if err != nil {
+	if err := outputOrganization(cmd, org, true); err != nil {
 		return err
 	}
 
+	return copyFromFlags(cmd, zdClient.AgentURL("organizations", org.ID), org)
+}
+
+func runOrgSearch(cmd *cobra.Command, args []string, zdClient *client.Client) error {
 	query := strings.Join(args, " ")
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
+	ctx := context.Background()
 
 	orgs, err := zdClient.SearchOrganizations(ctx, query)
 	if err != nil {
@@ -180,12 +246,7 @@ func runOrgSearch(cmd *cobra.Command, args []string) error {
 	return outputOrganizations(cmd, orgs, 0, len(orgs), "")
 }
 
-func runOrgUsers(cmd *cobra.Command, args []string) error {
-	zdClient, err := getClientFromFlags(cmd)
-	if err != nil {
-		return err
-	}
-
+func runOrgUsers(cmd *cobra.Command, args []string, zdClient *client.Client) error {
 	orgID, err := strconv.ParseInt(args[0], 10, 64)
 	if err != nil {
 		return fmt.Errorf("invalid organization ID: %s", args[0])
@@ -198,8 +259,7 @@ func runOrgUsers(cmd *cobra.Command, args []string) error {
 		perPage = 100
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
+	ctx := context.Background()
 
 	resp, err := zdClient.GetOrganizationUsers(ctx, orgID, page, perPage)
 	if err != nil {
@@ -214,12 +274,7 @@ func runOrgUsers(cmd *cobra.Command, args []string) error {
 	return outputUsers(cmd, resp.Users, page, resp.Count, resp.NextPage)
 }
 
-func runOrgTickets(cmd *cobra.Command, args []string) error {
-	zdClient, err := getClientFromFlags(cmd)
-	if err != nil {
-		return err
-	}
-
+func runOrgTickets(cmd *cobra.Command, args []string, zdClient *client.Client) error {
 	orgID, err := strconv.ParseInt(args[0], 10, 64)
 	if err != nil {
 		return fmt.Errorf("invalid organization ID: %s", args[0])
@@ -232,8 +287,7 @@ func runOrgTickets(cmd *cobra.Command, args []string) error {
 		perPage = 100
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
+	ctx := context.Background()
 
 	resp, err := zdClient.GetOrganizationTickets(ctx, orgID, page, perPage)
 	if err != nil {
@@ -248,41 +302,176 @@ func runOrgTickets(cmd *cobra.Command, args []string) error {
 	return outputTickets(cmd, resp.Tickets, page, resp.Count, resp.NextPage)
 }
 
-// outputOrganization outputs a single organization in the requested format
-func outputOrganization(cmd *cobra.Command, org *client.Organization, detailed bool) error {
-	format, _ := cmd.Flags().GetString("output")
-	writer := output.NewWriter(output.Format(format))
+func runOrgCreate(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	// Get flags
+	name, _ := cmd.Flags().GetString("name")
+	domains, _ := cmd.Flags().GetStringSlice("domains")
+	tags, _ := cmd.Flags().GetStringSlice("tags")
+	details, _ := cmd.Flags().GetString("details")
+	notes, _ := cmd.Flags().GetString("notes")
+	groupID, _ := cmd.Flags().GetInt64("group")
+
+	// Interactive prompt if not provided
+	var err error
+	if name == "" {
+		name, err = promptString("Name", true, "--name")
+		if err != nil {
+			return err
+		}
+	}
 
-	switch output.Format(format) {
-	case output.FormatJSON:
-		return writer.WriteJSON(org)
+	// Build request
+	req := client.CreateOrganizationRequest{
+		Name:        name,
+		DomainNames: domains,
+		Details:     details,
+		Notes:       notes,
+		Tags:        tags,
+	}
 
-	case output.FormatCSV:
-		headers := []string{"id", "name", "created_at", "updated_at", "shared_tickets", "shared_comments"}
-		return writer.WriteCSV(org, headers)
+	if groupID > 0 {
+		req.GroupID = &groupID
+	}
+	if cmd.Flags().Changed("shared-tickets") {
+		sharedTickets, _ := cmd.Flags().GetBool("shared-tickets")
+		req.SharedTickets = &sharedTickets
+	}
+	if cmd.Flags().Changed("shared-comments") {
+		sharedComments, _ := cmd.Flags().GetBool("shared-comments")
+		req.SharedComments = &sharedComments
+	}
 
-	default:
-		// Table format (default)
-		displayOrganization(org, detailed)
-		return nil
+	ctx := context.Background()
+
+	org, err := zdClient.CreateOrganization(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed to create organization: %w", err)
 	}
+
+	color.Green("✓ Organization created successfully!\n")
+	color.White("Organization ID: %d\n", org.ID)
+	color.White("Name: %s\n", org.Name)
+
+	return nil
 }
 
-// outputOrganizations outputs multiple organizations in the requested format
-func outputOrganizations(cmd *cobra.Command, orgs []client.Organization, page, total int, nextPage string) error {
-	format, _ := cmd.Flags().GetString("output")
-	writer := output.NewWriter(output.Format(format))
+func runOrgUpdate(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	orgID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid organization ID: %s", args[0])
+	}
+
+	// Build update request from flags
+	req := client.UpdateOrganizationRequest{}
+	updated := false
+
+	if cmd.Flags().Changed("name") {
+		name, _ := cmd.Flags().GetString("name")
+		req.Name = &name
+		updated = true
+	}
+
+	if cmd.Flags().Changed("domains") {
+		domains, _ := cmd.Flags().GetStringSlice("domains")
+		req.DomainNames = &domains
+		updated = true
+	}
+
+	if cmd.Flags().Changed("tags") {
+		tags, _ := cmd.Flags().GetStringSlice("tags")
+		req.Tags = &tags
+		updated = true
+	}
+
+	if cmd.Flags().Changed("details") {
+		details, _ := cmd.Flags().GetString("details")
+		req.Details = &details
+		updated = true
+	}
+
+	if cmd.Flags().Changed("notes") {
+		notes, _ := cmd.Flags().GetString("notes")
+		req.Notes = &notes
+		updated = true
+	}
+
+	if cmd.Flags().Changed("group") {
+		groupID, _ := cmd.Flags().GetInt64("group")
+		req.GroupID = &groupID
+		updated = true
+	}
+
+	if cmd.Flags().Changed("shared-tickets") {
+		sharedTickets, _ := cmd.Flags().GetBool("shared-tickets")
+		req.SharedTickets = &sharedTickets
+		updated = true
+	}
+
+	if cmd.Flags().Changed("shared-comments") {
+		sharedComments, _ := cmd.Flags().GetBool("shared-comments")
+		req.SharedComments = &sharedComments
+		updated = true
+	}
+
+	if !updated {
+		return fmt.Errorf("no updates specified. Use flags like --name, --domains, --tags, etc.")
+	}
+
+	ctx := context.Background()
+
+	org, err := zdClient.UpdateOrganization(ctx, orgID, req)
+	if err != nil {
+		return fmt.Errorf("failed to update organization: %w", err)
+	}
+
+	color.Green("✓ Organization #%d updated successfully!\n", orgID)
+	displayOrganization(org, false)
+
+	return nil
+}
 
-	switch output.Format(format) {
-	case output.FormatJSON:
-		return writer.WriteJSON(orgs)
+func runOrgDelete(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	orgID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid organization ID: %s", args[0])
+	}
+
+	// Confirmation unless --force
+	force, _ := cmd.Flags().GetBool("force")
+	if !force {
+		color.Yellow("WARNING: This will permanently delete organization %d\n", orgID)
+		confirm, err := promptString("Type 'yes' to confirm", true, "--force")
+		if err != nil {
+			return err
+		}
+		if strings.ToLower(confirm) != "yes" {
+			color.Yellow("Deletion cancelled.\n")
+			return nil
+		}
+	}
 
-	case output.FormatCSV:
-		headers := []string{"id", "name", "created_at", "updated_at", "shared_tickets", "shared_comments", "group_id"}
-		return writer.WriteCSV(orgs, headers)
+	ctx := context.Background()
 
-	default:
-		// Table format (default)
+	if err := zdClient.DeleteOrganization(ctx, orgID); err != nil {
+		return fmt.Errorf("failed to delete organization: %w", err)
+	}
+
+	color.Green("✓ Organization #%d deleted\n", orgID)
+
+	return nil
+}
+
+// outputOrganization outputs a single organization in the requested format
+func outputOrganization(cmd *cobra.Command, org *client.Organization, detailed bool) error {
+	headers := []string{"id", "name", "created_at", "updated_at", "shared_tickets", "shared_comments"}
+	return writeOutput(cmd, org, headers, func() {
+		displayOrganization(org, detailed)
+	})
+}
+
+// outputOrganizations outputs multiple organizations in the requested format
+func outputOrganizations(cmd *cobra.Command, orgs []client.Organization, page, total int, nextPage string) error {
+	return writeOutput(cmd, orgs, orgListHeaders, func() {
 		if page > 0 {
 			color.Cyan("Organizations (Page %d, showing %d of %d total)\n", page, len(orgs), total)
 		} else {
@@ -290,35 +479,14 @@ func outputOrganizations(cmd *cobra.Command, orgs []client.Organization, page, t
 		}
 		color.White(strings.Repeat("─", 80) + "\n\n")
 
-		for i, org := range orgs {
-			displayOrganizationSummary(&org, i+1)
-		}
+		printOrganizationTable(orgs, 1, true)
 
 		// Show pagination info
 		if nextPage != "" {
 			fmt.Println()
 			color.White("More results available. Use --page %d to see next page.\n", page+1)
 		}
-
-		return nil
-	}
-}
-
-// Display an organization summary (compact format)
-func displayOrganizationSummary(org *client.Organization, index int) {
-	sharedInfo := ""
-	if org.SharedTickets {
-		sharedInfo += " | shared tickets"
-	}
-	if org.SharedComments {
-		sharedInfo += " | shared comments"
-	}
-
-	fmt.Printf("#%-3d %s | ID: %d%s\n",
-		index,
-		color.CyanString(org.Name),
-		org.ID,
-		sharedInfo)
+	})
 }
 
 // Display full organization details
@@ -0,0 +1,299 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"zd-cli/internal/client"
+	"zd-cli/internal/output"
+	"zd-cli/internal/progress"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// domainAuditRow is one user whose membership doesn't match the
+// organization's domains
+type domainAuditRow struct {
+	Issue  string `json:"issue"`
+	UserID int64  `json:"user_id"`
+	Name   string `json:"name"`
+	Email  string `json:"email"`
+}
+
+// domainAudit is the JSON form of zd org audit-domains
+type domainAudit struct {
+	OrganizationID int64            `json:"organization_id"`
+	Domains        []string         `json:"domains"`
+	Missing        []domainAuditRow `json:"missing"`
+	Outside        []domainAuditRow `json:"outside"`
+}
+
+const (
+	domainIssueMissing = "missing"
+	domainIssueOutside = "outside"
+)
+
+func newOrgAuditDomainsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "audit-domains <org-id>",
+		Short: "Find users whose email domain doesn't match their organization",
+		Long: `Compare an organization's members with its domain names and report:
+
+  missing  end users with an email at one of the domains who aren't members
+  outside  members whose email isn't at any of the domains
+
+--fix corrects both in bulk after confirmation: missing users get the
+organization as their organization, and outside members whose organization
+is this one have it cleared. Members who belong through an additional
+organization membership are reported but left alone. Examples:
+  zd org audit-domains 360001234567
+  zd org audit-domains 360001234567 -o csv > mismatches.csv
+  zd org audit-domains 360001234567 --fix`,
+		Args: cobra.ExactArgs(1),
+		RunE: withClient(runOrgAuditDomains),
+	}
+
+	cmd.Flags().Bool("fix", false, "Add missing users to the organization and remove outside members")
+	cmd.Flags().Bool("force", false, "Skip confirmation prompt")
+
+	return cmd
+}
+
+func runOrgAuditDomains(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	orgID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid organization ID: %s", args[0])
+	}
+
+	fix, _ := cmd.Flags().GetBool("fix")
+	force, _ := cmd.Flags().GetBool("force")
+	format, _ := cmd.Flags().GetString("output")
+	writer := output.NewWriter(output.Format(format))
+	if fix && !writer.IsTable() {
+		return fmt.Errorf("--fix only works with table output")
+	}
+
+	// Reading every member and searching each domain can take a while
+	ctx, cancel := jobContext(zdClient, 10*time.Minute)
+	defer cancel()
+
+	org, err := zdClient.GetOrganization(ctx, orgID)
+	if err != nil {
+		return fmt.Errorf("failed to get organization: %w", err)
+	}
+	if len(org.DomainNames) == 0 {
+		return fmt.Errorf("organization %d has no domain names to check against", orgID)
+	}
+
+	domains := make([]string, len(org.DomainNames))
+	for i, domain := range org.DomainNames {
+		domains[i] = strings.ToLower(domain)
+	}
+
+	var spinner *progress.Spinner
+	if writer.IsTable() {
+		spinner = progress.NewSpinner("Reading organization members...")
+		spinner.Start()
+	}
+
+	members := make(map[int64]client.User)
+	err = zdClient.ListAllOrganizationUsers(ctx, orgID, func(users []client.User) error {
+		for _, user := range users {
+			members[user.ID] = user
+		}
+		return nil
+	})
+	if err != nil {
+		if spinner != nil {
+			spinner.Stop()
+		}
+		return fmt.Errorf("failed to list organization users: %w", err)
+	}
+
+	var missing []client.User
+	for _, domain := range domains {
+		if spinner != nil {
+			spinner.Update(fmt.Sprintf("Searching users at %s...", domain))
+		}
+
+		// Search narrows the candidates; the domain is checked exactly below
+		total, err := zdClient.SearchAll(ctx, fmt.Sprintf("type:user role:end-user email:*@%s", domain), client.SearchOptions{PerPage: 100}, func(page []client.SearchResult) error {
+			for _, result := range page {
+				user := result.User
+				if user == nil || user.Role != "end-user" || emailDomain(user.Email) != domain {
+					continue
+				}
+				if _, ok := members[user.ID]; !ok {
+					missing = append(missing, *user)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			if spinner != nil {
+				spinner.Stop()
+			}
+			return fmt.Errorf("failed to search users at %s: %w", domain, err)
+		}
+		if total > client.MaxSearchResults {
+			fmt.Fprintf(os.Stderr, "Warning: %d users at %s but search returns at most %d; only those were checked\n", total, domain, client.MaxSearchResults)
+		}
+	}
+	if spinner != nil {
+		spinner.Stop()
+	}
+
+	var outside []client.User
+	for _, user := range members {
+		if !slices.Contains(domains, emailDomain(user.Email)) {
+			outside = append(outside, user)
+		}
+	}
+
+	sort.Slice(missing, func(i, j int) bool { return missing[i].ID < missing[j].ID })
+	sort.Slice(outside, func(i, j int) bool { return outside[i].ID < outside[j].ID })
+
+	audit := domainAudit{
+		OrganizationID: orgID,
+		Domains:        domains,
+		Missing:        domainAuditRows(domainIssueMissing, missing),
+		Outside:        domainAuditRows(domainIssueOutside, outside),
+	}
+
+	switch {
+	case writer.IsJSON():
+		if err := writer.WriteJSON(audit); err != nil {
+			return err
+		}
+	case writer.IsCSV():
+		if err := writer.WriteCSV(append(audit.Missing, audit.Outside...), []string{"issue", "user_id", "name", "email"}); err != nil {
+			return err
+		}
+	default:
+		displayDomainAudit(org, audit)
+	}
+
+	if !fix || len(missing)+len(outside) == 0 {
+		return nil
+	}
+
+	// Only members whose organization is this one can be removed by clearing
+	// it; the others belong through an additional membership
+	var removable []int64
+	for _, user := range outside {
+		if user.OrganizationID != nil && *user.OrganizationID == orgID {
+			removable = append(removable, user.ID)
+		}
+	}
+	addable := make([]int64, len(missing))
+	for i, user := range missing {
+		addable[i] = user.ID
+	}
+
+	if skipped := len(outside) - len(removable); skipped > 0 {
+		fmt.Fprintf(os.Stderr, "Leaving %d outside member(s) alone: they belong through an additional membership\n", skipped)
+	}
+	if len(addable)+len(removable) == 0 {
+		return nil
+	}
+
+	// Confirmation unless --force
+	if !force {
+		fmt.Println()
+		color.Yellow("WARNING: This will add %d user(s) to %s and remove %d\n", len(addable), org.Name, len(removable))
+		confirm, err := promptString("Type 'yes' to confirm", true, "--force")
+		if err != nil {
+			return err
+		}
+		if strings.ToLower(confirm) != "yes" {
+			color.Yellow("Cancelled.\n")
+			return nil
+		}
+	}
+
+	var results []client.JobStatusResult
+	var batchErr error
+	for _, change := range []struct {
+		ids     []int64
+		orgID   interface{}
+		message string
+	}{
+		{addable, orgID, "Adding users"},
+		{removable, nil, "Removing users"},
+	} {
+		if len(change.ids) == 0 {
+			continue
+		}
+
+		changed, err := runBulkBatches(ctx, zdClient, change.message, change.ids, client.MaxBulkUsers, func(batch []int64) (*client.JobStatus, error) {
+			return zdClient.UpdateManyUsers(ctx, batch, map[string]interface{}{"organization_id": change.orgID})
+		})
+		results = append(results, changed...)
+		if err != nil {
+			batchErr = err
+			break
+		}
+	}
+	if batchErr != nil && len(results) == 0 {
+		return batchErr
+	}
+
+	// Earlier batches were applied even if a later one failed
+	if err := outputBulkResults(cmd, results, "user"); err != nil {
+		return err
+	}
+	return batchErr
+}
+
+// domainAuditRows turns users into audit rows for an issue
+func domainAuditRows(issue string, users []client.User) []domainAuditRow {
+	rows := make([]domainAuditRow, len(users))
+	for i, user := range users {
+		rows[i] = domainAuditRow{Issue: issue, UserID: user.ID, Name: user.Name, Email: user.Email}
+	}
+	return rows
+}
+
+// displayDomainAudit prints both lists of mismatched users
+func displayDomainAudit(org *client.Organization, audit domainAudit) {
+	color.Cyan("Domain audit: %s (%s)\n", org.Name, strings.Join(audit.Domains, ", "))
+	color.White(strings.Repeat("─", 80) + "\n")
+
+	sections := []struct {
+		title string
+		rows  []domainAuditRow
+	}{
+		{"Users at the organization's domains who aren't members", audit.Missing},
+		{"Members whose email isn't at the organization's domains", audit.Outside},
+	}
+	for _, section := range sections {
+		color.White("\n%s (%d):\n", section.title, len(section.rows))
+		if len(section.rows) == 0 {
+			color.Green("  ✓ None\n")
+			continue
+		}
+
+		table := output.NewTable("ID", "NAME", "EMAIL")
+		table.SetFlexColumn(1)
+		for _, row := range section.rows {
+			table.AddRow(fmt.Sprintf("%d", row.UserID), row.Name, orDash(row.Email))
+		}
+		table.Print()
+	}
+}
+
+// emailDomain returns the lowercased domain of an email address, or "" when
+// there is none
+func emailDomain(email string) string {
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return ""
+	}
+	return strings.ToLower(email[at+1:])
+}
@@ -0,0 +1,37 @@
+package commands
+
+import (
+	"fmt"
+
+	"zd-cli/internal/output"
+
+	"github.com/spf13/cobra"
+)
+
+// ApplyOutputOptions applies the global --fields, --template, --wide, and
+// --no-truncate flags to the running command. Templates render the same data as -o json, so the
+// command's output format is switched to JSON and the JSON output is routed
+// through the template.
+func ApplyOutputOptions(cmd *cobra.Command) error {
+	wide, _ := cmd.Flags().GetBool("wide")
+	noTruncate, _ := cmd.Flags().GetBool("no-truncate")
+	output.SetTableOptions(wide, noTruncate)
+
+	if fields, _ := cmd.Flags().GetStringSlice("fields"); len(fields) > 0 {
+		output.SetFields(fields)
+	}
+
+	text, _ := cmd.Flags().GetString("template")
+	if text == "" {
+		return nil
+	}
+
+	if cmd.Flags().Lookup("output") == nil {
+		return fmt.Errorf("--template is not supported by '%s'", cmd.CommandPath())
+	}
+	if err := cmd.Flags().Set("output", string(output.FormatJSON)); err != nil {
+		return err
+	}
+
+	return output.SetTemplate(text)
+}
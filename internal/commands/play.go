@@ -0,0 +1,349 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"zd-cli/internal/client"
+	"zd-cli/internal/config"
+	"zd-cli/internal/output"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// maxPlayPages caps how many pages of a view are searched for a ticket
+const maxPlayPages = 5
+
+// playLocksFile holds the tickets recently served by 'zd play next', in the
+// config directory
+const playLocksFile = "play-locks.json"
+
+// NewPlayCommand creates the play command
+func NewPlayCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "play",
+		Short: "Work through a view one ticket at a time",
+		Long: `Work through a view one ticket at a time, like play mode in the agent
+workspace. 'zd play next' serves the next ticket you haven't skipped; take it
+or skip it with a reason, then ask for the next one.
+
+A served ticket is locked on this machine for a while, so another terminal
+running 'zd play next' on the same view gets a different ticket.`,
+	}
+
+	cmd.AddCommand(newPlayNextCommand())
+	cmd.AddCommand(newPlaySkipCommand())
+	cmd.AddCommand(newPlaySkipsCommand())
+
+	// Add global output format flag to all subcommands
+	cmd.PersistentFlags().StringP("output", "o", "table", "Output format: table, json, csv")
+
+	return cmd
+}
+
+func newPlayNextCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "next <view-id>",
+		Short: "Serve the next ticket from a view",
+		Long: `Serve the next ticket from a view, in the view's order. Tickets you have
+skipped, tickets assigned to other agents, and tickets locked by another
+'zd play next' are passed over. Examples:
+  zd play next 360001234567
+  zd play next 360001234567 --take
+  zd play skip 12345 --reason "Needs billing access"`,
+		Args: cobra.ExactArgs(1),
+		RunE: withClient(runPlayNext),
+	}
+
+	cmd.Flags().Bool("take", false, "Assign the ticket to yourself right away")
+	cmd.Flags().Duration("lock-for", 10*time.Minute, "How long the ticket stays locked for other 'zd play next' runs")
+
+	// Views and skips must be current, or skipped tickets come round again
+	cmd.Flags().Bool("refresh", true, "Bypass cache and fetch fresh data")
+	cmd.Flags().MarkHidden("refresh")
+
+	return cmd
+}
+
+func newPlaySkipCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "skip <ticket-id>",
+		Short: "Skip a ticket, with a reason",
+		Long: `Record that you skipped a ticket, so 'zd play next' doesn't serve it to
+you again. The reason is visible to admins. Examples:
+  zd play skip 12345 --reason "Needs billing access"`,
+		Args: cobra.ExactArgs(1),
+		RunE: withClient(runPlaySkip),
+	}
+
+	cmd.Flags().String("reason", "", "Why you're skipping the ticket")
+
+	return cmd
+}
+
+func newPlaySkipsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "skips [user-id]",
+		Short: "List the tickets you, or another agent, skipped",
+		Args:  cobra.MaximumNArgs(1),
+		RunE:  withClient(runPlaySkips),
+	}
+
+	return cmd
+}
+
+func runPlayNext(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	viewID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid view ID: %s", args[0])
+	}
+
+	take, _ := cmd.Flags().GetBool("take")
+	lockFor, _ := cmd.Flags().GetDuration("lock-for")
+
+	ctx, cancel := runContext(zdClient, 2*time.Minute)
+	defer cancel()
+
+	me, err := zdClient.GetMe(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get current user: %w", err)
+	}
+
+	skips, err := zdClient.ListUserSkips(ctx, me.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list skips: %w", err)
+	}
+	skipped := make(map[int64]bool, len(skips))
+	for _, skip := range skips {
+		skipped[skip.TicketID] = true
+	}
+
+	locks, err := loadPlayLocks()
+	if err != nil {
+		return err
+	}
+
+	var next *client.Ticket
+	for page := 1; page <= maxPlayPages && next == nil; page++ {
+		resp, err := zdClient.GetViewTickets(ctx, viewID, page, 100)
+		if err != nil {
+			return fmt.Errorf("failed to get view tickets: %w", err)
+		}
+
+		for i, ticket := range resp.Tickets {
+			if playable(&ticket, me.ID, skipped, locks.held(zdClient, ticket.ID)) {
+				next = &resp.Tickets[i]
+				break
+			}
+		}
+
+		if resp.NextPage == "" {
+			break
+		}
+	}
+
+	if next == nil {
+		color.Yellow("No tickets left to play in view %d.\n", viewID)
+		return nil
+	}
+
+	if take {
+		next, err = zdClient.UpdateTicket(ctx, next.ID, client.UpdateTicketRequest{AssigneeID: &me.ID})
+		if err != nil {
+			return fmt.Errorf("failed to assign ticket: %w", err)
+		}
+		locks.release(zdClient, next.ID)
+	} else {
+		locks.lock(zdClient, next.ID, time.Now().Add(lockFor))
+	}
+	if err := locks.save(); err != nil {
+		return err
+	}
+
+	if err := outputTicket(cmd, next, false); err != nil {
+		return err
+	}
+
+	format, _ := cmd.Flags().GetString("output")
+	if output.NewWriter(output.Format(format)).IsTable() {
+		fmt.Println()
+		if take {
+			color.Green("✓ Ticket #%d assigned to you (%s)\n", next.ID, me.Name)
+		} else {
+			color.White("Take it:  zd ticket take %d\n", next.ID)
+			color.White("Skip it:  zd play skip %d --reason \"...\"\n", next.ID)
+		}
+	}
+
+	return nil
+}
+
+// playable reports whether a ticket can be served to the agent: it isn't
+// done, skipped, locked, or assigned to someone else
+func playable(ticket *client.Ticket, agentID int64, skipped map[int64]bool, locked bool) bool {
+	if ticket.Status == "solved" || ticket.Status == "closed" {
+		return false
+	}
+	if ticket.AssigneeID != nil && *ticket.AssigneeID != agentID {
+		return false
+	}
+	return !skipped[ticket.ID] && !locked
+}
+
+func runPlaySkip(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	ticketID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid ticket ID: %s", args[0])
+	}
+
+	reason, _ := cmd.Flags().GetString("reason")
+
+	if reason == "" {
+		reason, err = promptString("Reason", true, "--reason")
+		if err != nil {
+			return err
+		}
+	}
+
+	ctx := context.Background()
+
+	if _, err := zdClient.CreateSkip(ctx, ticketID, reason); err != nil {
+		return fmt.Errorf("failed to skip ticket: %w", err)
+	}
+
+	// The ticket won't be served to us again, so it needn't stay locked
+	locks, err := loadPlayLocks()
+	if err != nil {
+		return err
+	}
+	locks.release(zdClient, ticketID)
+	if err := locks.save(); err != nil {
+		return err
+	}
+
+	color.Green("✓ Skipped ticket #%d\n", ticketID)
+
+	return nil
+}
+
+func runPlaySkips(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	ctx, cancel := runContext(zdClient, 2*time.Minute)
+	defer cancel()
+
+	var userID int64
+	if len(args) == 1 {
+		id, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid user ID: %s", args[0])
+		}
+		userID = id
+	} else {
+		me, err := zdClient.GetMe(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get current user: %w", err)
+		}
+		userID = me.ID
+	}
+
+	skips, err := zdClient.ListUserSkips(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to list skips: %w", err)
+	}
+
+	if len(skips) == 0 {
+		color.Yellow("No skipped tickets found.\n")
+		return nil
+	}
+
+	headers := []string{"id", "ticket_id", "user_id", "reason", "created_at"}
+	return writeOutput(cmd, skips, headers, func() {
+		color.Cyan("Skipped Tickets (%d)\n", len(skips))
+		color.White(strings.Repeat("─", 80) + "\n")
+
+		table := output.NewTable("TICKET", "SKIPPED", "REASON")
+		table.SetFlexColumn(2)
+		for _, skip := range skips {
+			table.AddRow(fmt.Sprintf("#%d", skip.TicketID), formatDate(skip.CreatedAt), orDash(skip.Reason))
+		}
+		table.Print()
+	})
+}
+
+// playLocks are the tickets served by 'zd play next' that are still locked,
+// keyed by instance subdomain and ticket ID, with when each lock expires
+type playLocks struct {
+	path  string
+	until map[string]time.Time
+}
+
+// loadPlayLocks reads the play locks file, dropping expired locks. A missing
+// file has no locks.
+func loadPlayLocks() (*playLocks, error) {
+	dir, err := config.GetConfigDir()
+	if err != nil {
+		return nil, err
+	}
+
+	locks := &playLocks{path: filepath.Join(dir, playLocksFile), until: make(map[string]time.Time)}
+
+	data, err := os.ReadFile(locks.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return locks, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read play locks: %w", err)
+	}
+	if err := json.Unmarshal(data, &locks.until); err != nil {
+		return nil, fmt.Errorf("invalid play locks file %s: %w", locks.path, err)
+	}
+
+	now := time.Now()
+	for key, until := range locks.until {
+		if !until.After(now) {
+			delete(locks.until, key)
+		}
+	}
+
+	return locks, nil
+}
+
+func playLockKey(zdClient *client.Client, ticketID int64) string {
+	return fmt.Sprintf("%s:%d", zdClient.Subdomain(), ticketID)
+}
+
+func (l *playLocks) held(zdClient *client.Client, ticketID int64) bool {
+	_, ok := l.until[playLockKey(zdClient, ticketID)]
+	return ok
+}
+
+func (l *playLocks) lock(zdClient *client.Client, ticketID int64, until time.Time) {
+	l.until[playLockKey(zdClient, ticketID)] = until
+}
+
+func (l *playLocks) release(zdClient *client.Client, ticketID int64) {
+	delete(l.until, playLockKey(zdClient, ticketID))
+}
+
+// save writes the locks back to the play locks file
+func (l *playLocks) save() error {
+	if err := config.EnsureConfigDir(); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(l.until, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode play locks: %w", err)
+	}
+	if err := os.WriteFile(l.path, data, 0600); err != nil {
+		return fmt.Errorf("failed to write play locks: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"zd-cli/internal/client"
+	"zd-cli/internal/output"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// NewRateLimitCommand creates the ratelimit command
+func NewRateLimitCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ratelimit",
+		Short: "Show the API rate limit budget",
+		Long: `Show how many API requests the current instance may make per minute and how
+many are left. Zendesk reports the budget on every response; this makes one
+uncached request to read it.
+
+Pass the global --show-rate-limit flag to any command to print the budget
+left after it runs.`,
+		RunE: withClient(runRateLimit),
+	}
+
+	cmd.Flags().StringP("output", "o", "table", "Output format: table, json")
+
+	return cmd
+}
+
+// rateLimitOutput is the JSON form of a rate limit budget
+type rateLimitOutput struct {
+	Limit        int `json:"limit"`
+	Remaining    int `json:"remaining"`
+	Used         int `json:"used"`
+	ResetSeconds int `json:"reset_seconds,omitempty"`
+}
+
+func runRateLimit(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	ctx := context.Background()
+
+	rl, err := zdClient.GetRateLimit(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get rate limit: %w", err)
+	}
+
+	format, _ := cmd.Flags().GetString("output")
+	if output.Format(format) == output.FormatJSON {
+		return output.NewWriter(output.FormatJSON).WriteJSON(rateLimitOutput{
+			Limit:        rl.Limit,
+			Remaining:    rl.Remaining,
+			Used:         rl.Used(),
+			ResetSeconds: int(rl.Reset.Seconds()),
+		})
+	}
+
+	color.Cyan("API Rate Limit\n")
+	color.White("──────────────\n")
+	color.White("Limit:      %d requests/minute\n", rl.Limit)
+	color.White("Remaining:  %d\n", rl.Remaining)
+	color.White("Used:       %d (%.0f%%)\n", rl.Used(), float64(rl.Used())/float64(max(rl.Limit, 1))*100)
+	if rl.Reset > 0 {
+		color.White("Resets in:  %s\n", rl.Reset)
+	}
+	if rl.Low() {
+		color.Yellow("\nBudget is low; bulk commands will slow down until it resets.\n")
+	}
+
+	return nil
+}
+
+// ShowRateLimit prints the budget left after the command ran, when the
+// global --show-rate-limit flag is set
+func ShowRateLimit(cmd *cobra.Command) {
+	if show, _ := cmd.PersistentFlags().GetBool("show-rate-limit"); !show {
+		return
+	}
+
+	rl, ok := client.LastRateLimit()
+	if !ok {
+		fmt.Fprintln(os.Stderr, "Rate limit: no API requests made")
+		return
+	}
+	fmt.Fprintf(os.Stderr, "Rate limit: %s\n", rl)
+}
@@ -22,12 +22,14 @@ func NewReauthCommand() *cobra.Command {
 		RunE:  runReauth,
 	}
 
+	addOAuthFlags(cmd)
+
 	return cmd
 }
 
 func runReauth(cmd *cobra.Command, args []string) error {
 	// Load config
-	cfg, err := config.Load()
+	cfg, err := loadConfig(cmd)
 	if err != nil {
 		return fmt.Errorf("failed to load configuration: %w", err)
 	}
@@ -37,11 +39,12 @@ func runReauth(cmd *cobra.Command, args []string) error {
 	if len(args) > 0 {
 		instanceName = args[0]
 	} else {
-		// Use current instance
-		if cfg.Current == "" {
-			return fmt.Errorf("no current instance set. Specify instance name: zd reauth <instance-name>")
+		// Use the --instance override, or the current instance
+		instance, err := instanceFromFlags(cmd, cfg)
+		if err != nil {
+			return err
 		}
-		instanceName = cfg.Current
+		instanceName = instance.Name
 	}
 
 	// Get the instance
@@ -55,8 +58,11 @@ func runReauth(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("instance '%s' uses %s authentication, not OAuth", instanceName, instance.AuthType)
 	}
 
+	// Flags given on this run replace the saved OAuth settings
+	applyChangedOAuthFlags(cmd, instance)
+
 	// Verify OAuth config exists
-	if err := auth.ValidateOAuthConfig(instance.OAuthClientID, instance.OAuthSecret); err != nil {
+	if err := auth.ValidateOAuthConfig(instance.OAuthClientID, instance.OAuthSecret, instance.OAuthPKCE); err != nil {
 		return fmt.Errorf("OAuth client credentials missing: %w", err)
 	}
 
@@ -66,12 +72,8 @@ func runReauth(cmd *cobra.Command, args []string) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
 	defer cancel()
 
-	oauthCfg := auth.OAuthConfig{
-		ClientID:     instance.OAuthClientID,
-		ClientSecret: instance.OAuthSecret,
-		Subdomain:    instance.Subdomain,
-		RedirectURL:  auth.DefaultRedirectURL,
-	}
+	oauthCfg := oauthConfigFor(instance)
+	oauthCfg.NoBrowser = oauthOptionsFromFlags(cmd).noBrowser
 
 	token, err := auth.PerformOAuthFlow(ctx, oauthCfg)
 	if err != nil {
@@ -84,7 +86,7 @@ func runReauth(cmd *cobra.Command, args []string) error {
 	instance.SetOAuthExpiry(token.Expiry)
 
 	// Save config
-	if err := config.Save(cfg); err != nil {
+	if err := saveConfig(cmd, cfg); err != nil {
 		return fmt.Errorf("failed to save configuration: %w", err)
 	}
 
@@ -0,0 +1,316 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"zd-cli/internal/client"
+	"zd-cli/internal/output"
+	"zd-cli/internal/progress"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// reportGroupings are the fields zd report tickets can group by
+var reportGroupings = []string{"status", "priority", "type", "assignee", "group", "via"}
+
+// reportChartWidth is the length of the longest bar in --output chart
+const reportChartWidth = 50
+
+// reportRow is one group of a report
+type reportRow struct {
+	Key     string  `json:"key"`
+	Count   int     `json:"count"`
+	Percent float64 `json:"percent"`
+}
+
+// ticketReport is the JSON form of zd report tickets
+type ticketReport struct {
+	GroupBy   string      `json:"group_by"`
+	DateField string      `json:"date_field"`
+	Since     string      `json:"since"`
+	Total     int         `json:"total"`
+	Rows      []reportRow `json:"rows"`
+}
+
+// NewReportCommand creates the report command
+func NewReportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "report",
+		Short: "Summarize tickets and agents over a period",
+		Long: `Build reports locally from the incremental ticket export, so they cover
+every ticket in the period rather than the first 1,000 search results.`,
+	}
+
+	cmd.AddCommand(newReportTicketsCommand())
+	cmd.AddCommand(newReportAgentsCommand())
+
+	return cmd
+}
+
+func newReportTicketsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tickets",
+		Short: "Count tickets by status, priority, assignee, or group",
+		Long: fmt.Sprintf(`Count the tickets created (or updated, with --date-field updated) since a
+time, grouped by a field: %s.
+
+--filter narrows the tickets counted, using the expressions of 'zd tail'.
+Deleted tickets are left out. Examples:
+  zd report tickets --group-by status --since 30d
+  zd report tickets --group-by assignee --since 7d --filter status!=closed -o chart
+  zd report tickets --group-by group --since 2026-01-01 -o csv`, strings.Join(reportGroupings, ", ")),
+		Args: cobra.NoArgs,
+		RunE: withClient(runReportTickets),
+	}
+
+	cmd.Flags().String("group-by", "status", "Field to group by: "+strings.Join(reportGroupings, ", "))
+	cmd.Flags().String("since", "30d", "Start of the period: relative (30d, 12h, 2w), YYYY-MM-DD, RFC3339, or Unix timestamp")
+	cmd.Flags().String("date-field", "created", "Count tickets created or updated in the period: created, updated")
+	cmd.Flags().StringArray("filter", nil, "Only count tickets matching this expression (repeatable)")
+	cmd.Flags().StringP("output", "o", "table", "Output format: table, chart, json, csv")
+
+	cmd.RegisterFlagCompletionFunc("group-by", cobra.FixedCompletions(reportGroupings, cobra.ShellCompDirectiveNoFileComp))
+	cmd.RegisterFlagCompletionFunc("date-field", cobra.FixedCompletions([]string{"created", "updated"}, cobra.ShellCompDirectiveNoFileComp))
+	cmd.RegisterFlagCompletionFunc("filter", cobra.NoFileCompletions)
+
+	return cmd
+}
+
+func runReportTickets(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	groupBy, _ := cmd.Flags().GetString("group-by")
+	if !slices.Contains(reportGroupings, groupBy) {
+		return fmt.Errorf("invalid --group-by %q: use %s", groupBy, strings.Join(reportGroupings, ", "))
+	}
+
+	dateField, _ := cmd.Flags().GetString("date-field")
+	if dateField != "created" && dateField != "updated" {
+		return fmt.Errorf("invalid --date-field %q: use created or updated", dateField)
+	}
+
+	sinceFlag, _ := cmd.Flags().GetString("since")
+	since, err := parseReportSince(sinceFlag)
+	if err != nil {
+		return err
+	}
+
+	exprs, _ := cmd.Flags().GetStringArray("filter")
+	filters, err := parseTicketFilters(exprs)
+	if err != nil {
+		return err
+	}
+
+	format, _ := cmd.Flags().GetString("output")
+	if format != "table" && format != "chart" && format != "json" && format != "csv" {
+		return fmt.Errorf("invalid output format %q: use table, chart, json, or csv", format)
+	}
+
+	ctx := context.Background()
+
+	var tickets []client.Ticket
+	err = exportReportTickets(ctx, zdClient, since, false, func(ticket *client.Ticket, _ *client.TicketMetric) {
+		if dateField == "created" && !createdSince(ticket, since) {
+			return
+		}
+		if matchTicketFilters(filters, ticket, "update") {
+			tickets = append(tickets, *ticket)
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	if groupBy == "assignee" || groupBy == "group" {
+		names.prefetchTickets(tickets)
+	}
+
+	counts := make(map[string]int)
+	for i := range tickets {
+		counts[reportTicketKey(&tickets[i], groupBy)]++
+	}
+	rows := reportRows(counts, len(tickets))
+
+	switch format {
+	case "json":
+		return output.NewWriter(output.FormatJSON).WriteJSON(ticketReport{
+			GroupBy:   groupBy,
+			DateField: dateField,
+			Since:     since.UTC().Format(time.RFC3339),
+			Total:     len(tickets),
+			Rows:      rows,
+		})
+	case "csv":
+		return output.NewWriter(output.FormatCSV).WriteCSV(rows, []string{"key", "count", "percent"})
+	}
+
+	color.Cyan("Tickets %s since %s by %s\n", dateField, since.Format("2006-01-02 15:04"), groupBy)
+	if len(exprs) > 0 {
+		color.White("Filters: %s\n", strings.Join(exprs, " and "))
+	}
+	color.White(strings.Repeat("─", 80) + "\n")
+
+	if len(rows) == 0 {
+		color.Yellow("No tickets found.\n")
+		return nil
+	}
+
+	if format == "chart" {
+		printReportChart(rows)
+	} else {
+		table := output.NewTable(strings.ToUpper(groupBy), "COUNT", "PERCENT")
+		table.SetFlexColumn(0)
+		for _, row := range rows {
+			table.AddRow(row.Key, fmt.Sprintf("%d", row.Count), fmt.Sprintf("%.1f%%", row.Percent))
+		}
+		table.Print()
+	}
+
+	fmt.Println()
+	color.White("Total: %d tickets\n", len(tickets))
+
+	return nil
+}
+
+// exportReportTickets calls fn with every ticket updated since the start,
+// leaving out deleted tickets. With withMetrics each ticket comes with its
+// metrics, which may still be nil if Zendesk has none. A progress bar on
+// stderr counts the tickets read.
+func exportReportTickets(ctx context.Context, zdClient *client.Client, since time.Time, withMetrics bool, fn func(*client.Ticket, *client.TicketMetric)) error {
+	bar := progress.NewBar("Reading tickets", 0)
+	defer bar.Finish()
+
+	read := 0
+	cursor := ""
+	for {
+		pageCtx, cancel := context.WithTimeout(client.WithoutTimeout(ctx), 2*time.Minute)
+		var page *client.IncrementalTicketsResponse
+		var err error
+		if withMetrics {
+			page, err = zdClient.ExportTicketsWithMetrics(pageCtx, since.Unix(), cursor)
+		} else {
+			page, err = zdClient.ExportTickets(pageCtx, since.Unix(), cursor)
+		}
+		cancel()
+		if err != nil {
+			return fmt.Errorf("failed to export tickets: %w", err)
+		}
+
+		metrics := make(map[int64]*client.TicketMetric, len(page.MetricSets))
+		for i := range page.MetricSets {
+			metrics[page.MetricSets[i].TicketID] = &page.MetricSets[i]
+		}
+
+		for i := range page.Tickets {
+			if page.Tickets[i].Status != "deleted" {
+				fn(&page.Tickets[i], metrics[page.Tickets[i].ID])
+			}
+		}
+
+		read += len(page.Tickets)
+		bar.Set(read)
+
+		if page.EndOfStream || page.AfterCursor == "" {
+			return nil
+		}
+		cursor = page.AfterCursor
+	}
+}
+
+// parseReportSince parses --since, where relative times may leave out the
+// leading minus: 30d means the last 30 days
+func parseReportSince(value string) (time.Time, error) {
+	if t, ok := parseRelativeTime("-"+value, time.Now()); ok {
+		return t, nil
+	}
+	return parseTimestamp(value)
+}
+
+// createdSince reports whether the ticket was created at or after since
+func createdSince(ticket *client.Ticket, since time.Time) bool {
+	created, err := time.Parse(time.RFC3339, ticket.CreatedAt)
+	return err == nil && !created.Before(since)
+}
+
+// reportTicketKey returns the group a ticket falls in
+func reportTicketKey(ticket *client.Ticket, groupBy string) string {
+	switch groupBy {
+	case "status":
+		return ticket.Status
+	case "priority":
+		return orNone(ticket.Priority)
+	case "type":
+		return orNone(ticket.Type)
+	case "via":
+		return orNone(ticket.Via.Channel)
+	case "assignee":
+		if ticket.AssigneeID == nil {
+			return "(unassigned)"
+		}
+		return withID(names.userName(*ticket.AssigneeID), *ticket.AssigneeID)
+	case "group":
+		if ticket.GroupID == nil {
+			return "(none)"
+		}
+		return withID(names.groupName(*ticket.GroupID), *ticket.GroupID)
+	}
+	return ""
+}
+
+// orNone returns s, or "(none)" when it is empty
+func orNone(s string) string {
+	if s == "" {
+		return "(none)"
+	}
+	return s
+}
+
+// reportRows turns counts into rows, largest first
+func reportRows(counts map[string]int, total int) []reportRow {
+	rows := make([]reportRow, 0, len(counts))
+	for key, count := range counts {
+		rows = append(rows, reportRow{
+			Key:     key,
+			Count:   count,
+			Percent: float64(int(float64(count)*1000/float64(total)+0.5)) / 10,
+		})
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].Count != rows[j].Count {
+			return rows[i].Count > rows[j].Count
+		}
+		return rows[i].Key < rows[j].Key
+	})
+	return rows
+}
+
+// printReportChart draws a horizontal bar per row, scaled to the largest
+func printReportChart(rows []reportRow) {
+	labelWidth := 0
+	for _, row := range rows {
+		labelWidth = max(labelWidth, len([]rune(row.Key)))
+	}
+	labelWidth = min(labelWidth, 30)
+
+	largest := rows[0].Count
+	for _, row := range rows {
+		label := []rune(row.Key)
+		if len(label) > labelWidth {
+			label = append(label[:labelWidth-1], '…')
+		}
+
+		width := row.Count * reportChartWidth / largest
+		if width == 0 {
+			width = 1
+		}
+
+		fmt.Printf("%-*s  %s %d (%.1f%%)\n",
+			labelWidth, string(label),
+			color.CyanString(strings.Repeat("█", width)),
+			row.Count, row.Percent)
+	}
+}
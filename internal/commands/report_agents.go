@@ -0,0 +1,243 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"zd-cli/internal/client"
+	"zd-cli/internal/output"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// agentReportRow is one agent's line of zd report agents. Averages are nil
+// when none of the agent's tickets has the metric.
+type agentReportRow struct {
+	AgentID              int64    `json:"agent_id"`
+	Agent                string   `json:"agent"`
+	Solved               int      `json:"solved"`
+	AvgFirstReplyMinutes *float64 `json:"avg_first_reply_minutes"`
+	AvgResolutionMinutes *float64 `json:"avg_resolution_minutes"`
+	Reopened             int      `json:"reopened"`
+	ReopenRate           float64  `json:"reopen_rate"`
+}
+
+// agentReportTotals sums the metrics of one agent's solved tickets
+type agentReportTotals struct {
+	solved, reopened             int
+	replyMinutes, replyCount     int64
+	resolveMinutes, resolveCount int64
+}
+
+func newReportAgentsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "agents",
+		Short: "Summarize solved tickets, reply times, and reopens per agent",
+		Long: `Summarize the tickets each agent solved since a time: how many, the
+average first reply and full resolution times, and how many of them were
+reopened. Tickets count for their assignee and must still be solved or
+closed. Times are calendar minutes, or business hours with --business.
+
+--filter narrows the tickets counted, using the expressions of 'zd tail'.
+Examples:
+  zd report agents --since 7d
+  zd report agents --since 30d --filter group=360001234567 --business
+  zd report agents --since 2026-01-01 -o csv > agents.csv`,
+		Args: cobra.NoArgs,
+		RunE: withClient(runReportAgents),
+	}
+
+	cmd.Flags().String("since", "7d", "Start of the period: relative (7d, 12h, 2w), YYYY-MM-DD, RFC3339, or Unix timestamp")
+	cmd.Flags().Bool("business", false, "Use business hours instead of calendar time")
+	cmd.Flags().StringArray("filter", nil, "Only count tickets matching this expression (repeatable)")
+	cmd.Flags().StringP("output", "o", "table", "Output format: table, json, csv")
+
+	cmd.RegisterFlagCompletionFunc("filter", cobra.NoFileCompletions)
+
+	return cmd
+}
+
+func runReportAgents(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	sinceFlag, _ := cmd.Flags().GetString("since")
+	since, err := parseReportSince(sinceFlag)
+	if err != nil {
+		return err
+	}
+
+	exprs, _ := cmd.Flags().GetStringArray("filter")
+	filters, err := parseTicketFilters(exprs)
+	if err != nil {
+		return err
+	}
+
+	business, _ := cmd.Flags().GetBool("business")
+	format, _ := cmd.Flags().GetString("output")
+	writer := output.NewWriter(output.Format(format))
+
+	ctx := context.Background()
+
+	totals := make(map[int64]*agentReportTotals)
+	err = exportReportTickets(ctx, zdClient, since, true, func(ticket *client.Ticket, metric *client.TicketMetric) {
+		if ticket.AssigneeID == nil || metric == nil || !solvedSince(ticket, metric, since) {
+			return
+		}
+		if !matchTicketFilters(filters, ticket, "update") {
+			return
+		}
+
+		t := totals[*ticket.AssigneeID]
+		if t == nil {
+			t = &agentReportTotals{}
+			totals[*ticket.AssigneeID] = t
+		}
+		t.solved++
+		if metric.Reopens > 0 {
+			t.reopened++
+		}
+		if minutes := metricMinutes(metric.ReplyTimeInMinutes, business); minutes != nil {
+			t.replyMinutes += *minutes
+			t.replyCount++
+		}
+		if minutes := metricMinutes(metric.FullResolutionTimeInMinutes, business); minutes != nil {
+			t.resolveMinutes += *minutes
+			t.resolveCount++
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	var agentIDs []int64
+	for id := range totals {
+		agentIDs = append(agentIDs, id)
+	}
+	names.prefetchUsers(agentIDs)
+
+	all := &agentReportTotals{}
+	var rows []agentReportRow
+	for id, t := range totals {
+		rows = append(rows, t.row(id, names.userName(id)))
+		all.add(t)
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].Solved != rows[j].Solved {
+			return rows[i].Solved > rows[j].Solved
+		}
+		return rows[i].Agent < rows[j].Agent
+	})
+
+	if writer.IsJSON() {
+		if rows == nil {
+			rows = []agentReportRow{}
+		}
+		return writer.WriteJSON(rows)
+	}
+	if writer.IsCSV() {
+		return writer.WriteCSV(rows, []string{"agent_id", "agent", "solved", "avg_first_reply_minutes", "avg_resolution_minutes", "reopened", "reopen_rate"})
+	}
+
+	hours := "calendar"
+	if business {
+		hours = "business"
+	}
+	color.Cyan("Agents: tickets solved since %s (%s hours)\n", since.Format("2006-01-02 15:04"), hours)
+	if len(exprs) > 0 {
+		color.White("Filters: %s\n", strings.Join(exprs, " and "))
+	}
+	color.White(strings.Repeat("─", 80) + "\n")
+
+	if len(rows) == 0 {
+		color.Yellow("No solved tickets found.\n")
+		return nil
+	}
+
+	table := output.NewTable("AGENT", "SOLVED", "AVG FIRST REPLY", "AVG RESOLUTION", "REOPENED")
+	table.SetFlexColumn(0)
+	for _, row := range append(rows, all.row(0, "All agents")) {
+		table.AddRow(
+			row.Agent,
+			fmt.Sprintf("%d", row.Solved),
+			formatMinutes(row.AvgFirstReplyMinutes),
+			formatMinutes(row.AvgResolutionMinutes),
+			fmt.Sprintf("%d (%.1f%%)", row.Reopened, row.ReopenRate*100))
+	}
+	table.Print()
+
+	return nil
+}
+
+// row computes an agent's averages and reopen rate
+func (t *agentReportTotals) row(agentID int64, agent string) agentReportRow {
+	row := agentReportRow{
+		AgentID:  agentID,
+		Agent:    agent,
+		Solved:   t.solved,
+		Reopened: t.reopened,
+	}
+	if t.solved > 0 {
+		row.ReopenRate = float64(int(float64(t.reopened)*1000/float64(t.solved)+0.5)) / 1000
+	}
+	if t.replyCount > 0 {
+		avg := float64(t.replyMinutes) / float64(t.replyCount)
+		row.AvgFirstReplyMinutes = &avg
+	}
+	if t.resolveCount > 0 {
+		avg := float64(t.resolveMinutes) / float64(t.resolveCount)
+		row.AvgResolutionMinutes = &avg
+	}
+	return row
+}
+
+// add adds another agent's totals to t
+func (t *agentReportTotals) add(other *agentReportTotals) {
+	t.solved += other.solved
+	t.reopened += other.reopened
+	t.replyMinutes += other.replyMinutes
+	t.replyCount += other.replyCount
+	t.resolveMinutes += other.resolveMinutes
+	t.resolveCount += other.resolveCount
+}
+
+// solvedSince reports whether a ticket is solved or closed and was solved
+// at or after since
+func solvedSince(ticket *client.Ticket, metric *client.TicketMetric, since time.Time) bool {
+	if ticket.Status != "solved" && ticket.Status != "closed" {
+		return false
+	}
+	if metric.SolvedAt == nil {
+		return false
+	}
+	solved, err := time.Parse(time.RFC3339, *metric.SolvedAt)
+	return err == nil && !solved.Before(since)
+}
+
+// metricMinutes picks the calendar or business value of a metric
+func metricMinutes(m client.MetricMinutes, business bool) *int64 {
+	if business {
+		return m.Business
+	}
+	return m.Calendar
+}
+
+// formatMinutes formats a number of minutes as days, hours, and minutes,
+// e.g. "2d 3h" or "1h 25m"
+func formatMinutes(minutes *float64) string {
+	if minutes == nil {
+		return "-"
+	}
+
+	total := int64(*minutes + 0.5)
+	days, hours, mins := total/(24*60), total/60%24, total%60
+	switch {
+	case days > 0:
+		return fmt.Sprintf("%dd %dh", days, hours)
+	case hours > 0:
+		return fmt.Sprintf("%dh %dm", hours, mins)
+	default:
+		return fmt.Sprintf("%dm", mins)
+	}
+}
@@ -0,0 +1,378 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"zd-cli/internal/client"
+	"zd-cli/internal/output"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// requestSubcommands are the ticket subcommands with a 'zd request'
+// counterpart, suggested when the tickets API refuses a token
+var requestSubcommands = []string{"list", "show", "create", "comment"}
+
+// requestWithComments is a request with its conversation, for JSON output
+type requestWithComments struct {
+	*client.Request
+	Comments []client.Comment `json:"comments"`
+}
+
+// NewRequestCommand creates the request command
+func NewRequestCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "request",
+		Short: "Work with your own tickets as an end user",
+		Long: `List, read, create, and reply to tickets through the requests API, which
+only shows the tickets you requested or are CC'd on.
+
+Use these commands with end-user or light agent credentials, which the
+tickets API refuses. Comments are always public.`,
+	}
+
+	cmd.AddCommand(newRequestListCommand())
+	cmd.AddCommand(newRequestShowCommand())
+	cmd.AddCommand(newRequestCreateCommand())
+	cmd.AddCommand(newRequestCommentCommand())
+
+	// Add global output format flag to all subcommands
+	cmd.PersistentFlags().StringP("output", "o", "table", "Output format: table, json, csv")
+
+	return cmd
+}
+
+func newRequestListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List your requests, most recently updated first",
+		Long: `List the tickets you requested or are CC'd on. Examples:
+  zd request list
+  zd request list --status open,pending`,
+		Args: cobra.NoArgs,
+		RunE: withClient(runRequestList),
+	}
+
+	cmd.Flags().StringSlice("status", nil, "Only requests with these statuses (comma-separated)")
+	cmd.Flags().Int("page", 1, "Page number")
+	cmd.Flags().Int("per-page", 25, "Results per page (max 100)")
+
+	cmd.RegisterFlagCompletionFunc("status", cobra.FixedCompletions(ticketStatuses, cobra.ShellCompDirectiveNoFileComp))
+
+	return cmd
+}
+
+func newRequestShowCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show <request-id>",
+		Short: "Show a request and its conversation",
+		Long: `Show a request and its public comments. With -o csv the comments are
+listed, one per row.`,
+		Args: cobra.ExactArgs(1),
+		RunE: withClient(runRequestShow),
+	}
+
+	cmd.Flags().Bool("raw", false, "Show comment bodies as sent instead of rendering HTML")
+
+	return cmd
+}
+
+func newRequestCreateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a request",
+		Long: `Create a ticket with yourself as the requester. Examples:
+  zd request create --subject "Can't log in" --description "Since this morning..."
+  zd request create --subject "Invoice copy" --description @message.txt`,
+		Args: cobra.NoArgs,
+		RunE: withClient(runRequestCreate),
+	}
+
+	cmd.Flags().String("subject", "", "Request subject")
+	cmd.Flags().String("description", "", "Request description (@file to read a file, - for stdin)")
+	cmd.Flags().String("priority", "", "Priority: low, normal, high, urgent")
+	cmd.Flags().String("type", "", "Type: problem, incident, question, task")
+	cmd.Flags().StringArray("attach", nil, "Attach a file to the description (repeatable)")
+
+	cmd.RegisterFlagCompletionFunc("priority", cobra.FixedCompletions(ticketPriorities, cobra.ShellCompDirectiveNoFileComp))
+
+	return cmd
+}
+
+func newRequestCommentCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "comment <request-id>",
+		Short: "Reply to a request",
+		Long: `Add a public comment to a request. With --solve the request is also
+marked solved, when you're allowed to solve it. Examples:
+  zd request comment 12345 --message "Thanks, that worked"
+  zd request comment 12345 --message "All sorted" --solve`,
+		Args: cobra.ExactArgs(1),
+		RunE: withClient(runRequestComment),
+	}
+
+	cmd.Flags().String("message", "", "Comment message (@file to read a file, - for stdin)")
+	cmd.Flags().Bool("solve", false, "Mark the request solved")
+	cmd.Flags().StringArray("attach", nil, "Attach a file to the comment (repeatable)")
+
+	return cmd
+}
+
+func runRequestList(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	statuses, _ := cmd.Flags().GetStringSlice("status")
+	for _, status := range statuses {
+		if !containsString(ticketStatuses, status) {
+			return fmt.Errorf("invalid status %q: use %s", status, strings.Join(ticketStatuses, ", "))
+		}
+	}
+
+	page, _ := cmd.Flags().GetInt("page")
+	perPage, _ := cmd.Flags().GetInt("per-page")
+
+	if perPage > 100 {
+		perPage = 100
+	}
+
+	ctx := context.Background()
+
+	resp, err := zdClient.ListRequests(ctx, strings.Join(statuses, ","), page, perPage)
+	if err != nil {
+		return fmt.Errorf("failed to list requests: %w", err)
+	}
+
+	if len(resp.Requests) == 0 {
+		color.Yellow("No requests found.\n")
+		return nil
+	}
+
+	format, _ := cmd.Flags().GetString("output")
+	writer := output.NewWriter(output.Format(format))
+
+	switch output.Format(format) {
+	case output.FormatJSON:
+		return writer.WriteJSON(resp.Requests)
+
+	case output.FormatCSV:
+		headers := []string{"id", "subject", "status", "priority", "type", "requester_id", "created_at", "updated_at"}
+		return writer.WriteCSV(resp.Requests, headers)
+
+	default:
+		// Table format (default)
+		color.Cyan("Requests (Page %d, showing %d of %d total)\n", page, len(resp.Requests), resp.Count)
+		color.White(strings.Repeat("─", 80) + "\n")
+
+		table := output.NewTable("ID", "STATUS", "SUBJECT", "UPDATED")
+		table.SetFlexColumn(2)
+		for _, request := range resp.Requests {
+			table.AddRow(
+				fmt.Sprintf("%d", request.ID),
+				getColoredStatus(request.Status),
+				request.Subject,
+				formatDate(request.UpdatedAt))
+		}
+		table.Print()
+
+		// Show pagination info
+		if resp.NextPage != "" {
+			fmt.Println()
+			color.White("More results available. Use --page %d to see next page.\n", page+1)
+		}
+
+		return nil
+	}
+}
+
+func runRequestShow(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	requestID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid request ID: %s", args[0])
+	}
+
+	ctx := context.Background()
+
+	request, err := zdClient.GetRequest(ctx, requestID)
+	if err != nil {
+		return fmt.Errorf("failed to get request: %w", err)
+	}
+
+	comments, authors, err := zdClient.GetRequestComments(ctx, requestID)
+	if err != nil {
+		return fmt.Errorf("failed to get request comments: %w", err)
+	}
+
+	format, _ := cmd.Flags().GetString("output")
+	writer := output.NewWriter(output.Format(format))
+
+	switch output.Format(format) {
+	case output.FormatJSON:
+		if comments == nil {
+			comments = []client.Comment{}
+		}
+		return writer.WriteJSON(requestWithComments{Request: request, Comments: comments})
+
+	case output.FormatCSV:
+		headers := []string{"id", "author_id", "body", "public", "created_at"}
+		return writer.WriteCSV(comments, headers)
+
+	default:
+		// Table format (default)
+		raw, _ := cmd.Flags().GetBool("raw")
+		displayRequest(request, comments, authors, raw)
+		return nil
+	}
+}
+
+func runRequestCreate(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	subject, _ := cmd.Flags().GetString("subject")
+	description, err := messageFromFlag(cmd, "description")
+	if err != nil {
+		return err
+	}
+	priority, _ := cmd.Flags().GetString("priority")
+	requestType, _ := cmd.Flags().GetString("type")
+	attach, _ := cmd.Flags().GetStringArray("attach")
+
+	if priority != "" && !containsString(ticketPriorities, priority) {
+		return fmt.Errorf("invalid priority %q: use %s", priority, strings.Join(ticketPriorities, ", "))
+	}
+
+	// Interactive prompts if not provided
+	if subject == "" {
+		subject, err = promptString("Subject", true, "--subject")
+		if err != nil {
+			return err
+		}
+	}
+	if description == "" && len(attach) > 0 {
+		description = attachmentsComment(attach)
+	}
+	if description == "" {
+		description, err = promptString("Description", true, "--description")
+		if err != nil {
+			return err
+		}
+	}
+
+	req := client.CreateRequestRequest{
+		Subject:  subject,
+		Comment:  client.RequestComment{Body: description},
+		Priority: priority,
+		Type:     requestType,
+	}
+
+	req.Comment.Uploads, err = uploadAttachments(zdClient, attach)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	request, err := zdClient.CreateRequest(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	color.Green("✓ Created request #%d: %s\n", request.ID, request.Subject)
+
+	return nil
+}
+
+func runRequestComment(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	requestID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid request ID: %s", args[0])
+	}
+
+	message, err := messageFromFlag(cmd, "message")
+	if err != nil {
+		return err
+	}
+	solve, _ := cmd.Flags().GetBool("solve")
+	attach, _ := cmd.Flags().GetStringArray("attach")
+
+	if message == "" && len(attach) > 0 {
+		message = attachmentsComment(attach)
+	}
+	if message == "" {
+		message, err = promptString("Comment", true, "--message")
+		if err != nil {
+			return err
+		}
+	}
+
+	comment := client.RequestComment{Body: message}
+	comment.Uploads, err = uploadAttachments(zdClient, attach)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	request, err := zdClient.AddRequestComment(ctx, requestID, comment, solve)
+	if err != nil {
+		return fmt.Errorf("failed to add comment: %w", err)
+	}
+
+	if solve {
+		color.Green("✓ Added comment to request #%d and marked it %s\n", request.ID, request.Status)
+	} else {
+		color.Green("✓ Added comment to request #%d\n", request.ID)
+	}
+
+	return nil
+}
+
+// Display a request with its conversation. The sideloaded authors name the
+// comments, since end users often can't look other users up.
+func displayRequest(request *client.Request, comments []client.Comment, authors []client.User, raw bool) {
+	color.Cyan("Request #%d: %s\n", request.ID, request.Subject)
+	color.White(strings.Repeat("─", 80) + "\n")
+
+	fmt.Printf("Status:       %s\n", getColoredStatus(request.Status))
+	if request.Priority != "" {
+		fmt.Printf("Priority:     %s\n", getColoredPriority(request.Priority))
+	}
+	if request.Type != "" {
+		fmt.Printf("Type:         %s\n", request.Type)
+	}
+	color.White("Created:      %s\n", formatDate(request.CreatedAt))
+	color.White("Updated:      %s\n", formatDate(request.UpdatedAt))
+	if request.DueAt != nil && *request.DueAt != "" {
+		color.White("Due:          %s\n", formatDate(*request.DueAt))
+	}
+
+	authorNames := make(map[int64]string, len(authors))
+	for _, author := range authors {
+		authorNames[author.ID] = author.Name
+	}
+
+	color.White("\nConversation (%d comments):\n\n", len(comments))
+	for _, comment := range comments {
+		author := authorNames[comment.AuthorID]
+		if author == "" {
+			author = fmt.Sprintf("User %d", comment.AuthorID)
+		}
+		color.White("%s | %s\n", author, formatDate(comment.CreatedAt))
+		color.White("%s\n\n", commentText(&comment, raw))
+	}
+}
+
+// SuggestRequestCommand points at 'zd request' when the tickets API refused
+// a ticket command, which is what happens with end-user and light agent
+// credentials
+func SuggestRequestCommand(cmd *cobra.Command, err error) {
+	if cmd == nil || cmd.Parent() == nil || cmd.Parent().Name() != "ticket" || !client.IsForbiddenError(err) {
+		return
+	}
+
+	suggestion := "zd request --help"
+	if containsString(requestSubcommands, cmd.Name()) {
+		suggestion = "zd request " + cmd.Name()
+	}
+	fmt.Fprintf(os.Stderr, "\nHint: end-user and light agent credentials can't use the tickets API.\nUse '%s' for the tickets you requested or are CC'd on.\n", suggestion)
+}
@@ -0,0 +1,263 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"zd-cli/internal/client"
+	"zd-cli/internal/output"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// rolePermission is one permission of a custom role, for CSV output
+type rolePermission struct {
+	Permission string `json:"permission"`
+	Access     string `json:"access"`
+}
+
+// NewRoleCommand creates the role command
+func NewRoleCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "role",
+		Short: "Browse custom agent roles",
+		Long:  "List the custom agent roles of a Zendesk instance and the permissions they grant.",
+	}
+
+	cmd.AddCommand(newRoleListCommand())
+	cmd.AddCommand(newRoleShowCommand())
+
+	// Add global output format flag to all subcommands
+	cmd.PersistentFlags().StringP("output", "o", "table", "Output format: table, json, csv")
+
+	return cmd
+}
+
+func newRoleListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List custom roles",
+		Long: `List custom roles. With --permissions, show a matrix of every permission
+against every role instead. Examples:
+  zd role list
+  zd role list --permissions`,
+		RunE: withClient(runRoleList),
+	}
+
+	cmd.Flags().Bool("permissions", false, "Show a matrix of permissions by role")
+	cmd.Flags().Bool("refresh", false, "Bypass cache and fetch fresh data")
+
+	return cmd
+}
+
+func newRoleShowCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show <role-id|name>",
+		Short: "Show a custom role and its permissions",
+		Args:  cobra.ExactArgs(1),
+		RunE:  withClient(runRoleShow),
+	}
+
+	cmd.Flags().Bool("refresh", false, "Bypass cache and fetch fresh data")
+
+	return cmd
+}
+
+func runRoleList(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	ctx := context.Background()
+
+	roles, err := zdClient.ListCustomRoles(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list custom roles: %w", err)
+	}
+
+	if len(roles) == 0 {
+		color.Yellow("No custom roles found.\n")
+		return nil
+	}
+
+	sort.Slice(roles, func(i, j int) bool { return roles[i].Name < roles[j].Name })
+
+	format, _ := cmd.Flags().GetString("output")
+	writer := output.NewWriter(output.Format(format))
+
+	switch output.Format(format) {
+	case output.FormatJSON:
+		return writer.WriteJSON(roles)
+
+	case output.FormatCSV:
+		headers := []string{"id", "name", "description", "team_member_count", "created_at", "updated_at"}
+		return writer.WriteCSV(roles, headers)
+
+	default:
+		// Table format (default)
+		if permissions, _ := cmd.Flags().GetBool("permissions"); permissions {
+			displayRoleMatrix(roles)
+			return nil
+		}
+
+		color.Cyan("Custom Roles (%d)\n", len(roles))
+		color.White(strings.Repeat("─", 80) + "\n")
+
+		table := output.NewTable("ID", "NAME", "AGENTS", "DESCRIPTION")
+		table.SetFlexColumn(3)
+		for _, role := range roles {
+			table.AddRow(fmt.Sprintf("%d", role.ID), role.Name, fmt.Sprintf("%d", role.TeamMemberCount), orDash(role.Description))
+		}
+		table.Print()
+
+		return nil
+	}
+}
+
+func runRoleShow(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	ctx := context.Background()
+
+	roleID, err := resolveCustomRole(ctx, zdClient, args[0])
+	if err != nil {
+		return err
+	}
+
+	role, err := zdClient.GetCustomRole(ctx, roleID)
+	if err != nil {
+		return fmt.Errorf("failed to get custom role: %w", err)
+	}
+
+	format, _ := cmd.Flags().GetString("output")
+	writer := output.NewWriter(output.Format(format))
+
+	switch output.Format(format) {
+	case output.FormatJSON:
+		return writer.WriteJSON(role)
+
+	case output.FormatCSV:
+		var rows []rolePermission
+		for _, key := range rolePermissionKeys([]client.CustomRole{*role}) {
+			rows = append(rows, rolePermission{Permission: key, Access: formatRolePermission(role.Configuration[key], false)})
+		}
+		return writer.WriteCSV(rows, []string{"permission", "access"})
+
+	default:
+		// Table format (default)
+		displayRole(role)
+		return nil
+	}
+}
+
+// resolveCustomRole turns a custom role ID or name into a role ID
+func resolveCustomRole(ctx context.Context, zdClient *client.Client, value string) (int64, error) {
+	if id, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return id, nil
+	}
+
+	roles, err := zdClient.ListCustomRoles(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list custom roles: %w", err)
+	}
+
+	for _, role := range roles {
+		if strings.EqualFold(role.Name, value) {
+			return role.ID, nil
+		}
+	}
+
+	return 0, fmt.Errorf("custom role not found: %s (see 'zd role list')", value)
+}
+
+// Display full custom role details
+func displayRole(role *client.CustomRole) {
+	color.Cyan("Role: %s\n", role.Name)
+	color.White(strings.Repeat("─", 80) + "\n")
+
+	color.White("ID:           %d\n", role.ID)
+	if role.Description != "" {
+		color.White("Description:  %s\n", role.Description)
+	}
+	color.White("Agents:       %d\n", role.TeamMemberCount)
+
+	// Dates
+	color.White("\nDates:\n")
+	color.White("  Created:      %s\n", formatDate(role.CreatedAt))
+	color.White("  Last Updated: %s\n", formatDate(role.UpdatedAt))
+
+	color.White("\nPermissions:\n")
+	table := output.NewTable("PERMISSION", "ACCESS")
+	for _, key := range rolePermissionKeys([]client.CustomRole{*role}) {
+		table.AddRow(rolePermissionLabel(key), formatRolePermission(role.Configuration[key], true))
+	}
+	table.Print()
+}
+
+// displayRoleMatrix prints a permission per row with a column per role
+func displayRoleMatrix(roles []client.CustomRole) {
+	color.Cyan("Permissions by Role (%d roles)\n", len(roles))
+	color.White(strings.Repeat("─", 80) + "\n")
+
+	headers := []string{"PERMISSION"}
+	for _, role := range roles {
+		headers = append(headers, strings.ToUpper(role.Name))
+	}
+
+	table := output.NewTable(headers...)
+	table.SetFlexColumn(0)
+	for _, key := range rolePermissionKeys(roles) {
+		row := []string{rolePermissionLabel(key)}
+		for _, role := range roles {
+			row = append(row, formatRolePermission(role.Configuration[key], true))
+		}
+		table.AddRow(row...)
+	}
+	table.Print()
+}
+
+// rolePermissionKeys returns the permissions configured in any of the
+// roles, sorted
+func rolePermissionKeys(roles []client.CustomRole) []string {
+	seen := make(map[string]bool)
+	var keys []string
+	for _, role := range roles {
+		for key := range role.Configuration {
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// rolePermissionLabel turns a permission key into a label, e.g.
+// "ticket_access" into "Ticket access"
+func rolePermissionLabel(key string) string {
+	label := strings.ReplaceAll(key, "_", " ")
+	if label == "" {
+		return label
+	}
+	return strings.ToUpper(label[:1]) + label[1:]
+}
+
+// formatRolePermission formats a permission value. Booleans become ✓ and -
+// with symbols, or true and false without.
+func formatRolePermission(value interface{}, symbols bool) string {
+	switch v := value.(type) {
+	case nil:
+		return "-"
+	case bool:
+		if !symbols {
+			return strconv.FormatBool(v)
+		}
+		if v {
+			return "✓"
+		}
+		return "-"
+	case string:
+		return orDash(v)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
@@ -0,0 +1,68 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"zd-cli/internal/client"
+	"zd-cli/internal/output"
+
+	"github.com/spf13/cobra"
+)
+
+// runFunc is the body of a command that talks to the API, given a client
+// built from the config and global flags
+type runFunc func(cmd *cobra.Command, args []string, zdClient *client.Client) error
+
+// withClient turns run into a RunE. The client is built first, honoring
+// --instance, --refresh, --cache-ttl, and the other global flags, and the
+// error run returns goes through HandleError, so every command reports API
+// errors the same way. Usage isn't printed for errors from run, since by
+// then the command was called correctly.
+func withClient(run runFunc) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		zdClient, err := getClientFromFlags(cmd)
+		if err != nil {
+			return err
+		}
+
+		cmd.SilenceUsage = true
+		return HandleError(run(cmd, args, zdClient))
+	}
+}
+
+// runContext returns a context for a command that makes many requests, such
+// as an --all listing. Each request keeps the timeout from --timeout or the
+// instance, and limit caps the whole run. With --timeout 0 there's no cap.
+func runContext(zdClient *client.Client, limit time.Duration) (context.Context, context.CancelFunc) {
+	if zdClient.Timeout() <= 0 {
+		return context.WithCancel(context.Background())
+	}
+	return context.WithTimeout(context.Background(), limit)
+}
+
+// jobContext is runContext for bulk jobs and imports, whose rate limit waits
+// and job polling can outlast the per-request timeout, so only limit applies
+func jobContext(zdClient *client.Client, limit time.Duration) (context.Context, context.CancelFunc) {
+	ctx, cancel := runContext(zdClient, limit)
+	return client.WithoutTimeout(ctx), cancel
+}
+
+// writeOutput writes data as JSON, or as CSV with headers, when --output asks
+// for it, and otherwise calls table to print it as a table
+func writeOutput(cmd *cobra.Command, data interface{}, headers []string, table func()) error {
+	format, _ := cmd.Flags().GetString("output")
+	writer := output.NewWriter(output.Format(format))
+
+	switch output.Format(format) {
+	case output.FormatJSON:
+		return writer.WriteJSON(data)
+
+	case output.FormatCSV:
+		return writer.WriteCSV(data, headers)
+
+	default:
+		table()
+		return nil
+	}
+}
@@ -0,0 +1,72 @@
+package commands
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"zd-cli/internal/client"
+	"zd-cli/internal/config"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// writeGuardFromFlags returns the check for requests that change data on
+// instance. A read_only instance refuses them all. On a production instance
+// the first one must be confirmed by typing the instance's name, unless
+// --confirm-production is given, and the answer holds for the rest of the
+// command.
+func writeGuardFromFlags(cmd *cobra.Command, instance *config.Instance) (client.WriteGuard, error) {
+	env, err := instance.GetEnvironment()
+	if err != nil {
+		return nil, err
+	}
+
+	if instance.ReadOnly {
+		return func(req *http.Request) error {
+			return fmt.Errorf("instance '%s' is read-only, so %s %s wasn't sent (set read_only = false in its config to allow changes)",
+				instance.Name, req.Method, apiPath(req))
+		}, nil
+	}
+
+	confirmed, _ := cmd.Flags().GetBool("confirm-production")
+	if env != config.EnvironmentProduction || confirmed {
+		return nil, nil
+	}
+
+	var once sync.Once
+	var answer error
+	return func(req *http.Request) error {
+		once.Do(func() {
+			answer = confirmProductionWrite(instance, req)
+		})
+		return answer
+	}, nil
+}
+
+// confirmProductionWrite asks before the first change to a production
+// instance
+func confirmProductionWrite(instance *config.Instance, req *http.Request) error {
+	if !interactive() {
+		return fmt.Errorf("instance '%s' is production: --confirm-production is required to change it when not running interactively", instance.Name)
+	}
+
+	color.Yellow("WARNING: '%s' is a production instance (%s.zendesk.com)\n", instance.Name, instance.Subdomain)
+	color.Yellow("This command changes data there, starting with %s %s\n", req.Method, apiPath(req))
+
+	name, err := promptString(fmt.Sprintf("Type '%s' to confirm", instance.Name), true, "--confirm-production")
+	if err != nil {
+		return err
+	}
+	if name != instance.Name {
+		return fmt.Errorf("cancelled: nothing was changed on '%s'", instance.Name)
+	}
+	return nil
+}
+
+// apiPath returns a request's path without the /api/v2 prefix
+func apiPath(req *http.Request) string {
+	return strings.TrimPrefix(req.URL.Path, "/api/v2")
+}
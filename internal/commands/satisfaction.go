@@ -0,0 +1,161 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"zd-cli/internal/client"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// satisfactionScores are the score filters accepted by the satisfaction ratings API
+var satisfactionScores = []string{
+	"offered", "unoffered", "received", "received_with_comment", "received_without_comment",
+	"good", "good_with_comment", "good_without_comment",
+	"bad", "bad_with_comment", "bad_without_comment",
+}
+
+// NewSatisfactionCommand creates the satisfaction rating command
+func NewSatisfactionCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "satisfaction",
+		Aliases: []string{"csat"},
+		Short:   "Review customer satisfaction ratings",
+		Long:    "List customer satisfaction (CSAT) ratings, filtered by score and date.",
+	}
+
+	cmd.AddCommand(newSatisfactionListCommand())
+
+	// Add global output format flag to all subcommands
+	cmd.PersistentFlags().StringP("output", "o", "table", "Output format: table, json, csv")
+
+	return cmd
+}
+
+func newSatisfactionListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List satisfaction ratings",
+		Long: `List satisfaction ratings, most recent first. Examples:
+  zd satisfaction list --score bad
+  zd satisfaction list --score received_with_comment --since 2026-01-01
+  zd satisfaction list --since 2026-01-01 -o csv > csat.csv`,
+		RunE: withClient(runSatisfactionList),
+	}
+
+	cmd.Flags().Int("page", 1, "Page number")
+	cmd.Flags().Int("per-page", 100, "Results per page (max 100)")
+	cmd.Flags().String("score", "", "Filter by score: "+strings.Join(satisfactionScores, ", "))
+	cmd.Flags().String("since", "", "Only ratings since: Unix timestamp, RFC3339, YYYY-MM-DD, or relative (-7d, yesterday)")
+	cmd.Flags().Bool("refresh", false, "Bypass cache and fetch fresh data")
+
+	cmd.RegisterFlagCompletionFunc("score", cobra.FixedCompletions(satisfactionScores, cobra.ShellCompDirectiveNoFileComp))
+
+	return cmd
+}
+
+func runSatisfactionList(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	page, _ := cmd.Flags().GetInt("page")
+	perPage, _ := cmd.Flags().GetInt("per-page")
+	score, _ := cmd.Flags().GetString("score")
+	since, _ := cmd.Flags().GetString("since")
+
+	if perPage > 100 {
+		perPage = 100
+	}
+
+	if score != "" && !slices.Contains(satisfactionScores, score) {
+		return fmt.Errorf("invalid score %q: use one of %s", score, strings.Join(satisfactionScores, ", "))
+	}
+
+	var startTime int64
+	if since != "" {
+		t, err := parseTimestamp(since)
+		if err != nil {
+			return err
+		}
+		startTime = t.Unix()
+	}
+
+	ctx := context.Background()
+
+	resp, err := zdClient.ListSatisfactionRatings(ctx, page, perPage, score, startTime)
+	if err != nil {
+		return fmt.Errorf("failed to list satisfaction ratings: %w", err)
+	}
+
+	if len(resp.SatisfactionRatings) == 0 {
+		color.Yellow("No satisfaction ratings found.\n")
+		return nil
+	}
+
+	return outputSatisfactionRatings(cmd, resp.SatisfactionRatings, page, resp.Count, resp.NextPage)
+}
+
+// outputSatisfactionRatings outputs satisfaction ratings in the requested format
+func outputSatisfactionRatings(cmd *cobra.Command, ratings []client.SatisfactionRating, page, total int, nextPage string) error {
+	headers := []string{"id", "score", "comment", "reason", "ticket_id", "requester_id", "assignee_id", "group_id", "created_at", "updated_at"}
+	return writeOutput(cmd, ratings, headers, func() {
+		color.Cyan("Satisfaction Ratings (Page %d, showing %d of %d total)\n", page, len(ratings), total)
+		color.White(strings.Repeat("─", 80) + "\n\n")
+
+		good, bad := 0, 0
+		for i, rating := range ratings {
+			switch rating.Score {
+			case "good":
+				good++
+			case "bad":
+				bad++
+			}
+			displaySatisfactionRating(&rating, i+1)
+		}
+
+		if good+bad > 0 {
+			fmt.Println()
+			color.White("This page: %s good, %s bad (%.0f%% satisfied)\n",
+				color.GreenString("%d", good),
+				color.RedString("%d", bad),
+				float64(good)*100/float64(good+bad))
+		}
+
+		// Show pagination info
+		if nextPage != "" {
+			fmt.Println()
+			color.White("More results available. Use --page %d to see next page.\n", page+1)
+		}
+	})
+}
+
+// Display a satisfaction rating (compact format)
+func displaySatisfactionRating(rating *client.SatisfactionRating, index int) {
+	score := rating.Score
+	switch score {
+	case "good":
+		score = color.GreenString("good")
+	case "bad":
+		score = color.RedString("bad")
+	default:
+		score = color.HiBlackString(score)
+	}
+
+	fmt.Printf("#%-3d [%s] Ticket #%d | %s\n",
+		index,
+		score,
+		rating.TicketID,
+		formatDate(rating.CreatedAt))
+
+	if rating.Comment != "" {
+		comment := strings.ReplaceAll(rating.Comment, "\n", " ")
+		if len(comment) > 200 {
+			comment = comment[:200] + "..."
+		}
+		color.White("     \"%s\"\n", comment)
+	}
+	if rating.Reason != "" {
+		color.White("     Reason: %s\n", rating.Reason)
+	}
+}
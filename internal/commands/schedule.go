@@ -0,0 +1,251 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"zd-cli/internal/client"
+	"zd-cli/internal/output"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// minutesPerDay is the length of a day in schedule intervals
+const minutesPerDay = 24 * 60
+
+// scheduleWithHolidays is a schedule with its holidays, for JSON output
+type scheduleWithHolidays struct {
+	*client.Schedule
+	Holidays []client.Holiday `json:"holidays"`
+}
+
+// NewScheduleCommand creates the schedule command
+func NewScheduleCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule",
+		Short: "Browse business hours schedules and holidays",
+		Long: `List the business hours schedules of a Zendesk instance, with their weekly
+hours and holidays. SLA policies and business hours metrics only count time
+inside these hours.`,
+	}
+
+	cmd.AddCommand(newScheduleListCommand())
+	cmd.AddCommand(newScheduleShowCommand())
+
+	// Add global output format flag to all subcommands
+	cmd.PersistentFlags().StringP("output", "o", "table", "Output format: table, json, csv")
+
+	return cmd
+}
+
+func newScheduleListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List schedules",
+		RunE:  withClient(runScheduleList),
+	}
+
+	cmd.Flags().Bool("refresh", false, "Bypass cache and fetch fresh data")
+
+	return cmd
+}
+
+func newScheduleShowCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show <schedule-id|name>",
+		Short: "Show a schedule's weekly hours and holidays",
+		Long: `Show a schedule's weekly business hours and its holidays. With -o csv the
+holidays are listed, one per row. Examples:
+  zd schedule show 360000444444
+  zd schedule show "EMEA Support" --upcoming
+  zd schedule show 360000444444 -o csv > holidays.csv`,
+		Args: cobra.ExactArgs(1),
+		RunE: withClient(runScheduleShow),
+	}
+
+	cmd.Flags().Bool("upcoming", false, "Only show holidays that haven't ended yet")
+	cmd.Flags().Bool("refresh", false, "Bypass cache and fetch fresh data")
+
+	return cmd
+}
+
+func runScheduleList(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	ctx := context.Background()
+
+	schedules, err := zdClient.ListSchedules(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list schedules: %w", err)
+	}
+
+	if len(schedules) == 0 {
+		color.Yellow("No schedules found.\n")
+		return nil
+	}
+
+	headers := []string{"id", "name", "time_zone", "created_at", "updated_at"}
+	return writeOutput(cmd, schedules, headers, func() {
+		color.Cyan("Schedules (%d)\n", len(schedules))
+		color.White(strings.Repeat("─", 80) + "\n")
+
+		table := output.NewTable("ID", "NAME", "TIME ZONE", "HOURS/WEEK")
+		table.SetFlexColumn(1)
+		for _, schedule := range schedules {
+			table.AddRow(
+				fmt.Sprintf("%d", schedule.ID),
+				schedule.Name,
+				schedule.TimeZone,
+				formatScheduleHours(scheduleMinutes(schedule.Intervals)))
+		}
+		table.Print()
+	})
+}
+
+func runScheduleShow(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	ctx := context.Background()
+
+	scheduleID, err := resolveSchedule(ctx, zdClient, args[0])
+	if err != nil {
+		return err
+	}
+
+	schedule, err := zdClient.GetSchedule(ctx, scheduleID)
+	if err != nil {
+		return fmt.Errorf("failed to get schedule: %w", err)
+	}
+
+	holidays, err := zdClient.ListHolidays(ctx, scheduleID)
+	if err != nil {
+		return fmt.Errorf("failed to list holidays: %w", err)
+	}
+
+	sort.Slice(holidays, func(i, j int) bool { return holidays[i].StartDate < holidays[j].StartDate })
+	if upcoming, _ := cmd.Flags().GetBool("upcoming"); upcoming {
+		holidays = upcomingHolidays(holidays, time.Now())
+	}
+
+	format, _ := cmd.Flags().GetString("output")
+	writer := output.NewWriter(output.Format(format))
+
+	switch output.Format(format) {
+	case output.FormatJSON:
+		if holidays == nil {
+			holidays = []client.Holiday{}
+		}
+		return writer.WriteJSON(scheduleWithHolidays{Schedule: schedule, Holidays: holidays})
+
+	case output.FormatCSV:
+		headers := []string{"id", "name", "start_date", "end_date"}
+		return writer.WriteCSV(holidays, headers)
+
+	default:
+		// Table format (default)
+		displaySchedule(schedule, holidays)
+		return nil
+	}
+}
+
+// resolveSchedule turns a schedule ID or name into a schedule ID
+func resolveSchedule(ctx context.Context, zdClient *client.Client, value string) (int64, error) {
+	if id, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return id, nil
+	}
+
+	schedules, err := zdClient.ListSchedules(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list schedules: %w", err)
+	}
+
+	for _, schedule := range schedules {
+		if strings.EqualFold(schedule.Name, value) {
+			return schedule.ID, nil
+		}
+	}
+
+	return 0, fmt.Errorf("schedule not found: %s (see 'zd schedule list')", value)
+}
+
+// Display full schedule details
+func displaySchedule(schedule *client.Schedule, holidays []client.Holiday) {
+	color.Cyan("Schedule: %s\n", schedule.Name)
+	color.White(strings.Repeat("─", 80) + "\n")
+
+	color.White("ID:           %d\n", schedule.ID)
+	color.White("Time Zone:    %s\n", schedule.TimeZone)
+	color.White("Hours/Week:   %s\n", formatScheduleHours(scheduleMinutes(schedule.Intervals)))
+
+	// Weekly hours, Monday first
+	byDay := make(map[time.Weekday][]string)
+	for _, interval := range schedule.Intervals {
+		day := time.Weekday(interval.StartTime / minutesPerDay % 7)
+		byDay[day] = append(byDay[day], fmt.Sprintf("%s–%s",
+			formatScheduleTime(interval.StartTime-int(day)*minutesPerDay),
+			formatScheduleTime(interval.EndTime-int(day)*minutesPerDay)))
+	}
+
+	color.White("\nHours:\n")
+	for i := 1; i <= 7; i++ {
+		day := time.Weekday(i % 7)
+		hours := "Closed"
+		if len(byDay[day]) > 0 {
+			hours = strings.Join(byDay[day], ", ")
+		}
+		color.White("  %-12s %s\n", day.String()+":", hours)
+	}
+
+	color.White("\nHolidays:\n")
+	if len(holidays) == 0 {
+		color.White("  None\n")
+	}
+	for _, holiday := range holidays {
+		dates := holiday.StartDate
+		if holiday.EndDate != "" && holiday.EndDate != holiday.StartDate {
+			dates += " – " + holiday.EndDate
+		}
+		color.White("  %-25s %s\n", dates, holiday.Name)
+	}
+}
+
+// upcomingHolidays returns the holidays that end today or later
+func upcomingHolidays(holidays []client.Holiday, now time.Time) []client.Holiday {
+	today := now.Format("2006-01-02")
+	var upcoming []client.Holiday
+	for _, holiday := range holidays {
+		end := holiday.EndDate
+		if end == "" {
+			end = holiday.StartDate
+		}
+		if end >= today {
+			upcoming = append(upcoming, holiday)
+		}
+	}
+	return upcoming
+}
+
+// scheduleMinutes totals the business minutes in a week of intervals
+func scheduleMinutes(intervals []client.ScheduleInterval) int {
+	total := 0
+	for _, interval := range intervals {
+		total += interval.EndTime - interval.StartTime
+	}
+	return total
+}
+
+// formatScheduleHours formats a number of minutes as hours, e.g. "40h" or
+// "37h 30m"
+func formatScheduleHours(minutes int) string {
+	if minutes%60 == 0 {
+		return fmt.Sprintf("%dh", minutes/60)
+	}
+	return fmt.Sprintf("%dh %dm", minutes/60, minutes%60)
+}
+
+// formatScheduleTime formats minutes since midnight as HH:MM. An interval
+// ending at midnight ends at 24:00.
+func formatScheduleTime(minutes int) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
@@ -0,0 +1,331 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"zd-cli/internal/client"
+	"zd-cli/internal/output"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// searchTypes are the result types the unified search API can return
+var searchTypes = []string{"ticket", "user", "organization", "group"}
+
+// searchSortFields are the sort_by values accepted by the search API
+var searchSortFields = []string{"updated_at", "created_at", "priority", "status", "ticket_type"}
+
+// searchResultRow is the flattened form of a search result used for CSV output
+type searchResultRow struct {
+	ResultType string `json:"result_type"`
+	ID         int64  `json:"id"`
+	Name       string `json:"name"`
+	Status     string `json:"status"`
+	Email      string `json:"email"`
+	URL        string `json:"url"`
+	UpdatedAt  string `json:"updated_at"`
+}
+
+// NewSearchCommand creates the search command
+func NewSearchCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "search <query>",
+		Short: "Search tickets, users, organizations, and groups",
+		Long: `Search across Zendesk using the full search query language. Results of
+every type are returned unless --type is given. Examples:
+  zd search "acme"
+  zd search "acme" --type organization
+  zd search "status:open assignee:me"
+  zd search "type:user role:agent"
+  zd search "status:open" --sort-by updated_at --order asc --all
+  zd search "type:ticket billing" --created-after -7d`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: withClient(runSearch),
+	}
+
+	cmd.Flags().String("type", "", "Only return one result type: "+strings.Join(searchTypes, ", "))
+	cmd.Flags().StringP("output", "o", "table", "Output format: table, json, csv")
+	cmd.Flags().Bool("refresh", false, "Bypass cache and fetch fresh data")
+	addSearchPagingFlags(cmd)
+	addDateRangeFlags(cmd)
+
+	cmd.RegisterFlagCompletionFunc("type", cobra.FixedCompletions(searchTypes, cobra.ShellCompDirectiveNoFileComp))
+
+	return cmd
+}
+
+func runSearch(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	resultType, _ := cmd.Flags().GetString("type")
+
+	if resultType != "" && !slices.Contains(searchTypes, resultType) {
+		return fmt.Errorf("invalid type %q: use one of %s", resultType, strings.Join(searchTypes, ", "))
+	}
+
+	opts, all, err := searchOptionsFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	dates, err := dateRangeQuery(cmd)
+	if err != nil {
+		return err
+	}
+
+	query := strings.Join(args, " ")
+	if dates != "" {
+		query += " " + dates
+	}
+	if resultType != "" {
+		query = fmt.Sprintf("type:%s %s", resultType, query)
+	}
+
+	if all {
+		ctx, cancel := runContext(zdClient, 5*time.Minute)
+		defer cancel()
+
+		var results []client.SearchResult
+		total, err := zdClient.SearchAll(ctx, query, opts, func(page []client.SearchResult) error {
+			results = append(results, page...)
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to search: %w", err)
+		}
+
+		if len(results) == 0 {
+			color.Yellow("No results found matching '%s'.\n", query)
+			return nil
+		}
+
+		return outputSearchResults(cmd, results, 0, total, "")
+	}
+
+	ctx := context.Background()
+
+	resp, err := zdClient.Search(ctx, query, opts)
+	if err != nil {
+		return fmt.Errorf("failed to search: %w", err)
+	}
+
+	if len(resp.Results) == 0 {
+		color.Yellow("No results found matching '%s'.\n", query)
+		return nil
+	}
+
+	return outputSearchResults(cmd, resp.Results, opts.Page, resp.Count, resp.NextPage)
+}
+
+// addSearchPagingFlags adds the paging and sorting flags shared by the search commands
+func addSearchPagingFlags(cmd *cobra.Command) {
+	cmd.Flags().Int("page", 1, "Page number")
+	cmd.Flags().Int("per-page", 100, "Results per page (max 100)")
+	cmd.Flags().String("sort-by", "", "Sort by: "+strings.Join(searchSortFields, ", ")+" (default: relevance)")
+	cmd.Flags().String("order", "desc", "Sort order: asc, desc")
+	cmd.Flags().Bool("all", false, fmt.Sprintf("Fetch every page of results (the API returns at most %d)", client.MaxSearchResults))
+
+	cmd.RegisterFlagCompletionFunc("sort-by", cobra.FixedCompletions(searchSortFields, cobra.ShellCompDirectiveNoFileComp))
+	cmd.RegisterFlagCompletionFunc("order", cobra.FixedCompletions([]string{"asc", "desc"}, cobra.ShellCompDirectiveNoFileComp))
+}
+
+// searchOptionsFromFlags reads the paging and sorting flags and reports
+// whether --all was given
+func searchOptionsFromFlags(cmd *cobra.Command) (client.SearchOptions, bool, error) {
+	page, _ := cmd.Flags().GetInt("page")
+	perPage, _ := cmd.Flags().GetInt("per-page")
+	sortBy, _ := cmd.Flags().GetString("sort-by")
+	order, _ := cmd.Flags().GetString("order")
+	all, _ := cmd.Flags().GetBool("all")
+
+	if perPage > 100 {
+		perPage = 100
+	}
+
+	if sortBy != "" && !slices.Contains(searchSortFields, sortBy) {
+		return client.SearchOptions{}, false, fmt.Errorf("invalid sort field %q: use one of %s", sortBy, strings.Join(searchSortFields, ", "))
+	}
+	if order != "asc" && order != "desc" {
+		return client.SearchOptions{}, false, fmt.Errorf("invalid order %q: use asc or desc", order)
+	}
+
+	opts := client.SearchOptions{
+		Page:    page,
+		PerPage: perPage,
+		SortBy:  sortBy,
+	}
+
+	// sort_order only applies when a sort field is chosen
+	if sortBy != "" {
+		opts.SortOrder = order
+	}
+
+	// --all always walks from the first page in the largest pages allowed
+	if all {
+		opts.Page = 1
+		opts.PerPage = 100
+	}
+
+	return opts, all, nil
+}
+
+// dateRangeFlags maps the date range flags to the search keyword and
+// comparison they produce
+var dateRangeFlags = []struct {
+	flag, keyword, op string
+}{
+	{"created-after", "created", ">"},
+	{"created-before", "created", "<"},
+	{"updated-after", "updated", ">"},
+	{"updated-before", "updated", "<"},
+}
+
+// addDateRangeFlags adds the --created-after/--created-before and
+// --updated-after/--updated-before flags
+func addDateRangeFlags(cmd *cobra.Command) {
+	for _, f := range dateRangeFlags {
+		what, when, _ := strings.Cut(f.flag, "-")
+		cmd.Flags().String(f.flag, "", fmt.Sprintf("Only results %s %s this time: YYYY-MM-DD, RFC3339, or relative (-7d, yesterday)", what, when))
+	}
+}
+
+// dateRangeQuery turns the date range flags into search terms such as
+// "created>2026-01-01T00:00:00Z". It returns "" when no flag is set.
+func dateRangeQuery(cmd *cobra.Command) (string, error) {
+	var terms []string
+	for _, f := range dateRangeFlags {
+		value, _ := cmd.Flags().GetString(f.flag)
+		if value == "" {
+			continue
+		}
+
+		t, err := parseTimestamp(value)
+		if err != nil {
+			return "", fmt.Errorf("--%s: %w", f.flag, err)
+		}
+		terms = append(terms, f.keyword+f.op+t.UTC().Format(time.RFC3339))
+	}
+
+	return strings.Join(terms, " "), nil
+}
+
+// outputSearchResults outputs mixed search results in the requested format
+func outputSearchResults(cmd *cobra.Command, results []client.SearchResult, page, total int, nextPage string) error {
+	format, _ := cmd.Flags().GetString("output")
+	writer := output.NewWriter(output.Format(format))
+
+	switch output.Format(format) {
+	case output.FormatJSON:
+		return writer.WriteJSON(results)
+
+	case output.FormatCSV:
+		rows := make([]searchResultRow, len(results))
+		for i, result := range results {
+			rows[i] = newSearchResultRow(result)
+		}
+		headers := []string{"result_type", "id", "name", "status", "email", "url", "updated_at"}
+		return writer.WriteCSV(rows, headers)
+
+	default:
+		// Table format (default)
+		if page > 0 {
+			color.Cyan("Search Results (Page %d, showing %d of %d total)\n", page, len(results), total)
+		} else {
+			color.Cyan("Found %d result(s), showing %d\n", total, len(results))
+		}
+		color.White(strings.Repeat("─", 80) + "\n")
+
+		// Group results by type, in a fixed order
+		for _, resultType := range searchTypes {
+			var matches []client.SearchResult
+			for _, result := range results {
+				if result.ResultType == resultType {
+					matches = append(matches, result)
+				}
+			}
+			if len(matches) == 0 {
+				continue
+			}
+
+			fmt.Println()
+			color.Cyan("%ss (%d)\n", strings.ToUpper(resultType[:1])+resultType[1:], len(matches))
+			printSearchResultTable(resultType, matches)
+		}
+
+		// Show pagination info
+		if nextPage != "" {
+			fmt.Println()
+			color.White("More results available. Use --page %d to see next page, or --all.\n", page+1)
+		} else if page == 0 && total > len(results) {
+			fmt.Println()
+			color.White("Showing the first %d results. Narrow the query to see more.\n", len(results))
+		}
+
+		return nil
+	}
+}
+
+// printSearchResultTable prints search results of one type as a table
+func printSearchResultTable(resultType string, results []client.SearchResult) {
+	switch resultType {
+	case "ticket":
+		var tickets []client.Ticket
+		for _, result := range results {
+			tickets = append(tickets, *result.Ticket)
+		}
+		printTicketTable(tickets, 1, true)
+	case "user":
+		var users []client.User
+		for _, result := range results {
+			users = append(users, *result.User)
+		}
+		printUserTable(users, 1, true)
+	case "organization":
+		var orgs []client.Organization
+		for _, result := range results {
+			orgs = append(orgs, *result.Organization)
+		}
+		printOrganizationTable(orgs, 1, true)
+	case "group":
+		var groups []client.Group
+		for _, result := range results {
+			groups = append(groups, *result.Group)
+		}
+		printGroupTable(groups, 1, true)
+	}
+}
+
+// newSearchResultRow flattens a search result into a CSV row
+func newSearchResultRow(result client.SearchResult) searchResultRow {
+	row := searchResultRow{ResultType: result.ResultType}
+
+	switch {
+	case result.Ticket != nil:
+		row.ID = result.Ticket.ID
+		row.Name = result.Ticket.Subject
+		row.Status = result.Ticket.Status
+		row.URL = result.Ticket.URL
+		row.UpdatedAt = result.Ticket.UpdatedAt
+	case result.User != nil:
+		row.ID = result.User.ID
+		row.Name = result.User.Name
+		row.Email = result.User.Email
+		row.URL = result.User.URL
+		row.UpdatedAt = result.User.UpdatedAt
+	case result.Organization != nil:
+		row.ID = result.Organization.ID
+		row.Name = result.Organization.Name
+		row.URL = result.Organization.URL
+		row.UpdatedAt = result.Organization.UpdatedAt
+	case result.Group != nil:
+		row.ID = result.Group.ID
+		row.Name = result.Group.Name
+		row.URL = result.Group.URL
+		row.UpdatedAt = result.Group.UpdatedAt
+	}
+
+	return row
+}
@@ -0,0 +1,240 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"zd-cli/internal/client"
+	"zd-cli/internal/output"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// NewSessionCommand creates the session command
+func NewSessionCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "session",
+		Short: "List and end users' signed-in sessions",
+		Long: `List the browsers and devices users are signed in on, and sign them out.
+When an account may be compromised, sign the user out everywhere with
+'zd session logout-all', then reset their password or suspend them.`,
+	}
+
+	cmd.AddCommand(newSessionListCommand())
+	cmd.AddCommand(newSessionDeleteCommand())
+	cmd.AddCommand(newSessionLogoutAllCommand())
+
+	// Add global output format flag to all subcommands
+	cmd.PersistentFlags().StringP("output", "o", "table", "Output format: table, json, csv")
+
+	return cmd
+}
+
+func newSessionListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list [user-id]",
+		Short: "List sessions, for one user or the whole account",
+		Long: `List a user's sessions, or every session in the account when no user is
+given (admins only). Examples:
+  zd session list
+  zd session list 12345`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: withClient(runSessionList),
+	}
+
+	cmd.Flags().Int("page", 1, "Page number")
+	cmd.Flags().Int("per-page", 25, "Results per page (max 100)")
+
+	return cmd
+}
+
+func newSessionDeleteCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete <user-id> <session-id>",
+		Short: "Sign a user out of one session",
+		Args:  cobra.ExactArgs(2),
+		RunE:  withClient(runSessionDelete),
+	}
+
+	cmd.Flags().Bool("force", false, "Skip confirmation prompt")
+
+	return cmd
+}
+
+func newSessionLogoutAllCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "logout-all <user-id>",
+		Short: "Sign a user out of every session",
+		Long: `Sign a user out of every browser and device. API tokens and OAuth tokens
+are not revoked, and the user can sign in again with their password. Examples:
+  zd session logout-all 12345
+  zd session logout-all 12345 --force && zd user suspend 12345`,
+		Args: cobra.ExactArgs(1),
+		RunE: withClient(runSessionLogoutAll),
+	}
+
+	cmd.Flags().Bool("force", false, "Skip confirmation prompt")
+
+	return cmd
+}
+
+func runSessionList(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	var userID int64
+	if len(args) == 1 {
+		var err error
+		userID, err = strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid user ID: %s", args[0])
+		}
+	}
+
+	page, _ := cmd.Flags().GetInt("page")
+	perPage, _ := cmd.Flags().GetInt("per-page")
+
+	if perPage > 100 {
+		perPage = 100
+	}
+
+	ctx := context.Background()
+
+	resp, err := zdClient.ListSessions(ctx, userID, page, perPage)
+	if err != nil {
+		return fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	if len(resp.Sessions) == 0 {
+		color.Yellow("No sessions found.\n")
+		return nil
+	}
+
+	format, _ := cmd.Flags().GetString("output")
+	writer := output.NewWriter(output.Format(format))
+
+	switch output.Format(format) {
+	case output.FormatJSON:
+		return writer.WriteJSON(resp.Sessions)
+
+	case output.FormatCSV:
+		headers := []string{"id", "user_id", "authenticated_at", "last_seen_at"}
+		return writer.WriteCSV(resp.Sessions, headers)
+
+	default:
+		// Table format (default)
+		color.Cyan("Sessions (Page %d, showing %d of %d total)\n", page, len(resp.Sessions), resp.Count)
+		color.White(strings.Repeat("─", 80) + "\n")
+
+		userIDs := make([]int64, len(resp.Sessions))
+		for i, session := range resp.Sessions {
+			userIDs[i] = session.UserID
+		}
+		names.prefetchUsers(userIDs)
+
+		table := output.NewTable("ID", "USER", "SIGNED IN", "LAST SEEN")
+		table.SetFlexColumn(1)
+		for _, session := range resp.Sessions {
+			table.AddRow(
+				fmt.Sprintf("%d", session.ID),
+				withID(names.userName(session.UserID), session.UserID),
+				formatDate(session.AuthenticatedAt),
+				formatDate(session.LastSeenAt))
+		}
+		table.Print()
+
+		// Show pagination info
+		if resp.NextPage != "" {
+			fmt.Println()
+			color.White("More results available. Use --page %d to see next page.\n", page+1)
+		}
+
+		return nil
+	}
+}
+
+func runSessionDelete(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	userID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid user ID: %s", args[0])
+	}
+	sessionID, err := strconv.ParseInt(args[1], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid session ID: %s", args[1])
+	}
+
+	// Confirm unless --force
+	force, _ := cmd.Flags().GetBool("force")
+	if !force {
+		color.Yellow("This will sign user %d out of session %d.\n", userID, sessionID)
+		confirm, err := promptString("Type 'yes' to confirm", true, "--force")
+		if err != nil {
+			return err
+		}
+		if strings.ToLower(confirm) != "yes" {
+			color.Yellow("Sign out cancelled.\n")
+			return nil
+		}
+	}
+
+	ctx := context.Background()
+
+	if err := zdClient.DeleteSession(ctx, userID, sessionID); err != nil {
+		return fmt.Errorf("failed to delete session: %w", err)
+	}
+
+	color.Green("✓ Signed user #%d out of session %d\n", userID, sessionID)
+
+	return nil
+}
+
+func runSessionLogoutAll(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	userID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid user ID: %s", args[0])
+	}
+
+	ctx := context.Background()
+
+	// Confirm unless --force
+	force, _ := cmd.Flags().GetBool("force")
+	if !force {
+		user, err := zdClient.GetUser(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("failed to get user: %w", err)
+		}
+		sessions, err := zdClient.ListSessions(ctx, userID, 1, 1)
+		if err != nil {
+			return fmt.Errorf("failed to list sessions: %w", err)
+		}
+		if sessions.Count == 0 {
+			color.Yellow("%s has no sessions.\n", userLabel(user))
+			return nil
+		}
+
+		color.Yellow("This will sign %s out of %d session(s).\n", userLabel(user), sessions.Count)
+		confirm, err := promptString("Type 'yes' to confirm", true, "--force")
+		if err != nil {
+			return err
+		}
+		if strings.ToLower(confirm) != "yes" {
+			color.Yellow("Sign out cancelled.\n")
+			return nil
+		}
+	}
+
+	if err := zdClient.DeleteUserSessions(ctx, userID); err != nil {
+		return fmt.Errorf("failed to delete sessions: %w", err)
+	}
+
+	color.Green("✓ Signed user #%d out of every session\n", userID)
+	color.White("API and OAuth tokens still work. To lock the account, run: zd user suspend %d\n", userID)
+
+	return nil
+}
+
+// userLabel names a user with their ID and email, e.g. "Jane Doe (123,
+// jane@example.com)"
+func userLabel(user *client.User) string {
+	return fmt.Sprintf("%s (%d, %s)", user.Name, user.ID, orDash(user.Email))
+}
@@ -0,0 +1,230 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"zd-cli/internal/client"
+	"zd-cli/internal/output"
+
+	"github.com/fatih/color"
+)
+
+// printTicketTable prints tickets as an aligned table, numbering rows from
+// start. --wide adds requester, assignee, group, and last-updated columns.
+func printTicketTable(tickets []client.Ticket, start int, header bool) {
+	headers := []string{"#", "ID", "STATUS", "PRIORITY", "SUBJECT"}
+	if output.Wide() {
+		headers = append(headers, "REQUESTER", "ASSIGNEE", "GROUP", "UPDATED")
+	}
+
+	table := output.NewTable(headers...)
+	table.SetFlexColumn(4)
+	if !header {
+		table.HideHeader()
+	}
+
+	if output.Wide() {
+		names.prefetchTickets(tickets)
+	}
+
+	for i, ticket := range tickets {
+		row := []string{
+			fmt.Sprintf("%d", start+i),
+			fmt.Sprintf("%d", ticket.ID),
+			getColoredStatus(ticket.Status),
+			orDash(getColoredPriority(ticket.Priority)),
+			ticket.Subject,
+		}
+		if output.Wide() {
+			row = append(row,
+				optionalName(&ticket.RequesterID, names.userName),
+				optionalName(ticket.AssigneeID, names.userName),
+				optionalName(ticket.GroupID, names.groupName),
+				formatDate(ticket.UpdatedAt))
+		}
+		table.AddRow(row...)
+	}
+
+	table.Print()
+}
+
+// printUserTable prints users as an aligned table, numbering rows from
+// start. --wide adds organization, phone, time zone, and last-login columns.
+func printUserTable(users []client.User, start int, header bool) {
+	headers := []string{"#", "ID", "NAME", "EMAIL", "ROLE", "STATUS"}
+	if output.Wide() {
+		headers = append(headers, "ORGANIZATION", "PHONE", "TIME ZONE", "LAST LOGIN")
+	}
+
+	table := output.NewTable(headers...)
+	table.SetFlexColumn(2)
+	if !header {
+		table.HideHeader()
+	}
+
+	if output.Wide() {
+		var orgIDs []int64
+		for _, user := range users {
+			if user.OrganizationID != nil {
+				orgIDs = append(orgIDs, *user.OrganizationID)
+			}
+		}
+		names.prefetchOrganizations(orgIDs)
+	}
+
+	for i, user := range users {
+		email := user.Email
+		if email == "" {
+			email = "(no email)"
+		}
+
+		row := []string{
+			fmt.Sprintf("%d", start+i),
+			fmt.Sprintf("%d", user.ID),
+			color.CyanString(user.Name),
+			email,
+			user.Role,
+			orDash(userBadges(&user)),
+		}
+		if output.Wide() {
+			lastLogin := ""
+			if user.LastLoginAt != nil {
+				lastLogin = formatDate(*user.LastLoginAt)
+			}
+			row = append(row,
+				optionalName(user.OrganizationID, names.orgName),
+				orDash(user.Phone),
+				orDash(user.TimeZone),
+				orDash(lastLogin))
+		}
+		table.AddRow(row...)
+	}
+
+	table.Print()
+}
+
+// printOrganizationTable prints organizations as an aligned table, numbering
+// rows from start. --wide adds sharing, group, and created columns.
+func printOrganizationTable(orgs []client.Organization, start int, header bool) {
+	headers := []string{"#", "ID", "NAME", "DOMAINS"}
+	if output.Wide() {
+		headers = append(headers, "SHARING", "GROUP", "CREATED")
+	}
+
+	table := output.NewTable(headers...)
+	table.SetFlexColumn(2)
+	if !header {
+		table.HideHeader()
+	}
+
+	if output.Wide() {
+		var groupIDs []int64
+		for _, org := range orgs {
+			if org.GroupID != nil {
+				groupIDs = append(groupIDs, *org.GroupID)
+			}
+		}
+		names.prefetchGroups(groupIDs)
+	}
+
+	for i, org := range orgs {
+		row := []string{
+			fmt.Sprintf("%d", start+i),
+			fmt.Sprintf("%d", org.ID),
+			color.CyanString(org.Name),
+			orDash(strings.Join(org.DomainNames, ", ")),
+		}
+		if output.Wide() {
+			var sharing []string
+			if org.SharedTickets {
+				sharing = append(sharing, "tickets")
+			}
+			if org.SharedComments {
+				sharing = append(sharing, "comments")
+			}
+			row = append(row,
+				orDash(strings.Join(sharing, ", ")),
+				optionalName(org.GroupID, names.groupName),
+				formatDate(org.CreatedAt))
+		}
+		table.AddRow(row...)
+	}
+
+	table.Print()
+}
+
+// printGroupTable prints groups as an aligned table, numbering rows from
+// start. --wide adds description and created columns.
+func printGroupTable(groups []client.Group, start int, header bool) {
+	headers := []string{"#", "ID", "NAME", "FLAGS"}
+	if output.Wide() {
+		headers = append(headers, "DESCRIPTION", "CREATED")
+	}
+
+	table := output.NewTable(headers...)
+	table.SetFlexColumn(2)
+	if output.Wide() {
+		table.SetFlexColumn(4)
+	}
+	if !header {
+		table.HideHeader()
+	}
+
+	for i, group := range groups {
+		var flags []string
+		if group.Default {
+			flags = append(flags, color.GreenString("default"))
+		}
+		if group.Deleted {
+			flags = append(flags, color.RedString("deleted"))
+		}
+
+		row := []string{
+			fmt.Sprintf("%d", start+i),
+			fmt.Sprintf("%d", group.ID),
+			color.CyanString(group.Name),
+			orDash(strings.Join(flags, " ")),
+		}
+		if output.Wide() {
+			row = append(row,
+				orDash(group.Description),
+				formatDate(group.CreatedAt))
+		}
+		table.AddRow(row...)
+	}
+
+	table.Print()
+}
+
+// userBadges returns the colored verified/suspended/inactive badges for a user
+func userBadges(user *client.User) string {
+	var badges []string
+	if user.Verified {
+		badges = append(badges, color.GreenString("✓"))
+	}
+	if user.Suspended {
+		badges = append(badges, color.RedString("suspended"))
+	}
+	if !user.Active {
+		badges = append(badges, color.YellowString("inactive"))
+	}
+	return strings.Join(badges, " ")
+}
+
+// optionalName formats an optional ID as a name using lookup, showing "-"
+// when it is unset
+func optionalName(id *int64, lookup func(int64) string) string {
+	if id == nil || *id == 0 {
+		return "-"
+	}
+	return lookup(*id)
+}
+
+// orDash returns s, or "-" when s is empty
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
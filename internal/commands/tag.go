@@ -0,0 +1,197 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"zd-cli/internal/client"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// NewTagCommand creates the tag command
+func NewTagCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tag",
+		Short: "Browse Zendesk tags",
+		Long:  "List the tags used in your account and search tag names by prefix.",
+	}
+
+	cmd.AddCommand(newTagListCommand())
+	cmd.AddCommand(newTagSearchCommand())
+
+	// Add global output format flag to all subcommands
+	cmd.PersistentFlags().StringP("output", "o", "table", "Output format: table, json, csv")
+
+	return cmd
+}
+
+func newTagListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the most used tags",
+		RunE:  withClient(runTagList),
+	}
+
+	cmd.Flags().Int("page", 1, "Page number")
+	cmd.Flags().Int("per-page", 100, "Results per page (max 100)")
+	cmd.Flags().Bool("refresh", false, "Bypass cache and fetch fresh data")
+
+	return cmd
+}
+
+func newTagSearchCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "search <prefix>",
+		Short: "Find tags starting with a prefix",
+		Args:  cobra.ExactArgs(1),
+		RunE:  withClient(runTagSearch),
+	}
+
+	cmd.Flags().Bool("refresh", false, "Bypass cache and fetch fresh data")
+
+	return cmd
+}
+
+func runTagList(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	page, _ := cmd.Flags().GetInt("page")
+	perPage, _ := cmd.Flags().GetInt("per-page")
+
+	if perPage > 100 {
+		perPage = 100
+	}
+
+	ctx := context.Background()
+
+	resp, err := zdClient.ListTags(ctx, page, perPage)
+	if err != nil {
+		return fmt.Errorf("failed to list tags: %w", err)
+	}
+
+	if len(resp.Tags) == 0 {
+		color.Yellow("No tags found.\n")
+		return nil
+	}
+
+	return outputTags(cmd, resp.Tags, page, resp.Count, resp.NextPage)
+}
+
+func runTagSearch(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	prefix := args[0]
+	if len(prefix) < 2 {
+		return fmt.Errorf("prefix must be at least 2 characters")
+	}
+
+	ctx := context.Background()
+
+	names, err := zdClient.AutocompleteTags(ctx, prefix)
+	if err != nil {
+		return fmt.Errorf("failed to search tags: %w", err)
+	}
+
+	if len(names) == 0 {
+		color.Yellow("No tags found matching '%s'.\n", prefix)
+		return nil
+	}
+
+	tags := make([]client.Tag, len(names))
+	for i, name := range names {
+		tags[i] = client.Tag{Name: name}
+	}
+
+	return outputTags(cmd, tags, 0, len(tags), "")
+}
+
+// outputTags outputs tags in the requested format
+func outputTags(cmd *cobra.Command, tags []client.Tag, page, total int, nextPage string) error {
+	headers := []string{"name", "count"}
+	return writeOutput(cmd, tags, headers, func() {
+		if page > 0 {
+			color.Cyan("Tags (Page %d, showing %d of %d total)\n", page, len(tags), total)
+		} else {
+			color.Cyan("Found %d tag(s)\n", len(tags))
+		}
+		color.White(strings.Repeat("─", 80) + "\n\n")
+
+		for i, tag := range tags {
+			if tag.Count > 0 {
+				fmt.Printf("#%-3d %s | Used %d time(s)\n", i+1, color.CyanString(tag.Name), tag.Count)
+			} else {
+				fmt.Printf("#%-3d %s\n", i+1, color.CyanString(tag.Name))
+			}
+		}
+
+		// Show pagination info
+		if nextPage != "" {
+			fmt.Println()
+			color.White("More results available. Use --page %d to see next page.\n", page+1)
+		}
+	})
+}
+
+// completeListFlag completes comma-separated flags whose values come from a
+// fixed list, such as --status open,pending
+func completeListFlag(values []string) func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		done := ""
+		if i := strings.LastIndex(toComplete, ","); i >= 0 {
+			done = toComplete[:i+1]
+		}
+
+		var completions []string
+		for _, value := range values {
+			completions = append(completions, done+value)
+		}
+		return completions, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// completeFirstArg restricts a completion function to a command's first
+// argument
+func completeFirstArg(fn func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective)) func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) > 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return fn(cmd, args, toComplete)
+	}
+}
+
+// completeTags completes tag names for comma-separated tag flags and tag
+// arguments. Errors are swallowed since completion must never print output.
+func completeTags(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	// Complete only the last entry of a comma-separated list
+	done := ""
+	prefix := toComplete
+	if i := strings.LastIndex(toComplete, ","); i >= 0 {
+		done = toComplete[:i+1]
+		prefix = toComplete[i+1:]
+	}
+
+	if len(prefix) < 2 {
+		return nil, cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveNoSpace
+	}
+
+	zdClient, err := getClientFromFlags(cmd)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	names, err := zdClient.AutocompleteTags(ctx, prefix)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	completions := make([]string, len(names))
+	for i, name := range names {
+		completions[i] = done + name
+	}
+
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
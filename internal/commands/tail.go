@@ -0,0 +1,321 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"time"
+
+	"zd-cli/internal/client"
+	"zd-cli/internal/output"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// minTailInterval keeps tail within the incremental export rate limit of
+// 10 requests a minute
+const minTailInterval = 10 * time.Second
+
+// tailLag is how far behind now the incremental export must start; the API
+// rejects start times less than a minute old
+const tailLag = 61 * time.Second
+
+// tailEntry is one ticket created or updated, as printed by tail
+type tailEntry struct {
+	Time      string         `json:"time"`
+	Event     string         `json:"event"` // "create" or "update"
+	TicketID  int64          `json:"ticket_id"`
+	UpdaterID int64          `json:"updater_id"`
+	Via       string         `json:"via"`
+	Changes   []string       `json:"changes"`
+	Ticket    *client.Ticket `json:"ticket"`
+}
+
+// NewTailCommand creates the tail command
+func NewTailCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tail",
+		Short: "Stream ticket changes as they happen",
+		Long: fmt.Sprintf(`Poll the incremental ticket event export and print tickets as they are
+created and updated, like tail -f for your queue. Press Ctrl+C to stop.
+
+--filter keeps only matching tickets and can be repeated; every filter must
+match. Filters compare a field with =, !=, ~ (contains), or !~, and a
+comma-separated value matches any of its values. Fields: %s.
+"none" matches an empty field.
+
+The export lags about a minute behind, and -o json prints one JSON object
+per line. Matching tickets also fire the hooks set up with 'zd notify'.
+Examples:
+  zd tail
+  zd tail --filter status=new,open --filter priority=urgent,high
+  zd tail --filter event=create --filter group=360001234567
+  zd tail --filter tag~vip --filter assignee=none -o json`, strings.Join(ticketFilterFields, ", ")),
+		Args: cobra.NoArgs,
+		RunE: withClient(runTail),
+	}
+
+	cmd.Flags().StringArray("filter", nil, "Only show tickets matching this expression (repeatable)")
+	cmd.Flags().String("since", "", "Also show changes since: Unix timestamp, RFC3339, YYYY-MM-DD, or relative (-1h)")
+	cmd.Flags().Duration("interval", 30*time.Second, "Polling interval (minimum 10s)")
+	cmd.Flags().StringP("output", "o", "table", "Output format: table, json")
+	addNotifyFlag(cmd)
+
+	// Tailing always needs fresh data
+	cmd.Flags().Bool("refresh", true, "Bypass cache and fetch fresh data")
+	cmd.Flags().MarkHidden("refresh")
+
+	cmd.RegisterFlagCompletionFunc("filter", cobra.NoFileCompletions)
+
+	return cmd
+}
+
+func runTail(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	exprs, _ := cmd.Flags().GetStringArray("filter")
+	filters, err := parseTicketFilters(exprs)
+	if err != nil {
+		return err
+	}
+
+	interval, _ := cmd.Flags().GetDuration("interval")
+	if interval < minTailInterval {
+		interval = minTailInterval
+	}
+
+	start := time.Now().Add(-tailLag)
+	if since, _ := cmd.Flags().GetString("since"); since != "" {
+		start, err = parseTimestamp(since)
+		if err != nil {
+			return err
+		}
+	}
+
+	format, _ := cmd.Flags().GetString("output")
+	jsonOutput := output.Format(format) == output.FormatJSON
+
+	notifier, err := newNotifier(cmd, zdClient)
+	if err != nil {
+		return err
+	}
+
+	// Stop cleanly on Ctrl+C
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	if !jsonOutput {
+		color.Cyan("Tailing tickets on %s\n", zdClient.Subdomain())
+		if len(filters) > 0 {
+			color.White("Filters: %s\n", strings.Join(exprs, " and "))
+		}
+		if notifier != nil {
+			color.White("Notify hooks: %s\n", notifier.describe())
+		}
+		color.White("Polling every %s. Press Ctrl+C to stop.\n", interval)
+		color.White(strings.Repeat("─", 80) + "\n")
+	}
+
+	poller := &tailPoller{client: zdClient, startTime: start.Unix()}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		entries, err := poller.poll(ctx)
+		if err != nil && ctx.Err() == nil {
+			color.New(color.FgRed).Fprintf(os.Stderr, "✗ Failed to fetch ticket events: %v\n", err)
+		}
+
+		for _, entry := range entries {
+			if !matchTicketFilters(filters, entry.Ticket, entry.Event) {
+				continue
+			}
+			if jsonOutput {
+				if err := output.NewWriter(output.FormatJSON).WriteNDJSON(entry); err != nil {
+					return err
+				}
+			} else {
+				displayTailEntry(&entry)
+			}
+			notifier.notify(ctx, &entry)
+		}
+
+		select {
+		case <-ctx.Done():
+			if !jsonOutput {
+				fmt.Println()
+				color.White("Stopped tailing.\n")
+			}
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// tailPoller fetches ticket events since the last poll, remembering which
+// events it has already returned since polls overlap
+type tailPoller struct {
+	client      *client.Client
+	startTime   int64
+	lastEventID int64
+}
+
+// poll returns the tickets changed since the last poll, one entry per ticket
+// and update, oldest first
+func (p *tailPoller) poll(ctx context.Context) ([]tailEntry, error) {
+	reqCtx, cancel := context.WithTimeout(client.WithoutTimeout(ctx), 2*time.Minute)
+	defer cancel()
+
+	events, endTime, err := p.client.ExportTicketEvents(reqCtx, p.startTime)
+	if err != nil {
+		return nil, err
+	}
+
+	// The next export can't start less than a minute ago, so it overlaps
+	// this one; events already seen are skipped by ID
+	p.startTime = min(endTime, time.Now().Add(-tailLag).Unix())
+
+	var fresh []client.TicketEvent
+	var ticketIDs []int64
+	for _, event := range events {
+		if event.ID <= p.lastEventID {
+			continue
+		}
+		fresh = append(fresh, event)
+		ticketIDs = append(ticketIDs, event.TicketID)
+	}
+	if len(fresh) == 0 {
+		return nil, nil
+	}
+	sort.Slice(fresh, func(i, j int) bool { return fresh[i].ID < fresh[j].ID })
+	p.lastEventID = fresh[len(fresh)-1].ID
+
+	tickets, err := p.client.GetTicketsByIDs(reqCtx, ticketIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tickets: %w", err)
+	}
+	byID := make(map[int64]*client.Ticket, len(tickets))
+	for i := range tickets {
+		byID[tickets[i].ID] = &tickets[i]
+	}
+
+	var entries []tailEntry
+	for _, event := range fresh {
+		ticket, ok := byID[event.TicketID]
+		if !ok {
+			// Deleted since
+			continue
+		}
+
+		entry := tailEntry{
+			Time:      time.Unix(event.Timestamp, 0).UTC().Format(time.RFC3339),
+			Event:     "update",
+			TicketID:  event.TicketID,
+			UpdaterID: event.UpdaterID,
+			Via:       event.Via,
+			Changes:   []string{},
+			Ticket:    ticket,
+		}
+		for _, child := range event.ChildEvents {
+			if child["event_type"] == "Create" {
+				entry.Event = "create"
+			}
+			if change := describeTicketChildEvent(child); change != "" {
+				entry.Changes = append(entry.Changes, change)
+			}
+		}
+		if entry.Event == "update" && len(entry.Changes) == 0 {
+			// Nothing a person would notice, like a metric update
+			continue
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// ticketChildEventKeys are the keys of a child event that aren't the field
+// it changed
+var ticketChildEventKeys = map[string]bool{
+	"id": true, "event_type": true, "via": true, "via_reference_id": true, "previous_value": true,
+	"added_tags": true, "removed_tags": true,
+}
+
+// describeTicketChildEvent summarizes a field change or comment in a ticket
+// event, or returns "" for anything else
+func describeTicketChildEvent(child map[string]interface{}) string {
+	switch child["event_type"] {
+	case "Comment":
+		if public, _ := child["public"].(bool); public {
+			return "public comment"
+		}
+		return "internal note"
+
+	case "Change":
+		if _, ok := child["added_tags"]; ok {
+			var tags []string
+			for _, tag := range toStringSlice(child["added_tags"]) {
+				tags = append(tags, "+"+tag)
+			}
+			for _, tag := range toStringSlice(child["removed_tags"]) {
+				tags = append(tags, "-"+tag)
+			}
+			return "tags: " + strings.Join(tags, " ")
+		}
+
+		var keys []string
+		for key := range child {
+			if !ticketChildEventKeys[key] {
+				keys = append(keys, key)
+			}
+		}
+		if len(keys) == 0 {
+			return ""
+		}
+		sort.Strings(keys)
+		return fmt.Sprintf("%s: %s → %s", keys[0], formatAuditValue(child["previous_value"]), formatAuditValue(child[keys[0]]))
+	}
+
+	return ""
+}
+
+// displayTailEntry prints a changed ticket on one line, with its changes
+// below
+func displayTailEntry(entry *tailEntry) {
+	ticket := entry.Ticket
+	when := entry.Time
+	if t, err := time.Parse(time.RFC3339, entry.Time); err == nil {
+		when = t.Local().Format("15:04:05")
+	}
+
+	event := color.YellowString("updated")
+	if entry.Event == "create" {
+		event = color.GreenString("created")
+	}
+
+	fmt.Printf("%s  %s  %s  %s  %s  %s\n",
+		color.CyanString(when),
+		color.New(color.Bold).Sprintf("#%d", ticket.ID),
+		event,
+		getColoredStatus(ticket.Status),
+		orDash(ticket.Priority),
+		ticket.Subject)
+
+	if entry.Event == "update" {
+		fmt.Printf("          %s (by %d)\n", strings.Join(entry.Changes, " · "), entry.UpdaterID)
+	}
+}
+
+// toStringSlice converts a JSON array of strings, leaving out anything else
+func toStringSlice(value interface{}) []string {
+	items, _ := value.([]interface{})
+	var values []string
+	for _, item := range items {
+		if s, ok := item.(string); ok {
+			values = append(values, s)
+		}
+	}
+	return values
+}
@@ -3,10 +3,8 @@ package commands
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"zd-cli/internal/client"
-	"zd-cli/internal/config"
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 )
@@ -26,19 +24,10 @@ func NewTestCommand() *cobra.Command {
 }
 
 func runTest(cmd *cobra.Command, args []string) error {
-	// Load config
-	cfg, err := config.Load()
-	if err == config.ErrConfigNotFound {
-		return fmt.Errorf("no configuration found. Run 'zd init' to get started")
-	}
-	if err != nil {
-		return fmt.Errorf("failed to load configuration: %w", err)
-	}
-
-	// Get current instance
-	instance, err := cfg.GetCurrentInstance()
+	// Get the instance from the environment, --instance, or the current instance
+	instance, err := resolveInstance(cmd)
 	if err != nil {
-		return fmt.Errorf("no current instance set. Run 'zd instance switch <name>' to select an instance")
+		return err
 	}
 
 	// Check if refresh flag is set
@@ -51,14 +40,22 @@ func runTest(cmd *cobra.Command, args []string) error {
 	}
 
 	// Create client with cache option
-	zdClient, err := client.NewClientWithCache(instance, useCache)
+	ttl, err := cacheTTLFromFlags(cmd, instance)
+	if err != nil {
+		return err
+	}
+
+	zdClient, err := client.NewClientWithCache(instance, useCache, ttl)
 	if err != nil {
 		return fmt.Errorf("failed to create client: %w", err)
 	}
 
+	if err := configureClient(cmd, instance, zdClient); err != nil {
+		return err
+	}
+
 	// Test connection
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
+	ctx := context.Background()
 
 	if err := zdClient.TestConnection(ctx); err != nil {
 		color.Red("✗ Connection test failed: %v\n", err)
@@ -3,6 +3,7 @@ package commands
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -15,6 +16,16 @@ import (
 	"github.com/spf13/cobra"
 )
 
+// ticketStatuses and ticketPriorities are the values ticket lists can be filtered by
+var ticketStatuses = []string{"new", "open", "pending", "hold", "solved", "closed"}
+var ticketPriorities = []string{"low", "normal", "high", "urgent"}
+
+// ticketSortFields are the fields ticket lists can be sorted by
+var ticketSortFields = []string{"created_at", "updated_at", "priority", "status"}
+
+// ticketListHeaders are the CSV columns for ticket lists
+var ticketListHeaders = []string{"id", "subject", "status", "priority", "type", "requester_id", "assignee_id", "group_id", "organization_id", "created_at", "updated_at"}
+
 // NewTicketCommand creates the ticket management command
 func NewTicketCommand() *cobra.Command {
 	cmd := &cobra.Command{
@@ -25,16 +36,38 @@ func NewTicketCommand() *cobra.Command {
 
 	cmd.AddCommand(newTicketListCommand())
 	cmd.AddCommand(newTicketShowCommand())
+	cmd.AddCommand(newTicketOpenCommand())
 	cmd.AddCommand(newTicketCommentsCommand())
 	cmd.AddCommand(newTicketSearchCommand())
 	cmd.AddCommand(newTicketCreateCommand())
 	cmd.AddCommand(newTicketUpdateCommand())
 	cmd.AddCommand(newTicketCommentCommand())
 	cmd.AddCommand(newTicketAssignCommand())
+	cmd.AddCommand(newTicketTakeCommand())
+	cmd.AddCommand(newTicketFollowCommand())
+	cmd.AddCommand(newTicketUnfollowCommand())
+	cmd.AddCommand(newTicketUnassignCommand())
 	cmd.AddCommand(newTicketCloseCommand())
+	cmd.AddCommand(newTicketReopenCommand())
+	cmd.AddCommand(newTicketHoldCommand())
+	cmd.AddCommand(newTicketBulkUpdateCommand())
+	cmd.AddCommand(newTicketBulkCloseCommand())
+	cmd.AddCommand(newTicketExportCommand())
+	cmd.AddCommand(newTicketImportCommand())
+	cmd.AddCommand(newTicketAttachmentsCommand())
+	cmd.AddCommand(newTicketWatchCommand())
+	cmd.AddCommand(newTicketDeleteCommand())
+	cmd.AddCommand(newTicketRestoreCommand())
+	cmd.AddCommand(newTicketDeletedCommand())
+	cmd.AddCommand(newTicketAuditsCommand())
+	cmd.AddCommand(newTicketTagCommand())
+	cmd.AddCommand(newTicketMineCommand())
+	cmd.AddCommand(newTicketDueCommand())
+	cmd.AddCommand(newTicketMetricsCommand())
+	cmd.AddCommand(newTicketSummarizeCommand())
 
 	// Add global output format flag to all subcommands
-	cmd.PersistentFlags().StringP("output", "o", "table", "Output format: table, json, csv")
+	cmd.PersistentFlags().StringP("output", "o", "table", "Output format: table, json, csv, markdown (show and comments only)")
 
 	return cmd
 }
@@ -43,13 +76,24 @@ func newTicketListCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "List tickets",
-		RunE:  runTicketList,
+		RunE:  withClient(runTicketList),
 	}
 
 	cmd.Flags().Int("page", 1, "Page number")
 	cmd.Flags().Int("per-page", 30, "Results per page (max 100)")
-	cmd.Flags().String("status", "", "Filter by status: new, open, pending, hold, solved, closed")
+	cmd.Flags().StringSlice("status", nil, "Filter by status, comma-separated: "+strings.Join(ticketStatuses, ", "))
+	cmd.Flags().StringSlice("priority", nil, "Filter by priority, comma-separated: "+strings.Join(ticketPriorities, ", "))
+	cmd.Flags().String("brand", "", "Only show tickets for this brand (ID, name, or subdomain)")
+	cmd.Flags().String("sort-by", "", "Sort by: "+strings.Join(ticketSortFields, ", "))
+	cmd.Flags().String("order", "desc", "Sort order: asc, desc")
 	cmd.Flags().Bool("refresh", false, "Bypass cache and fetch fresh data")
+	cmd.Flags().Bool("all", false, "Fetch every page using cursor pagination, streaming results as they arrive")
+	addDateRangeFlags(cmd)
+
+	cmd.RegisterFlagCompletionFunc("status", completeListFlag(ticketStatuses))
+	cmd.RegisterFlagCompletionFunc("priority", completeListFlag(ticketPriorities))
+	cmd.RegisterFlagCompletionFunc("sort-by", cobra.FixedCompletions(ticketSortFields, cobra.ShellCompDirectiveNoFileComp))
+	cmd.RegisterFlagCompletionFunc("order", cobra.FixedCompletions([]string{"asc", "desc"}, cobra.ShellCompDirectiveNoFileComp))
 
 	return cmd
 }
@@ -59,10 +103,12 @@ func newTicketShowCommand() *cobra.Command {
 		Use:   "show <ticket-id>",
 		Short: "Show detailed information for a specific ticket",
 		Args:  cobra.ExactArgs(1),
-		RunE:  runTicketShow,
+		RunE:  withClient(runTicketShow),
 	}
 
 	cmd.Flags().Bool("refresh", false, "Bypass cache and fetch fresh data")
+	cmd.Flags().Bool("with-metrics", false, "Also show reply, wait, and resolution times")
+	addCopyFlag(cmd)
 
 	return cmd
 }
@@ -72,10 +118,18 @@ func newTicketCommentsCommand() *cobra.Command {
 		Use:   "comments <ticket-id>",
 		Short: "Show comments/conversation for a ticket",
 		Args:  cobra.ExactArgs(1),
-		RunE:  runTicketComments,
+		RunE:  withClient(runTicketComments),
 	}
 
 	cmd.Flags().Bool("refresh", false, "Bypass cache and fetch fresh data")
+	cmd.Flags().Bool("raw", false, "Show comment bodies as sent, without rendering their HTML")
+	cmd.Flags().Int("latest", 0, "Only show the N most recent comments")
+	cmd.Flags().Int("limit", 0, "Only show the first N comments")
+	cmd.Flags().String("since", "", "Only show comments made since this time: YYYY-MM-DD, RFC3339, or relative (-7d, yesterday)")
+	cmd.Flags().Bool("public-only", false, "Only show public replies")
+	cmd.Flags().Bool("private-only", false, "Only show internal notes")
+	cmd.MarkFlagsMutuallyExclusive("latest", "limit")
+	cmd.MarkFlagsMutuallyExclusive("public-only", "private-only")
 
 	return cmd
 }
@@ -87,37 +141,144 @@ func newTicketSearchCommand() *cobra.Command {
 		Long: `Search tickets by keyword. Examples:
   zd ticket search "login issue"
   zd ticket search "status:open priority:urgent"
-  zd ticket search "assignee:me"`,
+  zd ticket search "assignee:me"
+  zd ticket search "status:open" --sort-by created_at --order asc
+  zd ticket search "tags:vip" --all -o csv
+  zd ticket search "refund" --created-after -7d --updated-before yesterday`,
 		Args: cobra.MinimumNArgs(1),
-		RunE: runTicketSearch,
+		RunE: withClient(runTicketSearch),
 	}
 
 	cmd.Flags().Bool("refresh", false, "Bypass cache and fetch fresh data")
+	addSearchPagingFlags(cmd)
+	addDateRangeFlags(cmd)
 
 	return cmd
 }
 
-func runTicketList(cmd *cobra.Command, args []string) error {
-	zdClient, err := getClientFromFlags(cmd)
-	if err != nil {
-		return err
-	}
-
+func runTicketList(cmd *cobra.Command, args []string, zdClient *client.Client) error {
 	page, _ := cmd.Flags().GetInt("page")
 	perPage, _ := cmd.Flags().GetInt("per-page")
-	status, _ := cmd.Flags().GetString("status")
+	statuses, _ := cmd.Flags().GetStringSlice("status")
+	priorities, _ := cmd.Flags().GetStringSlice("priority")
+	sortBy, _ := cmd.Flags().GetString("sort-by")
+	order, _ := cmd.Flags().GetString("order")
+	all, _ := cmd.Flags().GetBool("all")
 
 	if perPage > 100 {
 		perPage = 100
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
+	if sortBy != "" && !slices.Contains(ticketSortFields, sortBy) {
+		return fmt.Errorf("invalid sort field %q: use one of %s", sortBy, strings.Join(ticketSortFields, ", "))
+	}
+	if order != "asc" && order != "desc" {
+		return fmt.Errorf("invalid order %q: use asc or desc", order)
+	}
 
-	resp, err := zdClient.ListTickets(ctx, page, perPage, status)
+	for _, status := range statuses {
+		if !slices.Contains(ticketStatuses, status) {
+			return fmt.Errorf("invalid status %q: use one of %s", status, strings.Join(ticketStatuses, ", "))
+		}
+	}
+	for _, priority := range priorities {
+		if !slices.Contains(ticketPriorities, priority) {
+			return fmt.Errorf("invalid priority %q: use one of %s", priority, strings.Join(ticketPriorities, ", "))
+		}
+	}
+
+	dates, err := dateRangeQuery(cmd)
+	if err != nil {
+		return err
+	}
+
+	// The API takes a single status; anything more is filtered client-side
+	opts := client.TicketListOptions{}
+	if len(statuses) == 1 {
+		opts.Status = statuses[0]
+	}
+	if sortBy != "" {
+		opts.SortBy = sortBy
+		opts.SortOrder = order
+	}
+
+	// Wide tables show names, so fetch the related records in the same request
+	format, _ := cmd.Flags().GetString("output")
+	opts.Include = names.sideloads(output.Wide() && output.Format(format) == output.FormatTable)
+
+	var brandID int64
+	if brand, _ := cmd.Flags().GetString("brand"); brand != "" {
+		brandID, err = resolveBrand(zdClient, brand)
+		if err != nil {
+			return err
+		}
+	}
+
+	// When --all streams the list endpoint, filter each fetched page
+	filterTickets := func(tickets []client.Ticket) []client.Ticket {
+		if brandID == 0 && len(statuses) < 2 && len(priorities) == 0 {
+			return tickets
+		}
+		var filtered []client.Ticket
+		for _, ticket := range tickets {
+			if brandID != 0 && ticket.BrandID != brandID {
+				continue
+			}
+			if len(statuses) > 0 && !slices.Contains(statuses, ticket.Status) {
+				continue
+			}
+			if len(priorities) > 0 && !slices.Contains(priorities, ticket.Priority) {
+				continue
+			}
+			filtered = append(filtered, ticket)
+		}
+		return filtered
+	}
+
+	// The list endpoint can't filter by date, brand, priority, or several
+	// statuses, so those go through search, where paging and counts cover
+	// only the matching tickets. Search stops at 1,000 results, so --all
+	// without dates streams the list endpoint and filters each page instead.
+	if dates != "" || (!all && (brandID != 0 || len(statuses) > 1 || len(priorities) > 0)) {
+		var terms []string
+		for _, status := range statuses {
+			terms = append(terms, "status:"+status)
+		}
+		for _, priority := range priorities {
+			terms = append(terms, "priority:"+priority)
+		}
+		if brandID != 0 {
+			terms = append(terms, fmt.Sprintf("brand:%d", brandID))
+		}
+		if dates != "" {
+			terms = append(terms, dates)
+		}
+		searchOpts := client.SearchOptions{Page: page, PerPage: perPage, SortBy: opts.SortBy, SortOrder: opts.SortOrder}
+		return listTicketsBySearch(cmd, zdClient, strings.Join(terms, " "), searchOpts, all)
+	}
+
+	if all {
+		ctx, cancel := runContext(zdClient, 30*time.Minute)
+		defer cancel()
+
+		stream := newListStream(cmd, zdClient, "tickets", ticketListHeaders, printTicketTable)
+		defer stream.stop()
+		err := zdClient.ListAllTickets(ctx, opts, func(tickets []client.Ticket) error {
+			return stream.write(filterTickets(tickets))
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list tickets: %w", err)
+		}
+		return stream.finish()
+	}
+
+	ctx := context.Background()
+
+	resp, err := zdClient.ListTickets(ctx, page, perPage, opts)
 	if err != nil {
 		return fmt.Errorf("failed to list tickets: %w", err)
 	}
+	names.addSideloads(resp.Sideloads)
 
 	if len(resp.Tickets) == 0 {
 		color.Yellow("No tickets found.\n")
@@ -127,47 +288,116 @@ func runTicketList(cmd *cobra.Command, args []string) error {
 	return outputTickets(cmd, resp.Tickets, page, resp.Count, resp.NextPage)
 }
 
-func runTicketShow(cmd *cobra.Command, args []string) error {
-	zdClient, err := getClientFromFlags(cmd)
+// listTicketsBySearch serves ticket list through the search API, for filters
+// the list endpoint doesn't support
+func listTicketsBySearch(cmd *cobra.Command, zdClient *client.Client, query string, opts client.SearchOptions, all bool) error {
+	if all {
+		ctx, cancel := runContext(zdClient, 5*time.Minute)
+		defer cancel()
+
+		opts.Page = 1
+		opts.PerPage = 100
+
+		stream := newListStream(cmd, zdClient, "tickets", ticketListHeaders, printTicketTable)
+		defer stream.stop()
+		err := zdClient.SearchAllTickets(ctx, query, opts, func(tickets []client.Ticket) error {
+			return stream.write(tickets)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list tickets: %w", err)
+		}
+		return stream.finish()
+	}
+
+	ctx := context.Background()
+
+	resp, err := zdClient.SearchTickets(ctx, query, opts)
 	if err != nil {
-		return err
+		return fmt.Errorf("failed to list tickets: %w", err)
+	}
+
+	if len(resp.Results) == 0 {
+		color.Yellow("No tickets found.\n")
+		return nil
 	}
 
+	return outputTickets(cmd, resp.Results, opts.Page, resp.Count, resp.NextPage)
+}
+
+func runTicketShow(cmd *cobra.Command, args []string, zdClient *client.Client) error {
 	ticketID, err := strconv.ParseInt(args[0], 10, 64)
 	if err != nil {
 		return fmt.Errorf("invalid ticket ID: %s", args[0])
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
+	ctx := context.Background()
+
+	// Table and markdown output show names, so fetch the related records
+	// in the same request
+	format, _ := cmd.Flags().GetString("output")
+	include := names.sideloads(output.Format(format) == output.FormatTable || output.Format(format) == output.FormatMarkdown)
 
-	ticket, err := zdClient.GetTicket(ctx, ticketID)
+	resp, err := zdClient.GetTicketWithSideloads(ctx, ticketID, include)
 	if err != nil {
 		return fmt.Errorf("failed to get ticket: %w", err)
 	}
+	names.addSideloads(resp.Sideloads)
+	ticket := &resp.Ticket
 
-	return outputTicket(cmd, ticket, true)
-}
+	var metric *client.TicketMetric
+	if withMetrics, _ := cmd.Flags().GetBool("with-metrics"); withMetrics {
+		metric, err = zdClient.GetTicketMetrics(ctx, ticketID)
+		if err != nil {
+			return fmt.Errorf("failed to get ticket metrics: %w", err)
+		}
+	}
 
-func runTicketComments(cmd *cobra.Command, args []string) error {
-	zdClient, err := getClientFromFlags(cmd)
+	switch {
+	case output.Format(format) == output.FormatMarkdown:
+		err = outputTicketMarkdown(ctx, zdClient, ticket, commentFilter{})
+	case metric != nil && output.Format(format) == output.FormatJSON:
+		err = output.NewWriter(output.FormatJSON).WriteJSON(ticketWithMetrics{Ticket: ticket, MetricSet: metric})
+	default:
+		err = outputTicket(cmd, ticket, true)
+		if err == nil && metric != nil && output.Format(format) == output.FormatTable {
+			displayTicketMetrics(metric)
+		}
+	}
 	if err != nil {
 		return err
 	}
 
+	return copyFromFlags(cmd, zdClient.AgentURL("tickets", ticket.ID), ticket)
+}
+
+func runTicketComments(cmd *cobra.Command, args []string, zdClient *client.Client) error {
 	ticketID, err := strconv.ParseInt(args[0], 10, 64)
 	if err != nil {
 		return fmt.Errorf("invalid ticket ID: %s", args[0])
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
+	ctx := context.Background()
+
+	filter, err := commentFilterFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	// Markdown output is a full document, so include the ticket itself
+	if format, _ := cmd.Flags().GetString("output"); output.Format(format) == output.FormatMarkdown {
+		ticket, err := zdClient.GetTicket(ctx, ticketID)
+		if err != nil {
+			return fmt.Errorf("failed to get ticket: %w", err)
+		}
+		return outputTicketMarkdown(ctx, zdClient, ticket, filter)
+	}
 
 	comments, err := zdClient.GetTicketComments(ctx, ticketID)
 	if err != nil {
 		return fmt.Errorf("failed to get ticket comments: %w", err)
 	}
 
+	comments = filter.apply(comments)
 	if len(comments) == 0 {
 		color.Yellow("No comments found for ticket %d.\n", ticketID)
 		return nil
@@ -176,65 +406,69 @@ func runTicketComments(cmd *cobra.Command, args []string) error {
 	return outputComments(cmd, comments, ticketID)
 }
 
-func runTicketSearch(cmd *cobra.Command, args []string) error {
-	zdClient, err := getClientFromFlags(cmd)
+func runTicketSearch(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	opts, all, err := searchOptionsFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	dates, err := dateRangeQuery(cmd)
 	if err != nil {
 		return err
 	}
 
 	query := strings.Join(args, " ")
+	if dates != "" {
+		query += " " + dates
+	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
+	if all {
+		ctx, cancel := runContext(zdClient, 5*time.Minute)
+		defer cancel()
 
-	tickets, err := zdClient.SearchTickets(ctx, query)
+		var tickets []client.Ticket
+		err := zdClient.SearchAllTickets(ctx, query, opts, func(page []client.Ticket) error {
+			tickets = append(tickets, page...)
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to search tickets: %w", err)
+		}
+
+		if len(tickets) == 0 {
+			color.Yellow("No tickets found matching '%s'.\n", query)
+			return nil
+		}
+
+		return outputTickets(cmd, tickets, 0, len(tickets), "")
+	}
+
+	ctx := context.Background()
+
+	resp, err := zdClient.SearchTickets(ctx, query, opts)
 	if err != nil {
 		return fmt.Errorf("failed to search tickets: %w", err)
 	}
 
-	if len(tickets) == 0 {
+	if len(resp.Results) == 0 {
 		color.Yellow("No tickets found matching '%s'.\n", query)
 		return nil
 	}
 
-	return outputTickets(cmd, tickets, 0, len(tickets), "")
+	return outputTickets(cmd, resp.Results, opts.Page, resp.Count, resp.NextPage)
 }
 
 // outputTicket outputs a single ticket in the requested format
 func outputTicket(cmd *cobra.Command, ticket *client.Ticket, detailed bool) error {
-	format, _ := cmd.Flags().GetString("output")
-	writer := output.NewWriter(output.Format(format))
-
-	switch output.Format(format) {
-	case output.FormatJSON:
-		return writer.WriteJSON(ticket)
-
-	case output.FormatCSV:
-		headers := []string{"id", "subject", "status", "priority", "requester_id", "assignee_id", "created_at", "updated_at"}
-		return writer.WriteCSV(ticket, headers)
-
-	default:
-		// Table format (default)
+	headers := []string{"id", "subject", "status", "priority", "requester_id", "assignee_id", "created_at", "updated_at"}
+	return writeOutput(cmd, ticket, headers, func() {
 		displayTicket(ticket, detailed)
-		return nil
-	}
+	})
 }
 
 // outputTickets outputs multiple tickets in the requested format
 func outputTickets(cmd *cobra.Command, tickets []client.Ticket, page, total int, nextPage string) error {
-	format, _ := cmd.Flags().GetString("output")
-	writer := output.NewWriter(output.Format(format))
-
-	switch output.Format(format) {
-	case output.FormatJSON:
-		return writer.WriteJSON(tickets)
-
-	case output.FormatCSV:
-		headers := []string{"id", "subject", "status", "priority", "type", "requester_id", "assignee_id", "group_id", "organization_id", "created_at", "updated_at"}
-		return writer.WriteCSV(tickets, headers)
-
-	default:
-		// Table format (default)
+	return writeOutput(cmd, tickets, ticketListHeaders, func() {
 		if page > 0 {
 			color.Cyan("Tickets (Page %d, showing %d of %d total)\n", page, len(tickets), total)
 		} else {
@@ -242,79 +476,28 @@ func outputTickets(cmd *cobra.Command, tickets []client.Ticket, page, total int,
 		}
 		color.White(strings.Repeat("─", 80) + "\n\n")
 
-		for i, ticket := range tickets {
-			displayTicketSummary(&ticket, i+1)
-		}
+		printTicketTable(tickets, 1, true)
 
 		// Show pagination info
 		if nextPage != "" {
 			fmt.Println()
 			color.White("More results available. Use --page %d to see next page.\n", page+1)
 		}
-
-		return nil
-	}
+	})
 }
 
 // outputComments outputs comments in the requested format
 func outputComments(cmd *cobra.Command, comments []client.Comment, ticketID int64) error {
-	format, _ := cmd.Flags().GetString("output")
-	writer := output.NewWriter(output.Format(format))
-
-	switch output.Format(format) {
-	case output.FormatJSON:
-		return writer.WriteJSON(comments)
-
-	case output.FormatCSV:
-		headers := []string{"id", "author_id", "body", "public", "created_at"}
-		return writer.WriteCSV(comments, headers)
-
-	default:
-		// Table format (default)
+	headers := []string{"id", "author_id", "body", "public", "created_at"}
+	return writeOutput(cmd, comments, headers, func() {
 		color.Cyan("Comments for Ticket #%d (%d total)\n", ticketID, len(comments))
 		color.White(strings.Repeat("─", 80) + "\n\n")
 
+		raw, _ := cmd.Flags().GetBool("raw")
 		for i, comment := range comments {
-			displayComment(&comment, i+1)
+			displayComment(&comment, i+1, raw)
 		}
-
-		return nil
-	}
-}
-
-// Display a ticket summary (compact format)
-func displayTicketSummary(ticket *client.Ticket, index int) {
-	// Status color
-	statusColor := color.WhiteString
-	switch ticket.Status {
-	case "new":
-		statusColor = color.CyanString
-	case "open":
-		statusColor = color.BlueString
-	case "pending":
-		statusColor = color.YellowString
-	case "solved":
-		statusColor = color.GreenString
-	case "closed":
-		statusColor = color.HiBlackString
-	}
-
-	// Priority indicator
-	priorityIndicator := ""
-	switch ticket.Priority {
-	case "urgent":
-		priorityIndicator = color.RedString("!")
-	case "high":
-		priorityIndicator = color.YellowString("↑")
-	}
-
-	fmt.Printf("#%-4d %s%-8s %s| %s | ID: %d\n",
-		index,
-		priorityIndicator,
-		statusColor(ticket.Status),
-		color.WhiteString("| "),
-		ticket.Subject,
-		ticket.ID)
+	})
 }
 
 // Display full ticket details
@@ -328,21 +511,25 @@ func displayTicket(ticket *client.Ticket, detailed bool) {
 	fmt.Printf("Type:         %s\n", ticket.Type)
 
 	// People
+	names.prefetchTickets([]client.Ticket{*ticket})
 	color.White("\nPeople:\n")
-	color.White("  Requester ID: %d\n", ticket.RequesterID)
-	color.White("  Submitter ID: %d\n", ticket.SubmitterID)
+	color.White("  Requester:    %s\n", withID(names.userName(ticket.RequesterID), ticket.RequesterID))
+	color.White("  Submitter:    %s\n", withID(names.userName(ticket.SubmitterID), ticket.SubmitterID))
 	if ticket.AssigneeID != nil {
-		color.White("  Assignee ID:  %d\n", *ticket.AssigneeID)
+		color.White("  Assignee:     %s\n", withID(names.userName(*ticket.AssigneeID), *ticket.AssigneeID))
 	} else {
-		color.White("  Assignee ID:  (unassigned)\n")
+		color.White("  Assignee:     (unassigned)\n")
 	}
 
 	// Organization and Group
 	if ticket.OrganizationID != nil {
-		color.White("  Organization: %d\n", *ticket.OrganizationID)
+		color.White("  Organization: %s\n", withID(names.orgName(*ticket.OrganizationID), *ticket.OrganizationID))
 	}
 	if ticket.GroupID != nil {
-		color.White("  Group:        %d\n", *ticket.GroupID)
+		color.White("  Group:        %s\n", withID(names.groupName(*ticket.GroupID), *ticket.GroupID))
+	}
+	if ticket.BrandID != 0 {
+		color.White("  Brand:        %d\n", ticket.BrandID)
 	}
 
 	// Dates
@@ -358,6 +545,11 @@ func displayTicket(ticket *client.Ticket, detailed bool) {
 		color.White("\nTags: %s\n", strings.Join(ticket.Tags, ", "))
 	}
 
+	// Custom fields
+	if detailed {
+		displayCustomFields(ticket.CustomFields)
+	}
+
 	// Description
 	if detailed && ticket.Description != "" {
 		color.White("\nDescription:\n")
@@ -367,8 +559,27 @@ func displayTicket(ticket *client.Ticket, detailed bool) {
 	color.White("\nURL: %s\n", ticket.URL)
 }
 
+// Display custom fields that have a value
+func displayCustomFields(fields []client.CustomField) {
+	var set []client.CustomField
+	for _, field := range fields {
+		if field.Value != nil && field.Value != "" && field.Value != false {
+			set = append(set, field)
+		}
+	}
+
+	if len(set) == 0 {
+		return
+	}
+
+	color.White("\nCustom Fields:\n")
+	for _, field := range set {
+		color.White("  %-12d %s\n", field.ID, formatAuditValue(field.Value))
+	}
+}
+
 // Display a comment
-func displayComment(comment *client.Comment, index int) {
+func displayComment(comment *client.Comment, index int, raw bool) {
 	visibility := "Public"
 	if !comment.Public {
 		visibility = color.YellowString("Private")
@@ -376,20 +587,90 @@ func displayComment(comment *client.Comment, index int) {
 
 	color.White("#%-3d [%s] Author ID: %d | %s\n", index, visibility, comment.AuthorID, formatDate(comment.CreatedAt))
 
-	// Use plain body if available, otherwise HTML body, otherwise regular body
-	body := comment.PlainBody
-	if body == "" {
-		body = comment.Body
-	}
+	body := commentText(comment, raw)
 
 	// Truncate long comments for list view
-	if len(body) > 200 {
-		body = body[:200] + "..."
-	}
+	body = output.TruncateRendered(body, 200)
 
 	color.White("%s\n\n", body)
 }
 
+// commentFilter selects which comments of a conversation to show
+type commentFilter struct {
+	since       time.Time
+	publicOnly  bool
+	privateOnly bool
+	limit       int // Keep the first N
+	latest      int // Keep the last N
+}
+
+// commentFilterFromFlags reads the comment filter flags
+func commentFilterFromFlags(cmd *cobra.Command) (commentFilter, error) {
+	var filter commentFilter
+	filter.publicOnly, _ = cmd.Flags().GetBool("public-only")
+	filter.privateOnly, _ = cmd.Flags().GetBool("private-only")
+	filter.limit, _ = cmd.Flags().GetInt("limit")
+	filter.latest, _ = cmd.Flags().GetInt("latest")
+
+	if filter.limit < 0 || filter.latest < 0 {
+		return filter, fmt.Errorf("--limit and --latest must be positive")
+	}
+
+	if since, _ := cmd.Flags().GetString("since"); since != "" {
+		t, err := parseTimestamp(since)
+		if err != nil {
+			return filter, fmt.Errorf("--since: %w", err)
+		}
+		filter.since = t
+	}
+
+	return filter, nil
+}
+
+// apply returns the comments that pass the filter, oldest first
+func (f commentFilter) apply(comments []client.Comment) []client.Comment {
+	var kept []client.Comment
+	for _, comment := range comments {
+		if f.publicOnly && !comment.Public {
+			continue
+		}
+		if f.privateOnly && comment.Public {
+			continue
+		}
+		if !f.since.IsZero() {
+			created, err := time.Parse(time.RFC3339, comment.CreatedAt)
+			if err == nil && created.Before(f.since) {
+				continue
+			}
+		}
+		kept = append(kept, comment)
+	}
+
+	if f.limit > 0 && len(kept) > f.limit {
+		kept = kept[:f.limit]
+	}
+	if f.latest > 0 && len(kept) > f.latest {
+		kept = kept[len(kept)-f.latest:]
+	}
+	return kept
+}
+
+// commentText returns a comment's body for the terminal. The HTML body is
+// rendered so formatting and links survive; raw shows the body as sent,
+// falling back to the HTML source when there is no text body.
+func commentText(comment *client.Comment, raw bool) string {
+	if !raw && comment.HTMLBody != "" {
+		return output.RenderHTML(comment.HTMLBody)
+	}
+
+	for _, body := range []string{comment.Body, comment.PlainBody, comment.HTMLBody} {
+		if body != "" {
+			return body
+		}
+	}
+	return ""
+}
+
 func getColoredStatus(status string) string {
 	switch status {
 	case "new":
@@ -428,17 +709,33 @@ func newTicketCreateCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create",
 		Short: "Create a new ticket",
-		RunE:  runTicketCreate,
+		RunE:  withClient(runTicketCreate),
 	}
 
 	cmd.Flags().String("subject", "", "Ticket subject")
-	cmd.Flags().String("description", "", "Ticket description")
+	cmd.Flags().String("description", "", "Ticket description (@file to read a file, - for stdin)")
 	cmd.Flags().String("priority", "", "Priority: low, normal, high, urgent")
 	cmd.Flags().String("type", "incident", "Type: problem, incident, question, task")
 	cmd.Flags().String("status", "new", "Status: new, open, pending, hold, solved, closed")
+	cmd.Flags().String("due", "", "Due date, e.g. 2026-10-30, friday, or 3d; makes the ticket a task")
 	cmd.Flags().Int64("assignee", 0, "Assignee user ID")
 	cmd.Flags().Int64("group", 0, "Group ID")
 	cmd.Flags().StringSlice("tags", []string{}, "Tags (comma-separated)")
+	cmd.Flags().StringArray("attach", nil, "Attach a file to the description (repeatable)")
+	cmd.Flags().String("requester-email", "", "Requester email (user is created if not found)")
+	cmd.Flags().String("requester-name", "", "Requester name, used when creating a new requester")
+	cmd.Flags().String("brand", "", "Brand ID, name, or subdomain")
+	cmd.Flags().StringArray("field", nil, "Set a custom field: <id>=<value> (repeatable)")
+	cmd.Flags().String("field-json", "", "Set custom fields from JSON: '{\"<id>\": value}'")
+	cmd.Flags().String("from-file", "", "Read the ticket from a JSON or YAML file (- for stdin); flags override its fields")
+
+	useConfigDefault(cmd, "group")
+	useConfigDefault(cmd, "priority")
+
+	cmd.RegisterFlagCompletionFunc("tags", completeTags)
+	cmd.RegisterFlagCompletionFunc("status", cobra.FixedCompletions(ticketStatuses, cobra.ShellCompDirectiveNoFileComp))
+	cmd.RegisterFlagCompletionFunc("priority", cobra.FixedCompletions(ticketPriorities, cobra.ShellCompDirectiveNoFileComp))
+	cmd.RegisterFlagCompletionFunc("group", completeGroupIDs)
 
 	return cmd
 }
@@ -448,15 +745,28 @@ func newTicketUpdateCommand() *cobra.Command {
 		Use:   "update <ticket-id>",
 		Short: "Update a ticket",
 		Args:  cobra.ExactArgs(1),
-		RunE:  runTicketUpdate,
+		RunE:  withClient(runTicketUpdate),
 	}
 
 	cmd.Flags().String("subject", "", "New subject")
 	cmd.Flags().String("priority", "", "New priority: low, normal, high, urgent")
 	cmd.Flags().String("status", "", "New status: new, open, pending, hold, solved, closed")
+	cmd.Flags().String("type", "", "New type: problem, incident, question, task")
+	cmd.Flags().String("due", "", "Due date for a task, e.g. 2026-10-30, next friday, or 3d; none to clear it")
 	cmd.Flags().Int64("assignee", 0, "New assignee user ID")
 	cmd.Flags().Int64("group", 0, "New group ID")
-	cmd.Flags().StringSlice("tags", []string{}, "Tags to set")
+	cmd.Flags().StringSlice("tags", []string{}, "Tags to set (replaces all tags; see 'zd ticket tag')")
+	cmd.Flags().String("comment", "", "Add a comment with the update (@file to read a file, - for stdin)")
+	cmd.Flags().Bool("private", false, "Make the comment private")
+	cmd.Flags().StringArray("attach", nil, "Attach a file to the comment (repeatable)")
+	cmd.Flags().StringArray("field", nil, "Set a custom field: <id>=<value> (repeatable)")
+	cmd.Flags().String("field-json", "", "Set custom fields from JSON: '{\"<id>\": value}'")
+	cmd.Flags().String("from-file", "", "Read ticket fields to update from a JSON or YAML file (- for stdin); flags override its fields")
+
+	cmd.RegisterFlagCompletionFunc("tags", completeTags)
+	cmd.RegisterFlagCompletionFunc("status", cobra.FixedCompletions(ticketStatuses, cobra.ShellCompDirectiveNoFileComp))
+	cmd.RegisterFlagCompletionFunc("priority", cobra.FixedCompletions(ticketPriorities, cobra.ShellCompDirectiveNoFileComp))
+	cmd.RegisterFlagCompletionFunc("group", completeGroupIDs)
 
 	return cmd
 }
@@ -466,22 +776,77 @@ func newTicketCommentCommand() *cobra.Command {
 		Use:   "comment <ticket-id>",
 		Short: "Add a comment to a ticket",
 		Args:  cobra.ExactArgs(1),
-		RunE:  runTicketComment,
+		RunE:  withClient(runTicketComment),
 	}
 
-	cmd.Flags().String("message", "", "Comment message")
+	cmd.Flags().String("message", "", "Comment message (@file to read a file, - for stdin)")
 	cmd.Flags().Bool("public", true, "Make comment public")
 	cmd.Flags().Bool("private", false, "Make comment private")
+	cmd.Flags().StringArray("attach", nil, "Attach a file to the comment (repeatable)")
 
 	return cmd
 }
 
 func newTicketAssignCommand() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "assign <ticket-id> <user-id>",
+		Use:   "assign <ticket-id> <user-id|email|name>",
 		Short: "Assign a ticket to a user",
-		Args:  cobra.ExactArgs(2),
-		RunE:  runTicketAssign,
+		Long: `Assign a ticket to an agent, given by user ID, email address, or name.
+
+Names and emails are looked up among agents and admins. When several match,
+you are asked to pick one.`,
+		Example: `  zd ticket assign 12345 987654321
+  zd ticket assign 12345 jane@example.com
+  zd ticket assign 12345 "Jane Smith"`,
+		Args: cobra.ExactArgs(2),
+		RunE: withClient(runTicketAssign),
+	}
+
+	return cmd
+}
+
+func newTicketTakeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "take <ticket-id>",
+		Short: "Assign a ticket to yourself",
+		Args:  cobra.ExactArgs(1),
+		RunE:  withClient(runTicketTake),
+	}
+
+	return cmd
+}
+
+func newTicketFollowCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "follow <ticket-id>",
+		Short: "Follow a ticket to be notified of its updates",
+		Long: `Add yourself to a ticket's followers, so you're notified of its updates
+without owning it. Followers see internal notes and aren't CC'd on replies
+to the requester.`,
+		Args: cobra.ExactArgs(1),
+		RunE: withClient(runTicketFollow),
+	}
+
+	return cmd
+}
+
+func newTicketUnfollowCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unfollow <ticket-id>",
+		Short: "Stop following a ticket",
+		Args:  cobra.ExactArgs(1),
+		RunE:  withClient(runTicketUnfollow),
+	}
+
+	return cmd
+}
+
+func newTicketUnassignCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unassign <ticket-id>",
+		Short: "Clear a ticket's assignee and group",
+		Args:  cobra.ExactArgs(1),
+		RunE:  withClient(runTicketUnassign),
 	}
 
 	return cmd
@@ -492,40 +857,116 @@ func newTicketCloseCommand() *cobra.Command {
 		Use:   "close <ticket-id>",
 		Short: "Close a ticket",
 		Args:  cobra.ExactArgs(1),
-		RunE:  runTicketClose,
+		RunE:  withClient(runTicketClose),
 	}
 
-	cmd.Flags().String("comment", "", "Optional closing comment")
+	cmd.Flags().String("comment", "", "Optional closing comment (@file to read a file, - for stdin)")
 
 	return cmd
 }
 
-func runTicketCreate(cmd *cobra.Command, args []string) error {
-	zdClient, err := getClientFromFlags(cmd)
-	if err != nil {
-		return err
+func newTicketReopenCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reopen <ticket-id>",
+		Short: "Reopen a solved or pending ticket",
+		Long: `Set a ticket's status back to open. Closed tickets can't be reopened;
+create a follow-up ticket instead. Examples:
+  zd ticket reopen 12345
+  zd ticket reopen 12345 --comment "Customer reports it's happening again" --private`,
+		Args: cobra.ExactArgs(1),
+		RunE: withClient(func(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+			return runTicketSetStatus(cmd, args, zdClient, "open", "reopened")
+		}),
+	}
+
+	cmd.Flags().String("comment", "", "Add a comment (@file to read a file, - for stdin)")
+	cmd.Flags().Bool("private", false, "Make the comment private")
+
+	return cmd
+}
+
+func newTicketHoldCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "hold <ticket-id>",
+		Short: "Put a ticket on hold",
+		Long: `Set a ticket's status to on-hold, while you wait on a third party rather
+than the requester. Examples:
+  zd ticket hold 12345
+  zd ticket hold 12345 --comment "Waiting on the payments team" --private`,
+		Args: cobra.ExactArgs(1),
+		RunE: withClient(func(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+			return runTicketSetStatus(cmd, args, zdClient, "hold", "put on hold")
+		}),
 	}
 
+	cmd.Flags().String("comment", "", "Add a comment (@file to read a file, - for stdin)")
+	cmd.Flags().Bool("private", false, "Make the comment private")
+
+	return cmd
+}
+
+func runTicketCreate(cmd *cobra.Command, args []string, zdClient *client.Client) error {
 	// Get flags
 	subject, _ := cmd.Flags().GetString("subject")
-	description, _ := cmd.Flags().GetString("description")
+	description, err := messageFromFlag(cmd, "description")
+	if err != nil {
+		return err
+	}
 	priority, _ := cmd.Flags().GetString("priority")
 	ticketType, _ := cmd.Flags().GetString("type")
 	status, _ := cmd.Flags().GetString("status")
 	assigneeID, _ := cmd.Flags().GetInt64("assignee")
 	groupID, _ := cmd.Flags().GetInt64("group")
 	tags, _ := cmd.Flags().GetStringSlice("tags")
+	attach, _ := cmd.Flags().GetStringArray("attach")
+	requesterEmail, _ := cmd.Flags().GetString("requester-email")
+	requesterName, _ := cmd.Flags().GetString("requester-name")
+
+	if requesterName != "" && requesterEmail == "" {
+		return fmt.Errorf("--requester-name requires --requester-email")
+	}
+
+	fields, _ := cmd.Flags().GetStringArray("field")
+	fieldJSON, _ := cmd.Flags().GetString("field-json")
+	customFields, err := parseCustomFields(zdClient, fields, fieldJSON)
+	if err != nil {
+		return err
+	}
+
+	var definition map[string]interface{}
+	if fromFile, _ := cmd.Flags().GetString("from-file"); fromFile != "" {
+		definition, err = readDefinition(fromFile, "ticket")
+		if err != nil {
+			return err
+		}
+		ticketDescriptionAsComment(definition)
+
+		// Only flags given explicitly override the file, not flag defaults
+		if !cmd.Flags().Changed("type") {
+			ticketType = ""
+		}
+		if !cmd.Flags().Changed("status") {
+			status = ""
+		}
+		// Nor do configured defaults
+		if _, ok := definition["priority"]; ok && !cmd.Flags().Changed("priority") {
+			priority = ""
+		}
+		if _, ok := definition["group_id"]; ok && !cmd.Flags().Changed("group") {
+			groupID = 0
+		}
+	}
 
 	// Interactive prompts if not provided
-	if subject == "" {
-		subject, err = promptString("Subject", true)
+	if subject == "" && definition == nil {
+		subject, err = promptString("Subject", true, "--subject")
 		if err != nil {
 			return err
 		}
 	}
 
-	if description == "" {
-		description, err = promptString("Description", true)
+	if description == "" && definition == nil {
+		description, err = promptString("Description", true, "--description")
 		if err != nil {
 			return err
 		}
@@ -533,12 +974,13 @@ func runTicketCreate(cmd *cobra.Command, args []string) error {
 
 	// Build request
 	req := client.CreateTicketRequest{
-		Subject:     subject,
-		Description: description,
-		Priority:    priority,
-		Type:        ticketType,
-		Status:      status,
-		Tags:        tags,
+		Subject:      subject,
+		Description:  description,
+		Priority:     priority,
+		Type:         ticketType,
+		Status:       status,
+		Tags:         tags,
+		CustomFields: customFields,
 	}
 
 	if assigneeID > 0 {
@@ -548,10 +990,46 @@ func runTicketCreate(cmd *cobra.Command, args []string) error {
 		req.GroupID = &groupID
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
+	// Zendesk only keeps due dates on tasks
+	if cmd.Flags().Changed("due") {
+		if cmd.Flags().Changed("type") && ticketType != "task" {
+			return fmt.Errorf("--due only applies to tasks; use --type task or leave --type out")
+		}
+		due, clear, err := dueFromFlag(cmd)
+		if err != nil {
+			return err
+		}
+		if clear {
+			return fmt.Errorf("--due none clears a due date with 'zd ticket update'; leave --due out to create a ticket without one")
+		}
+		req.Type = "task"
+		req.DueAt = due
+	}
 
-	ticket, err := zdClient.CreateTicket(ctx, req)
+	if brand, _ := cmd.Flags().GetString("brand"); brand != "" {
+		brandID, err := resolveBrand(zdClient, brand)
+		if err != nil {
+			return err
+		}
+		req.BrandID = &brandID
+	}
+
+	if requesterEmail != "" {
+		requesterID, err := resolveRequester(zdClient, requesterEmail, requesterName)
+		if err != nil {
+			return err
+		}
+		req.RequesterID = &requesterID
+	}
+
+	req.Uploads, err = uploadAttachments(zdClient, attach)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	ticket, err := zdClient.CreateTicketFromDefinition(ctx, mergeDefinition(definition, req.Fields()))
 	if err != nil {
 		return fmt.Errorf("failed to create ticket: %w", err)
 	}
@@ -559,25 +1037,32 @@ func runTicketCreate(cmd *cobra.Command, args []string) error {
 	color.Green("✓ Ticket created successfully!\n")
 	color.White("Ticket ID: %d\n", ticket.ID)
 	color.White("Status: %s\n", ticket.Status)
+	if ticket.DueAt != nil && *ticket.DueAt != "" {
+		color.White("Due: %s\n", formatDate(*ticket.DueAt))
+	}
 	color.White("URL: %s\n", ticket.URL)
 
 	return nil
 }
 
-func runTicketUpdate(cmd *cobra.Command, args []string) error {
-	zdClient, err := getClientFromFlags(cmd)
-	if err != nil {
-		return err
-	}
-
+func runTicketUpdate(cmd *cobra.Command, args []string, zdClient *client.Client) error {
 	ticketID, err := strconv.ParseInt(args[0], 10, 64)
 	if err != nil {
 		return fmt.Errorf("invalid ticket ID: %s", args[0])
 	}
 
+	// Fields from --from-file, which flags override
+	definition := map[string]interface{}{}
+	if fromFile, _ := cmd.Flags().GetString("from-file"); fromFile != "" {
+		definition, err = readDefinition(fromFile, "ticket")
+		if err != nil {
+			return err
+		}
+	}
+
 	// Build update request from flags
 	req := client.UpdateTicketRequest{}
-	updated := false
+	updated := len(definition) > 0
 
 	if cmd.Flags().Changed("subject") {
 		subject, _ := cmd.Flags().GetString("subject")
@@ -597,6 +1082,29 @@ func runTicketUpdate(cmd *cobra.Command, args []string) error {
 		updated = true
 	}
 
+	if cmd.Flags().Changed("type") {
+		ticketType, _ := cmd.Flags().GetString("type")
+		req.Type = &ticketType
+		updated = true
+	}
+
+	clearDue := false
+	if cmd.Flags().Changed("due") {
+		due, clear, err := dueFromFlag(cmd)
+		if err != nil {
+			return err
+		}
+		if clear {
+			clearDue = true
+		} else {
+			if err := checkDueTicketType(zdClient, ticketID, req.Type, definition); err != nil {
+				return err
+			}
+			req.DueAt = &due
+		}
+		updated = true
+	}
+
 	if cmd.Flags().Changed("assignee") {
 		assigneeID, _ := cmd.Flags().GetInt64("assignee")
 		req.AssigneeID = &assigneeID
@@ -615,14 +1123,56 @@ func runTicketUpdate(cmd *cobra.Command, args []string) error {
 		updated = true
 	}
 
+	if cmd.Flags().Changed("field") || cmd.Flags().Changed("field-json") {
+		fields, _ := cmd.Flags().GetStringArray("field")
+		fieldJSON, _ := cmd.Flags().GetString("field-json")
+		req.CustomFields, err = parseCustomFields(zdClient, fields, fieldJSON)
+		if err != nil {
+			return err
+		}
+		updated = true
+	}
+
+	attach, _ := cmd.Flags().GetStringArray("attach")
+	if cmd.Flags().Changed("comment") || len(attach) > 0 {
+		message, err := messageFromFlag(cmd, "comment")
+		if err != nil {
+			return err
+		}
+		if message == "" {
+			message = attachmentsComment(attach)
+		}
+		private, _ := cmd.Flags().GetBool("private")
+		req.Comment = &client.TicketComment{
+			Body:   message,
+			Public: !private,
+		}
+		updated = true
+	}
+
 	if !updated {
-		return fmt.Errorf("no updates specified. Use flags like --status, --priority, --assignee, etc.")
+		return fmt.Errorf("no updates specified. Use flags like --status, --priority, --assignee, --due, or --from-file")
+	}
+
+	if req.Comment != nil {
+		req.Comment.Uploads, err = uploadAttachments(zdClient, attach)
+		if err != nil {
+			return err
+		}
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
+	ctx := context.Background()
 
-	ticket, err := zdClient.UpdateTicket(ctx, ticketID, req)
+	fields, err := toDefinition(req)
+	if err != nil {
+		return err
+	}
+	if clearDue {
+		// UpdateTicketRequest omits nil fields, so send the null directly
+		fields["due_at"] = nil
+	}
+
+	ticket, err := zdClient.UpdateTicketFromDefinition(ctx, ticketID, mergeDefinition(definition, fields))
 	if err != nil {
 		return fmt.Errorf("failed to update ticket: %w", err)
 	}
@@ -633,20 +1183,41 @@ func runTicketUpdate(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-func runTicketComment(cmd *cobra.Command, args []string) error {
-	zdClient, err := getClientFromFlags(cmd)
-	if err != nil {
-		return err
+// ticketDescriptionAsComment moves the description of a ticket definition
+// into its first comment, which is where the API takes it
+func ticketDescriptionAsComment(definition map[string]interface{}) {
+	description, ok := definition["description"].(string)
+	if !ok {
+		return
 	}
+	delete(definition, "description")
 
+	comment, ok := definition["comment"].(map[string]interface{})
+	if !ok {
+		comment = map[string]interface{}{}
+		definition["comment"] = comment
+	}
+	if _, ok := comment["body"]; !ok {
+		comment["body"] = description
+	}
+}
+
+func runTicketComment(cmd *cobra.Command, args []string, zdClient *client.Client) error {
 	ticketID, err := strconv.ParseInt(args[0], 10, 64)
 	if err != nil {
 		return fmt.Errorf("invalid ticket ID: %s", args[0])
 	}
 
-	message, _ := cmd.Flags().GetString("message")
+	message, err := messageFromFlag(cmd, "message")
+	if err != nil {
+		return err
+	}
+	attach, _ := cmd.Flags().GetStringArray("attach")
+	if message == "" && len(attach) > 0 {
+		message = attachmentsComment(attach)
+	}
 	if message == "" {
-		message, err = promptString("Comment", true)
+		message, err = promptString("Comment", true, "--message")
 		if err != nil {
 			return err
 		}
@@ -660,16 +1231,17 @@ func runTicketComment(cmd *cobra.Command, args []string) error {
 
 	// Create update request with just a comment
 	req := client.UpdateTicketRequest{}
-	req.Comment = &struct {
-		Body   string `json:"body"`
-		Public bool   `json:"public"`
-	}{
+	req.Comment = &client.TicketComment{
 		Body:   message,
 		Public: isPublic,
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
+	req.Comment.Uploads, err = uploadAttachments(zdClient, attach)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
 
 	ticket, err := zdClient.UpdateTicket(ctx, ticketID, req)
 	if err != nil {
@@ -686,45 +1258,135 @@ func runTicketComment(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-func runTicketAssign(cmd *cobra.Command, args []string) error {
-	zdClient, err := getClientFromFlags(cmd)
+func runTicketAssign(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	ticketID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid ticket ID: %s", args[0])
+	}
+
+	ctx := context.Background()
+
+	assignee, err := resolveAssignee(ctx, zdClient, args[1])
 	if err != nil {
 		return err
 	}
 
+	req := client.UpdateTicketRequest{
+		AssigneeID: &assignee.ID,
+	}
+
+	ticket, err := zdClient.UpdateTicket(ctx, ticketID, req)
+	if err != nil {
+		return fmt.Errorf("failed to assign ticket: %w", err)
+	}
+
+	if assignee.Name != "" {
+		color.Green("✓ Ticket #%d assigned to %s (%d)\n", ticket.ID, assignee.Name, assignee.ID)
+	} else {
+		color.Green("✓ Ticket #%d assigned to user %d\n", ticket.ID, assignee.ID)
+	}
+
+	return nil
+}
+
+func runTicketTake(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	ticketID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid ticket ID: %s", args[0])
+	}
+
+	ctx := context.Background()
+
+	me, err := zdClient.GetMe(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get current user: %w", err)
+	}
+
+	ticket, err := zdClient.UpdateTicket(ctx, ticketID, client.UpdateTicketRequest{
+		AssigneeID: &me.ID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to assign ticket: %w", err)
+	}
+
+	color.Green("✓ Ticket #%d assigned to you (%s)\n", ticket.ID, me.Name)
+
+	return nil
+}
+
+func runTicketFollow(cmd *cobra.Command, args []string, zdClient *client.Client) error {
 	ticketID, err := strconv.ParseInt(args[0], 10, 64)
 	if err != nil {
 		return fmt.Errorf("invalid ticket ID: %s", args[0])
 	}
 
-	assigneeID, err := strconv.ParseInt(args[1], 10, 64)
+	ctx := context.Background()
+
+	me, err := zdClient.GetMe(ctx)
 	if err != nil {
-		return fmt.Errorf("invalid user ID: %s", args[1])
+		return fmt.Errorf("failed to get current user: %w", err)
 	}
 
-	req := client.UpdateTicketRequest{
-		AssigneeID: &assigneeID,
+	ticket, err := zdClient.FollowTicket(ctx, ticketID, me.ID)
+	if err != nil {
+		return fmt.Errorf("failed to follow ticket: %w", err)
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
+	if !slices.Contains(ticket.FollowerIDs, me.ID) {
+		return fmt.Errorf("ticket #%d didn't add you as a follower; check that followers are enabled in Zendesk's ticket settings", ticket.ID)
+	}
 
-	ticket, err := zdClient.UpdateTicket(ctx, ticketID, req)
+	color.Green("✓ You (%s) are following ticket #%d\n", me.Name, ticket.ID)
+
+	return nil
+}
+
+func runTicketUnfollow(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	ticketID, err := strconv.ParseInt(args[0], 10, 64)
 	if err != nil {
-		return fmt.Errorf("failed to assign ticket: %w", err)
+		return fmt.Errorf("invalid ticket ID: %s", args[0])
 	}
 
-	color.Green("✓ Ticket #%d assigned to user %d\n", ticket.ID, assigneeID)
+	ctx := context.Background()
+
+	me, err := zdClient.GetMe(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get current user: %w", err)
+	}
+
+	ticket, err := zdClient.UnfollowTicket(ctx, ticketID, me.ID)
+	if err != nil {
+		return fmt.Errorf("failed to unfollow ticket: %w", err)
+	}
+
+	color.Green("✓ You (%s) are no longer following ticket #%d\n", me.Name, ticket.ID)
 
 	return nil
 }
 
-func runTicketClose(cmd *cobra.Command, args []string) error {
-	zdClient, err := getClientFromFlags(cmd)
+func runTicketUnassign(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	ticketID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid ticket ID: %s", args[0])
+	}
+
+	ctx := context.Background()
+
+	// UpdateTicketRequest omits nil fields, so send the nulls directly
+	ticket, err := zdClient.UpdateTicketFromDefinition(ctx, ticketID, map[string]interface{}{
+		"assignee_id": nil,
+		"group_id":    nil,
+	})
 	if err != nil {
-		return err
+		return fmt.Errorf("failed to unassign ticket: %w", err)
 	}
 
+	color.Green("✓ Ticket #%d unassigned\n", ticket.ID)
+
+	return nil
+}
+
+func runTicketClose(cmd *cobra.Command, args []string, zdClient *client.Client) error {
 	ticketID, err := strconv.ParseInt(args[0], 10, 64)
 	if err != nil {
 		return fmt.Errorf("invalid ticket ID: %s", args[0])
@@ -737,18 +1399,17 @@ func runTicketClose(cmd *cobra.Command, args []string) error {
 
 	// Add closing comment if provided
 	if cmd.Flags().Changed("comment") {
-		message, _ := cmd.Flags().GetString("comment")
-		req.Comment = &struct {
-			Body   string `json:"body"`
-			Public bool   `json:"public"`
-		}{
+		message, err := messageFromFlag(cmd, "comment")
+		if err != nil {
+			return err
+		}
+		req.Comment = &client.TicketComment{
 			Body:   message,
 			Public: true,
 		}
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
+	ctx := context.Background()
 
 	ticket, err := zdClient.UpdateTicket(ctx, ticketID, req)
 	if err != nil {
@@ -760,8 +1421,141 @@ func runTicketClose(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-// Helper function for interactive string prompts
-func promptString(label string, required bool) (string, error) {
+// runTicketSetStatus sets a ticket's status, with an optional comment.
+// done describes the change for the success message, e.g. "reopened".
+func runTicketSetStatus(cmd *cobra.Command, args []string, zdClient *client.Client, status, done string) error {
+	ticketID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid ticket ID: %s", args[0])
+	}
+
+	req := client.UpdateTicketRequest{
+		Status: &status,
+	}
+
+	if cmd.Flags().Changed("comment") {
+		message, err := messageFromFlag(cmd, "comment")
+		if err != nil {
+			return err
+		}
+		private, _ := cmd.Flags().GetBool("private")
+		req.Comment = &client.TicketComment{
+			Body:   message,
+			Public: !private,
+		}
+	}
+
+	ctx := context.Background()
+
+	ticket, err := zdClient.UpdateTicket(ctx, ticketID, req)
+	if err != nil {
+		return fmt.Errorf("failed to update ticket: %w", err)
+	}
+
+	color.Green("✓ Ticket #%d %s\n", ticket.ID, done)
+
+	return nil
+}
+
+// resolveRequester finds the user with the given email, creating them as an
+// end user if they don't exist yet, and returns their ID
+func resolveRequester(zdClient *client.Client, email, name string) (int64, error) {
+	ctx := context.Background()
+
+	user, err := zdClient.FindUserByEmail(ctx, email)
+	if err != nil {
+		return 0, fmt.Errorf("failed to look up requester: %w", err)
+	}
+	if user != nil {
+		color.White("Requester: %s <%s> (ID: %d)\n", user.Name, user.Email, user.ID)
+		return user.ID, nil
+	}
+
+	// Zendesk requires a name; fall back to the email's local part
+	if name == "" {
+		name = strings.SplitN(email, "@", 2)[0]
+	}
+
+	user, err = zdClient.CreateUser(ctx, client.CreateUserRequest{
+		Name:  name,
+		Email: email,
+		Role:  "end-user",
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to create requester: %w", err)
+	}
+
+	color.Green("✓ Created requester %s <%s> (ID: %d)\n", user.Name, user.Email, user.ID)
+
+	return user.ID, nil
+}
+
+// resolveAssignee turns a user ID, email address, or name into an agent to
+// assign tickets to. A numeric argument is used as-is without a lookup.
+// Otherwise agents and admins matching the search are considered, an exact
+// email or name match wins, and the user picks when several remain.
+func resolveAssignee(ctx context.Context, zdClient *client.Client, arg string) (*client.User, error) {
+	if id, err := strconv.ParseInt(arg, 10, 64); err == nil {
+		return &client.User{ID: id}, nil
+	}
+
+	users, err := zdClient.SearchUsers(ctx, arg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search users: %w", err)
+	}
+
+	var agents, exact []client.User
+	for _, user := range users {
+		if user.Role != "agent" && user.Role != "admin" {
+			continue
+		}
+		agents = append(agents, user)
+		if strings.EqualFold(user.Email, arg) || strings.EqualFold(user.Name, arg) {
+			exact = append(exact, user)
+		}
+	}
+	if len(exact) > 0 {
+		agents = exact
+	}
+
+	switch len(agents) {
+	case 0:
+		return nil, fmt.Errorf("no agent found matching %q", arg)
+	case 1:
+		return &agents[0], nil
+	}
+
+	if !interactive() {
+		var matches []string
+		for _, user := range agents {
+			matches = append(matches, fmt.Sprintf("%s <%s> (%d)", user.Name, user.Email, user.ID))
+		}
+		return nil, fmt.Errorf("%d agents match %q, use a user ID instead:\n  %s", len(agents), arg, strings.Join(matches, "\n  "))
+	}
+
+	items := make([]string, len(agents))
+	for i, user := range agents {
+		items[i] = fmt.Sprintf("%s <%s> (ID: %d)", user.Name, user.Email, user.ID)
+	}
+	prompt := promptui.Select{
+		Label: fmt.Sprintf("Several agents match %q", arg),
+		Items: items,
+	}
+	idx, _, err := prompt.Run()
+	if err != nil {
+		return nil, err
+	}
+
+	return &agents[idx], nil
+}
+
+// Helper function for interactive string prompts. flag names the flag that
+// answers the prompt instead, for the error when zd can't prompt.
+func promptString(label string, required bool, flag string) (string, error) {
+	if !interactive() {
+		return "", fmt.Errorf("%s is required when not running interactively", flag)
+	}
+
 	prompt := promptui.Prompt{
 		Label: label,
 	}
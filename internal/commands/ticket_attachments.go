@@ -0,0 +1,247 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"zd-cli/internal/client"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// commentAttachment is a flattened view of an attachment and the comment it belongs to
+type commentAttachment struct {
+	CommentID   int64  `json:"comment_id"`
+	AuthorID    int64  `json:"author_id"`
+	CreatedAt   string `json:"created_at"`
+	ID          int64  `json:"id"`
+	FileName    string `json:"file_name"`
+	ContentType string `json:"content_type"`
+	Size        int64  `json:"size"`
+	Inline      bool   `json:"inline"`
+	ContentURL  string `json:"content_url"`
+	Path        string `json:"path,omitempty"`
+
+	attachment *client.Attachment
+}
+
+func newTicketAttachmentsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attachments <ticket-id>",
+		Short: "List and download attachments on a ticket's comments",
+		Long: `List the attachments on every comment of a ticket. Use --download to
+save them into a directory. Examples:
+  zd ticket attachments 12345
+  zd ticket attachments 12345 --download ./files`,
+		Args: cobra.ExactArgs(1),
+		RunE: withClient(runTicketAttachments),
+	}
+
+	cmd.Flags().String("download", "", "Download attachments into this directory")
+	cmd.Flags().Bool("include-inline", false, "Include inline images when downloading")
+	cmd.Flags().Bool("refresh", false, "Bypass cache and fetch fresh data")
+
+	return cmd
+}
+
+func runTicketAttachments(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	ticketID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid ticket ID: %s", args[0])
+	}
+
+	ctx := context.Background()
+
+	comments, err := zdClient.GetTicketComments(ctx, ticketID)
+	if err != nil {
+		return fmt.Errorf("failed to get ticket comments: %w", err)
+	}
+
+	var attachments []commentAttachment
+	for _, comment := range comments {
+		for i := range comment.Attachments {
+			attachment := &comment.Attachments[i]
+			attachments = append(attachments, commentAttachment{
+				CommentID:   comment.ID,
+				AuthorID:    comment.AuthorID,
+				CreatedAt:   comment.CreatedAt,
+				ID:          attachment.ID,
+				FileName:    attachment.FileName,
+				ContentType: attachment.ContentType,
+				Size:        attachment.Size,
+				Inline:      attachment.Inline,
+				ContentURL:  attachment.ContentURL,
+				attachment:  attachment,
+			})
+		}
+	}
+
+	if len(attachments) == 0 {
+		color.Yellow("No attachments found for ticket %d.\n", ticketID)
+		return nil
+	}
+
+	downloadDir, _ := cmd.Flags().GetString("download")
+	if downloadDir != "" {
+		includeInline, _ := cmd.Flags().GetBool("include-inline")
+		if err := downloadAttachments(zdClient, attachments, downloadDir, includeInline); err != nil {
+			return err
+		}
+	}
+
+	return outputAttachments(cmd, attachments, ticketID)
+}
+
+// downloadAttachments saves attachments into dir, recording where each one was written
+func downloadAttachments(zdClient *client.Client, attachments []commentAttachment, dir string, includeInline bool) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create download directory: %w", err)
+	}
+
+	failed := 0
+	for i := range attachments {
+		attachment := &attachments[i]
+		if attachment.Inline && !includeInline {
+			continue
+		}
+
+		path := attachmentPath(dir, attachment)
+		if err := downloadAttachment(zdClient, attachment.attachment, path); err != nil {
+			color.Red("✗ %s: %v\n", attachment.FileName, err)
+			failed++
+			continue
+		}
+		attachment.Path = path
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d attachment(s) failed to download", failed)
+	}
+
+	return nil
+}
+
+// downloadAttachment downloads a single attachment to path
+func downloadAttachment(zdClient *client.Client, attachment *client.Attachment, path string) error {
+	ctx, cancel := runContext(zdClient, 10*time.Minute)
+	defer cancel()
+
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create file: %w", err)
+	}
+
+	if _, err := zdClient.DownloadAttachment(ctx, attachment, f); err != nil {
+		f.Close()
+		os.Remove(path) // Clean up partial download
+		return err
+	}
+
+	return f.Close()
+}
+
+// uploadAttachments uploads files and returns the upload token to attach
+// them to a comment. All files share one token, so they appear on the same
+// comment.
+func uploadAttachments(zdClient *client.Client, paths []string) ([]string, error) {
+	if len(paths) == 0 {
+		return nil, nil
+	}
+
+	ctx, cancel := runContext(zdClient, 10*time.Minute)
+	defer cancel()
+
+	token := ""
+	for _, path := range paths {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open attachment: %w", err)
+		}
+
+		upload, err := zdClient.UploadFile(ctx, filepath.Base(path), f, token)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to upload %s: %w", path, err)
+		}
+
+		token = upload.Token
+		color.Green("✓ Uploaded %s\n", filepath.Base(path))
+	}
+
+	return []string{token}, nil
+}
+
+// attachmentsComment builds a comment body for attachments added without a message
+func attachmentsComment(paths []string) string {
+	names := make([]string, len(paths))
+	for i, path := range paths {
+		names[i] = filepath.Base(path)
+	}
+	return "Attached: " + strings.Join(names, ", ")
+}
+
+// attachmentPath picks a file name inside dir that doesn't clobber an existing file
+func attachmentPath(dir string, attachment *commentAttachment) string {
+	name := filepath.Base(attachment.FileName)
+	if name == "." || name == string(filepath.Separator) || name == "" {
+		name = fmt.Sprintf("attachment-%d", attachment.ID)
+	}
+
+	path := filepath.Join(dir, name)
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return path
+	}
+
+	return filepath.Join(dir, fmt.Sprintf("%d-%s", attachment.ID, name))
+}
+
+// outputAttachments outputs attachments in the requested format
+func outputAttachments(cmd *cobra.Command, attachments []commentAttachment, ticketID int64) error {
+	headers := []string{"comment_id", "id", "file_name", "content_type", "size", "inline", "content_url", "path"}
+	return writeOutput(cmd, attachments, headers, func() {
+		color.Cyan("Attachments for Ticket #%d (%d total)\n", ticketID, len(attachments))
+		color.White(strings.Repeat("─", 80) + "\n")
+
+		var lastComment int64
+		for _, attachment := range attachments {
+			if attachment.CommentID != lastComment {
+				color.White("\nComment %d | Author ID: %d | %s\n", attachment.CommentID, attachment.AuthorID, formatDate(attachment.CreatedAt))
+				lastComment = attachment.CommentID
+			}
+
+			inline := ""
+			if attachment.Inline {
+				inline = color.HiBlackString(" (inline)")
+			}
+
+			fmt.Printf("  %s | %s | %s | ID: %d%s\n",
+				color.CyanString(attachment.FileName),
+				formatSize(attachment.Size),
+				attachment.ContentType,
+				attachment.ID,
+				inline)
+
+			if attachment.Path != "" {
+				color.Green("    ✓ Saved to %s\n", attachment.Path)
+			}
+		}
+	})
+}
+
+// formatSize formats a byte count in human-readable units
+func formatSize(bytes int64) string {
+	switch {
+	case bytes >= 1024*1024:
+		return fmt.Sprintf("%.1f MB", float64(bytes)/(1024*1024))
+	case bytes >= 1024:
+		return fmt.Sprintf("%.1f KB", float64(bytes)/1024)
+	default:
+		return fmt.Sprintf("%d B", bytes)
+	}
+}
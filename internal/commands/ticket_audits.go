@@ -0,0 +1,207 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"zd-cli/internal/client"
+	"zd-cli/internal/output"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// auditEventRow is a flattened audit event for CSV output
+type auditEventRow struct {
+	AuditID       int64  `json:"audit_id"`
+	CreatedAt     string `json:"created_at"`
+	AuthorID      int64  `json:"author_id"`
+	Channel       string `json:"channel"`
+	EventID       int64  `json:"event_id"`
+	Type          string `json:"type"`
+	FieldName     string `json:"field_name"`
+	PreviousValue string `json:"previous_value"`
+	Value         string `json:"value"`
+}
+
+func newTicketAuditsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "audits <ticket-id>",
+		Short: "Show the audit timeline for a ticket",
+		Long: `Show a timeline of everything that happened to a ticket: field changes,
+assignments, comments, and notifications, with who made each change and when.`,
+		Args: cobra.ExactArgs(1),
+		RunE: withClient(runTicketAudits),
+	}
+
+	cmd.Flags().Int("page", 1, "Page number")
+	cmd.Flags().Int("per-page", 100, "Results per page (max 100)")
+	cmd.Flags().Bool("newest-first", false, "Show the most recent audits first")
+
+	return cmd
+}
+
+func runTicketAudits(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	ticketID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid ticket ID: %s", args[0])
+	}
+
+	page, _ := cmd.Flags().GetInt("page")
+	perPage, _ := cmd.Flags().GetInt("per-page")
+	newestFirst, _ := cmd.Flags().GetBool("newest-first")
+
+	if perPage > 100 {
+		perPage = 100
+	}
+
+	ctx := context.Background()
+
+	resp, err := zdClient.ListTicketAudits(ctx, ticketID, page, perPage, newestFirst)
+	if err != nil {
+		return fmt.Errorf("failed to get ticket audits: %w", err)
+	}
+
+	if len(resp.Audits) == 0 {
+		color.Yellow("No audits found for ticket %d.\n", ticketID)
+		return nil
+	}
+
+	return outputAudits(cmd, resp.Audits, ticketID, page, resp.Count, resp.NextPage)
+}
+
+// outputAudits outputs ticket audits in the requested format
+func outputAudits(cmd *cobra.Command, audits []client.Audit, ticketID int64, page, total int, nextPage string) error {
+	format, _ := cmd.Flags().GetString("output")
+	writer := output.NewWriter(output.Format(format))
+
+	switch output.Format(format) {
+	case output.FormatJSON:
+		return writer.WriteJSON(audits)
+
+	case output.FormatCSV:
+		var rows []auditEventRow
+		for _, audit := range audits {
+			for _, event := range audit.Events {
+				rows = append(rows, auditEventRow{
+					AuditID:       audit.ID,
+					CreatedAt:     audit.CreatedAt,
+					AuthorID:      audit.AuthorID,
+					Channel:       audit.Via.Channel,
+					EventID:       event.ID,
+					Type:          event.Type,
+					FieldName:     event.FieldName,
+					PreviousValue: formatAuditValue(event.PreviousValue),
+					Value:         formatAuditEventValue(event),
+				})
+			}
+		}
+		headers := []string{"audit_id", "created_at", "author_id", "channel", "event_id", "type", "field_name", "previous_value", "value"}
+		return writer.WriteCSV(rows, headers)
+
+	default:
+		// Table format (default)
+		color.Cyan("Audit Timeline for Ticket #%d (Page %d, showing %d of %d total)\n", ticketID, page, len(audits), total)
+		color.White(strings.Repeat("─", 80) + "\n")
+
+		for _, audit := range audits {
+			displayAudit(&audit)
+		}
+
+		// Show pagination info
+		if nextPage != "" {
+			fmt.Println()
+			color.White("More results available. Use --page %d to see next page.\n", page+1)
+		}
+
+		return nil
+	}
+}
+
+// Display an audit and its events
+func displayAudit(audit *client.Audit) {
+	via := ""
+	if audit.Via.Channel != "" {
+		via = " via " + audit.Via.Channel
+	}
+
+	color.Cyan("\n%s", formatDate(audit.CreatedAt))
+	color.White(" | Author ID: %d%s | Audit %d\n", audit.AuthorID, via, audit.ID)
+
+	for _, event := range audit.Events {
+		displayAuditEvent(&event)
+	}
+}
+
+// Display a single audit event
+func displayAuditEvent(event *client.AuditEvent) {
+	switch event.Type {
+	case "Create":
+		color.White("  + %s: %s\n", event.FieldName, formatAuditValue(event.Value))
+
+	case "Change":
+		switch event.FieldName {
+		case "status":
+			color.White("  ~ status: %s → %s\n",
+				getColoredStatus(formatAuditValue(event.PreviousValue)),
+				getColoredStatus(formatAuditValue(event.Value)))
+		case "assignee_id":
+			color.Magenta("  ~ assigned: %s → %s\n", formatAuditValue(event.PreviousValue), formatAuditValue(event.Value))
+		default:
+			color.White("  ~ %s: %s → %s\n", event.FieldName, formatAuditValue(event.PreviousValue), formatAuditValue(event.Value))
+		}
+
+	case "Comment":
+		visibility := "public"
+		if !event.Public {
+			visibility = color.YellowString("private")
+		}
+		body := strings.ReplaceAll(event.Body, "\n", " ")
+		if len(body) > 100 {
+			body = body[:100] + "..."
+		}
+		color.White("  💬 %s comment by %d: %s\n", visibility, event.AuthorID, body)
+
+	case "Notification", "Cc", "FollowerNotification":
+		color.HiBlack("  ✉ %s: %s (%d recipient(s))\n", strings.ToLower(event.Type), event.Subject, len(event.Recipients))
+
+	default:
+		color.HiBlack("  • %s\n", event.Type)
+	}
+}
+
+// formatAuditEventValue returns the value of an event, using the comment
+// body or notification subject for events without a field value
+func formatAuditEventValue(event client.AuditEvent) string {
+	switch {
+	case event.Type == "Comment":
+		return event.Body
+	case event.Value == nil && event.Subject != "":
+		return event.Subject
+	default:
+		return formatAuditValue(event.Value)
+	}
+}
+
+// formatAuditValue formats an audit event value for display
+func formatAuditValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "(none)"
+	case string:
+		if v == "" {
+			return "(none)"
+		}
+		return v
+	case []interface{}:
+		parts := make([]string, len(v))
+		for i, item := range v {
+			parts[i] = fmt.Sprintf("%v", item)
+		}
+		return strings.Join(parts, ", ")
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
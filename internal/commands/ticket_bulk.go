@@ -0,0 +1,426 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"zd-cli/internal/client"
+	"zd-cli/internal/output"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+func newTicketBulkUpdateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bulk-update [ticket-id...]",
+		Short: "Apply the same update to many tickets",
+		Long: `Apply the same update to many tickets using the Zendesk batch update endpoint.
+
+Ticket IDs can be passed as arguments, read from a file with --from-file,
+or piped on stdin with --from-file -. Tickets are submitted in batches of 100
+and each batch job is polled until it finishes. Examples:
+  zd ticket bulk-update 101 102 103 --status solved
+  zd ticket bulk-update --from-file ids.txt --assignee 987654 --add-tags escalated
+  zd ticket search "tag:outage" -o json | jq -r '.[].id' | zd ticket bulk-update --from-file - --priority urgent`,
+		RunE: withClient(runTicketBulkUpdate),
+	}
+
+	cmd.Flags().String("from-file", "", "Read ticket IDs from a file (use - for stdin)")
+	cmd.Flags().String("status", "", "New status: new, open, pending, hold, solved, closed")
+	cmd.Flags().String("priority", "", "New priority: low, normal, high, urgent")
+	cmd.Flags().Int64("assignee", 0, "New assignee user ID")
+	cmd.Flags().Int64("group", 0, "New group ID")
+	cmd.Flags().StringSlice("tags", []string{}, "Replace all tags (comma-separated)")
+	cmd.Flags().StringSlice("add-tags", []string{}, "Tags to add (comma-separated)")
+	cmd.Flags().StringSlice("remove-tags", []string{}, "Tags to remove (comma-separated)")
+
+	cmd.RegisterFlagCompletionFunc("tags", completeTags)
+	cmd.RegisterFlagCompletionFunc("add-tags", completeTags)
+	cmd.RegisterFlagCompletionFunc("remove-tags", completeTags)
+	cmd.RegisterFlagCompletionFunc("status", cobra.FixedCompletions(ticketStatuses, cobra.ShellCompDirectiveNoFileComp))
+	cmd.RegisterFlagCompletionFunc("priority", cobra.FixedCompletions(ticketPriorities, cobra.ShellCompDirectiveNoFileComp))
+	cmd.RegisterFlagCompletionFunc("group", completeGroupIDs)
+
+	return cmd
+}
+
+func runTicketBulkUpdate(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	fromFile, _ := cmd.Flags().GetString("from-file")
+	ticketIDs, err := collectIDs(args, fromFile)
+	if err != nil {
+		return err
+	}
+	if len(ticketIDs) == 0 {
+		return fmt.Errorf("no ticket IDs specified. Pass IDs as arguments or use --from-file")
+	}
+
+	// Build update request from flags
+	req := client.UpdateTicketRequest{}
+	updated := false
+
+	if cmd.Flags().Changed("status") {
+		status, _ := cmd.Flags().GetString("status")
+		req.Status = &status
+		updated = true
+	}
+
+	if cmd.Flags().Changed("priority") {
+		priority, _ := cmd.Flags().GetString("priority")
+		req.Priority = &priority
+		updated = true
+	}
+
+	if cmd.Flags().Changed("assignee") {
+		assigneeID, _ := cmd.Flags().GetInt64("assignee")
+		req.AssigneeID = &assigneeID
+		updated = true
+	}
+
+	if cmd.Flags().Changed("group") {
+		groupID, _ := cmd.Flags().GetInt64("group")
+		req.GroupID = &groupID
+		updated = true
+	}
+
+	if cmd.Flags().Changed("tags") {
+		tags, _ := cmd.Flags().GetStringSlice("tags")
+		req.Tags = tags
+		updated = true
+	}
+
+	if cmd.Flags().Changed("add-tags") {
+		tags, _ := cmd.Flags().GetStringSlice("add-tags")
+		req.AdditionalTags = tags
+		updated = true
+	}
+
+	if cmd.Flags().Changed("remove-tags") {
+		tags, _ := cmd.Flags().GetStringSlice("remove-tags")
+		req.RemoveTags = tags
+		updated = true
+	}
+
+	if !updated {
+		return fmt.Errorf("no updates specified. Use flags like --status, --priority, --assignee, --add-tags, etc.")
+	}
+
+	// Bulk jobs can take a while, so allow more time than a single request
+	ctx, cancel := jobContext(zdClient, 10*time.Minute)
+	defer cancel()
+
+	results, batchErr := runBulkBatches(ctx, zdClient, "Updating tickets", ticketIDs, client.MaxBulkTickets, func(batch []int64) (*client.JobStatus, error) {
+		return zdClient.UpdateManyTickets(ctx, batch, req)
+	})
+	if batchErr != nil && len(results) == 0 {
+		return batchErr
+	}
+
+	// Earlier batches were applied even if a later one failed, so they're
+	// reported before the error
+	if err := outputBulkResults(cmd, results, "ticket"); err != nil {
+		return err
+	}
+	return batchErr
+}
+
+// jobResultsFor returns one result per submitted ID. Tickets missing from the
+// job results (e.g. because the job failed) are reported as failures.
+func jobResultsFor(job *client.JobStatus, ids []int64) []client.JobStatusResult {
+	byID := make(map[int64]client.JobStatusResult)
+	for _, result := range job.Results {
+		byID[result.ID] = result
+	}
+
+	results := make([]client.JobStatusResult, 0, len(ids))
+	for _, id := range ids {
+		result, ok := byID[id]
+		if !ok {
+			result = client.JobStatusResult{ID: id, Error: "no result returned"}
+			if job.Message != "" {
+				result.Details = job.Message
+			}
+		} else if result.Error == "" && result.Status != "Failed" {
+			result.Success = true
+		}
+		results = append(results, result)
+	}
+
+	return results
+}
+
+// outputBulkResults outputs per-item job results in the requested format.
+// noun names the items, e.g. "ticket".
+func outputBulkResults(cmd *cobra.Command, results []client.JobStatusResult, noun string) error {
+	format, _ := cmd.Flags().GetString("output")
+	writer := output.NewWriter(output.Format(format))
+
+	switch output.Format(format) {
+	case output.FormatJSON:
+		return writer.WriteJSON(results)
+
+	case output.FormatCSV:
+		headers := []string{"id", "success", "status", "error", "details"}
+		return writer.WriteCSV(results, headers)
+
+	default:
+		// Table format (default)
+		var failures []client.JobStatusResult
+		for _, result := range results {
+			if !result.Success {
+				failures = append(failures, result)
+			}
+		}
+
+		succeeded := len(results) - len(failures)
+		if len(failures) == 0 {
+			color.Green("✓ Updated %d %s(s)\n", succeeded, noun)
+			return nil
+		}
+
+		color.Yellow("Updated %d of %d %s(s), %d failed\n", succeeded, len(results), noun, len(failures))
+		color.White(strings.Repeat("─", 80) + "\n")
+		for _, failure := range failures {
+			reason := failure.Error
+			if failure.Details != "" {
+				reason = fmt.Sprintf("%s (%s)", reason, failure.Details)
+			}
+			color.Red("  ✗ %s%s #%d: %s\n", strings.ToUpper(noun[:1]), noun[1:], failure.ID, reason)
+		}
+
+		return nil
+	}
+}
+
+func newTicketBulkCloseCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bulk-close [ticket-id...]",
+		Short: "Close or solve many tickets at once",
+		Long: `Close or solve many tickets using the Zendesk batch update endpoint.
+
+Tickets can be passed as IDs, read from a file with --from-file (use - for
+stdin), or found with a ticket search query with --query. The matching
+tickets are listed and must be confirmed before anything changes; --dry-run
+stops after the listing. Tickets that are already closed, or already solved
+with --status solved, are skipped.
+
+--report writes a CSV of the result for every ticket. Examples:
+  zd ticket bulk-close --query "status:pending updated<30days" --dry-run
+  zd ticket bulk-close --query "status:pending updated<30days" --report closed.csv
+  zd ticket bulk-close --from-file ids.txt --status solved --comment "Closing stale tickets"`,
+		RunE: withClient(runTicketBulkClose),
+	}
+
+	cmd.Flags().String("from-file", "", "Read ticket IDs from a file (use - for stdin)")
+	cmd.Flags().String("query", "", "Select the tickets matching a ticket search query")
+	cmd.Flags().String("status", "closed", "Status to set: closed or solved")
+	cmd.Flags().String("comment", "", "Add a public comment to every ticket (@file to read a file, - for stdin)")
+	cmd.Flags().String("report", "", "Write the result for every ticket to this CSV file")
+	cmd.Flags().Bool("dry-run", false, "List the tickets that would change without changing them")
+	cmd.Flags().Bool("force", false, "Skip confirmation prompt")
+
+	cmd.RegisterFlagCompletionFunc("status", cobra.FixedCompletions([]string{"closed", "solved"}, cobra.ShellCompDirectiveNoFileComp))
+	cmd.RegisterFlagCompletionFunc("query", cobra.NoFileCompletions)
+
+	return cmd
+}
+
+func runTicketBulkClose(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	fromFile, _ := cmd.Flags().GetString("from-file")
+	query, _ := cmd.Flags().GetString("query")
+	ticketIDs, err := collectIDs(args, fromFile)
+	if err != nil {
+		return err
+	}
+	if len(ticketIDs) == 0 && query == "" {
+		return fmt.Errorf("no tickets specified. Pass IDs as arguments, or use --from-file or --query")
+	}
+
+	status, _ := cmd.Flags().GetString("status")
+	if status != "closed" && status != "solved" {
+		return fmt.Errorf("invalid status %q: use closed or solved", status)
+	}
+
+	req := client.UpdateTicketRequest{Status: &status}
+	if cmd.Flags().Changed("comment") {
+		message, err := messageFromFlag(cmd, "comment")
+		if err != nil {
+			return err
+		}
+		req.Comment = &client.TicketComment{Body: message, Public: true}
+	}
+
+	reportPath, _ := cmd.Flags().GetString("report")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	force, _ := cmd.Flags().GetBool("force")
+	format, _ := cmd.Flags().GetString("output")
+	writer := output.NewWriter(output.Format(format))
+
+	// Bulk jobs can take a while, so allow more time than a single request
+	ctx, cancel := jobContext(zdClient, 10*time.Minute)
+	defer cancel()
+
+	tickets, err := resolveBulkTickets(ctx, zdClient, ticketIDs, query)
+	if err != nil {
+		return err
+	}
+
+	// Skip tickets already in the requested state; closed tickets can't change
+	var pending []client.Ticket
+	for _, ticket := range tickets {
+		if ticket.Status != "closed" && ticket.Status != status {
+			pending = append(pending, ticket)
+		}
+	}
+	if skipped := len(tickets) - len(pending); skipped > 0 {
+		fmt.Fprintf(os.Stderr, "Skipping %d ticket(s) already %s or closed\n", skipped, status)
+	}
+
+	if dryRun && !writer.IsTable() {
+		if pending == nil {
+			pending = []client.Ticket{}
+		}
+		if writer.IsJSON() {
+			return writer.WriteJSON(pending)
+		}
+		return writer.WriteCSV(pending, ticketListHeaders)
+	}
+
+	if len(pending) == 0 {
+		color.Yellow("No tickets to mark %s.\n", status)
+		return nil
+	}
+
+	if writer.IsTable() {
+		color.Cyan("Tickets to mark %s (%d)\n", status, len(pending))
+		color.White(strings.Repeat("─", 80) + "\n")
+		table := output.NewTable("ID", "STATUS", "SUBJECT", "UPDATED")
+		table.SetFlexColumn(2)
+		for _, ticket := range pending {
+			table.AddRow(fmt.Sprintf("%d", ticket.ID), getColoredStatus(ticket.Status), ticket.Subject, formatDate(ticket.UpdatedAt))
+		}
+		table.Print()
+		fmt.Println()
+	}
+
+	if dryRun {
+		color.Yellow("Dry run: no tickets were changed.\n")
+		return nil
+	}
+
+	// Confirmation unless --force
+	if !force {
+		color.Yellow("WARNING: This will mark %d ticket(s) %s\n", len(pending), status)
+		if status == "closed" {
+			color.Yellow("Closed tickets can't be reopened or updated.\n")
+		}
+		confirm, err := promptString("Type 'yes' to confirm", true, "--force")
+		if err != nil {
+			return err
+		}
+		if strings.ToLower(confirm) != "yes" {
+			color.Yellow("Cancelled.\n")
+			return nil
+		}
+	}
+
+	ids := make([]int64, len(pending))
+	for i, ticket := range pending {
+		ids[i] = ticket.ID
+	}
+
+	results, batchErr := runBulkBatches(ctx, zdClient, "Closing tickets", ids, client.MaxBulkTickets, func(batch []int64) (*client.JobStatus, error) {
+		return zdClient.UpdateManyTickets(ctx, batch, req)
+	})
+	if batchErr != nil && len(results) == 0 {
+		return batchErr
+	}
+
+	// Earlier batches were applied even if a later one failed, so they're
+	// reported before the error
+	if reportPath != "" {
+		if err := writeBulkReport(reportPath, results); err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "Wrote results for %d ticket(s) to %s\n", len(results), reportPath)
+	}
+
+	if err := outputBulkResults(cmd, results, "ticket"); err != nil {
+		return err
+	}
+	return batchErr
+}
+
+// resolveBulkTickets looks up the given ticket IDs and the tickets matching
+// query, returning each ticket once, ordered by ID. IDs that match no ticket
+// are reported on stderr and left out.
+func resolveBulkTickets(ctx context.Context, zdClient *client.Client, ticketIDs []int64, query string) ([]client.Ticket, error) {
+	byID := make(map[int64]client.Ticket)
+
+	if len(ticketIDs) > 0 {
+		tickets, err := zdClient.GetTicketsByIDs(ctx, ticketIDs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get tickets: %w", err)
+		}
+		for _, ticket := range tickets {
+			byID[ticket.ID] = ticket
+		}
+
+		var notFound []string
+		for _, id := range ticketIDs {
+			if _, ok := byID[id]; !ok {
+				notFound = append(notFound, fmt.Sprintf("%d", id))
+			}
+		}
+		if len(notFound) > 0 {
+			fmt.Fprintf(os.Stderr, "Warning: no ticket found with ID %s\n", strings.Join(notFound, ", "))
+		}
+	}
+
+	if query != "" {
+		found := 0
+		err := zdClient.SearchAllTickets(ctx, query, client.SearchOptions{PerPage: 100}, func(page []client.Ticket) error {
+			for _, ticket := range page {
+				byID[ticket.ID] = ticket
+			}
+			found += len(page)
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to search tickets: %w", err)
+		}
+		if found >= client.MaxSearchResults {
+			fmt.Fprintf(os.Stderr, "Warning: search returns at most %d tickets; narrow the query to cover the rest\n", client.MaxSearchResults)
+		}
+	}
+
+	tickets := make([]client.Ticket, 0, len(byID))
+	for _, ticket := range byID {
+		tickets = append(tickets, ticket)
+	}
+	sort.Slice(tickets, func(i, j int) bool { return tickets[i].ID < tickets[j].ID })
+
+	return tickets, nil
+}
+
+// writeBulkReport writes per-item job results to a CSV file
+func writeBulkReport(path string, results []client.JobStatusResult) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create report: %w", err)
+	}
+
+	headers := []string{"id", "success", "status", "error", "details"}
+	err = output.NewWriterTo(output.FormatCSV, f).WriteCSV(results, headers)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write report: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"zd-cli/internal/client"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+func newTicketDeleteCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete <ticket-id>",
+		Short: "Delete a ticket",
+		Long: `Soft-delete a ticket. Deleted tickets can be listed with 'zd ticket deleted list'
+and restored with 'zd ticket restore' until they are permanently removed.`,
+		Args: cobra.ExactArgs(1),
+		RunE: withClient(runTicketDelete),
+	}
+
+	cmd.Flags().Bool("force", false, "Skip confirmation prompt")
+
+	return cmd
+}
+
+func newTicketRestoreCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore <ticket-id>",
+		Short: "Restore a deleted ticket",
+		Args:  cobra.ExactArgs(1),
+		RunE:  withClient(runTicketRestore),
+	}
+
+	return cmd
+}
+
+func newTicketDeletedCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deleted",
+		Short: "Manage deleted tickets",
+	}
+
+	cmd.AddCommand(newTicketDeletedListCommand())
+
+	return cmd
+}
+
+func newTicketDeletedListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List deleted tickets",
+		RunE:  withClient(runTicketDeletedList),
+	}
+
+	cmd.Flags().Int("page", 1, "Page number")
+	cmd.Flags().Int("per-page", 25, "Results per page (max 100)")
+
+	return cmd
+}
+
+func runTicketDelete(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	ticketID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid ticket ID: %s", args[0])
+	}
+
+	// Confirmation unless --force
+	force, _ := cmd.Flags().GetBool("force")
+	if !force {
+		color.Yellow("WARNING: This will delete ticket %d\n", ticketID)
+		confirm, err := promptString("Type 'yes' to confirm", true, "--force")
+		if err != nil {
+			return err
+		}
+		if strings.ToLower(confirm) != "yes" {
+			color.Yellow("Deletion cancelled.\n")
+			return nil
+		}
+	}
+
+	ctx := context.Background()
+
+	if err := zdClient.DeleteTicket(ctx, ticketID); err != nil {
+		return fmt.Errorf("failed to delete ticket: %w", err)
+	}
+
+	color.Green("✓ Ticket #%d deleted\n", ticketID)
+	color.White("Restore it with: zd ticket restore %d\n", ticketID)
+
+	return nil
+}
+
+func runTicketRestore(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	ticketID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid ticket ID: %s", args[0])
+	}
+
+	ctx := context.Background()
+
+	if err := zdClient.RestoreTicket(ctx, ticketID); err != nil {
+		return fmt.Errorf("failed to restore ticket: %w", err)
+	}
+
+	color.Green("✓ Ticket #%d restored\n", ticketID)
+
+	return nil
+}
+
+func runTicketDeletedList(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	page, _ := cmd.Flags().GetInt("page")
+	perPage, _ := cmd.Flags().GetInt("per-page")
+
+	if perPage > 100 {
+		perPage = 100
+	}
+
+	ctx := context.Background()
+
+	resp, err := zdClient.ListDeletedTickets(ctx, page, perPage)
+	if err != nil {
+		return fmt.Errorf("failed to list deleted tickets: %w", err)
+	}
+
+	if len(resp.DeletedTickets) == 0 {
+		color.Yellow("No deleted tickets found.\n")
+		return nil
+	}
+
+	return outputDeletedTickets(cmd, resp.DeletedTickets, page, resp.Count, resp.NextPage)
+}
+
+// outputDeletedTickets outputs deleted tickets in the requested format
+func outputDeletedTickets(cmd *cobra.Command, tickets []client.DeletedTicket, page, total int, nextPage string) error {
+	headers := []string{"id", "subject", "previous_state", "deleted_at"}
+	return writeOutput(cmd, tickets, headers, func() {
+		color.Cyan("Deleted Tickets (Page %d, showing %d of %d total)\n", page, len(tickets), total)
+		color.White(strings.Repeat("─", 80) + "\n\n")
+
+		for i, ticket := range tickets {
+			fmt.Printf("#%-3d [%s] %s | ID: %d | Deleted %s by %s\n",
+				i+1,
+				getColoredStatus(ticket.PreviousState),
+				color.CyanString(ticket.Subject),
+				ticket.ID,
+				formatDate(ticket.DeletedAt),
+				ticket.Actor.Name)
+		}
+
+		// Show pagination info
+		if nextPage != "" {
+			fmt.Println()
+			color.White("More results available. Use --page %d to see next page.\n", page+1)
+		}
+	})
+}
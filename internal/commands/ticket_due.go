@@ -0,0 +1,262 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"zd-cli/internal/client"
+	"zd-cli/internal/output"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// ticketDueHeaders are the CSV columns for due tasks
+var ticketDueHeaders = []string{"id", "subject", "status", "priority", "assignee_id", "due_at"}
+
+// dueHour is the time of day a due date without a time is set to, as the
+// agent interface's date picker does
+const dueHour = 12
+
+func newTicketDueCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "due",
+		Short: "List tasks that are overdue or due soon",
+		Long: `List unsolved task tickets that are past their due date or due within
+--within, soonest first. Set due dates with --due on 'zd ticket create' and
+'zd ticket update'. Examples:
+  zd ticket due
+  zd ticket due --overdue --mine
+  zd ticket due --within 2w -o csv > due.csv`,
+		RunE: withClient(runTicketDue),
+	}
+
+	cmd.Flags().Bool("overdue", false, "Only list tasks past their due date")
+	cmd.Flags().String("within", "7d", "List tasks due within this long, e.g. 12h, 3d, or 2w")
+	cmd.Flags().Bool("mine", false, "Only list tasks assigned to you")
+
+	return cmd
+}
+
+func runTicketDue(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	overdue, _ := cmd.Flags().GetBool("overdue")
+	mine, _ := cmd.Flags().GetBool("mine")
+
+	now := time.Now()
+	cutoff := now
+	if !overdue {
+		within, _ := cmd.Flags().GetString("within")
+		since, ok := parseRelativeTime("-"+within, now)
+		if !ok {
+			return fmt.Errorf("invalid --within %q: use a length of time like 12h, 3d, or 2w", within)
+		}
+		cutoff = now.Add(now.Sub(since))
+	}
+
+	// Search narrows by date only, so tasks are filtered to the minute below
+	query := fmt.Sprintf("ticket_type:task status<solved due_date<=%s", cutoff.Format("2006-01-02"))
+	if mine {
+		query += " assignee:me"
+	}
+
+	ctx := client.WithoutTimeout(context.Background())
+
+	var tickets []client.Ticket
+	err := zdClient.SearchAllTickets(ctx, query, client.SearchOptions{PerPage: 100}, func(page []client.Ticket) error {
+		for _, ticket := range page {
+			if due, ok := ticketDueAt(&ticket); ok && due.Before(cutoff) {
+				tickets = append(tickets, ticket)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to search tickets: %w", err)
+	}
+
+	sort.SliceStable(tickets, func(i, j int) bool {
+		return *tickets[i].DueAt < *tickets[j].DueAt
+	})
+
+	return writeOutput(cmd, tickets, ticketDueHeaders, func() {
+		if len(tickets) == 0 {
+			if overdue {
+				color.Green("No overdue tasks.\n")
+			} else {
+				color.Green("No tasks overdue or due before %s.\n", cutoff.Format("2006-01-02 15:04"))
+			}
+			return
+		}
+		printDueTable(tickets, now)
+	})
+}
+
+// printDueTable prints tasks with when each is due, overdue ones in red
+func printDueTable(tickets []client.Ticket, now time.Time) {
+	table := output.NewTable("ID", "DUE", "WHEN", "STATUS", "PRIORITY", "ASSIGNEE", "SUBJECT")
+	table.SetFlexColumn(6)
+
+	names.prefetchTickets(tickets)
+
+	for _, ticket := range tickets {
+		due, _ := ticketDueAt(&ticket)
+		table.AddRow(
+			fmt.Sprintf("%d", ticket.ID),
+			due.Local().Format("2006-01-02 15:04"),
+			dueWhen(due, now),
+			getColoredStatus(ticket.Status),
+			orDash(getColoredPriority(ticket.Priority)),
+			optionalName(ticket.AssigneeID, names.userName),
+			ticket.Subject,
+		)
+	}
+
+	table.Print()
+}
+
+// ticketDueAt returns a ticket's due date, if it has one
+func ticketDueAt(ticket *client.Ticket) (time.Time, bool) {
+	if ticket.DueAt == nil || *ticket.DueAt == "" {
+		return time.Time{}, false
+	}
+	due, err := time.Parse(time.RFC3339, *ticket.DueAt)
+	return due, err == nil
+}
+
+// dueWhen describes how long until due, or how long overdue, e.g. "in 3d"
+// or "2h overdue"
+func dueWhen(due, now time.Time) string {
+	if due.Before(now) {
+		return color.RedString("%s overdue", shortDuration(now.Sub(due)))
+	}
+	return "in " + shortDuration(due.Sub(now))
+}
+
+// shortDuration formats d in its largest whole unit: days, hours, or minutes
+func shortDuration(d time.Duration) string {
+	switch {
+	case d >= 24*time.Hour:
+		return fmt.Sprintf("%dd", int(d/(24*time.Hour)))
+	case d >= time.Hour:
+		return fmt.Sprintf("%dh", int(d/time.Hour))
+	default:
+		return fmt.Sprintf("%dm", int(d/time.Minute))
+	}
+}
+
+// dueFromFlag returns --due as the UTC time to send as due_at. clear is true
+// for "none", which removes the due date.
+func dueFromFlag(cmd *cobra.Command) (due string, clear bool, err error) {
+	value, _ := cmd.Flags().GetString("due")
+	if strings.EqualFold(strings.TrimSpace(value), "none") {
+		return "", true, nil
+	}
+
+	t, err := parseDueDate(value, time.Now())
+	if err != nil {
+		return "", false, err
+	}
+	return t.UTC().Format(time.RFC3339), false, nil
+}
+
+// checkDueTicketType makes sure a ticket getting a due date is a task, as
+// Zendesk only keeps due dates on tasks. The type given with --type or in
+// --from-file counts; otherwise the ticket must already be a task, so an
+// incident isn't turned into a task without being asked.
+func checkDueTicketType(zdClient *client.Client, ticketID int64, newType *string, definition map[string]interface{}) error {
+	ticketType := ""
+	if newType != nil {
+		ticketType = *newType
+	} else if fileType, ok := definition["type"].(string); ok {
+		ticketType = fileType
+	} else {
+		ticket, err := zdClient.GetTicket(context.Background(), ticketID)
+		if err != nil {
+			return fmt.Errorf("failed to get ticket: %w", err)
+		}
+		if ticket.Type == "task" {
+			return nil
+		}
+		return fmt.Errorf("ticket #%d isn't a task (type: %s), and only tasks have due dates: add --type task to make it one", ticketID, orDash(ticket.Type))
+	}
+
+	if ticketType != "task" {
+		return fmt.Errorf("--due only applies to tasks; use --type task")
+	}
+	return nil
+}
+
+// parseDueDate parses a due date: a date or time (2026-10-30, 2026-10-30
+// 17:00, RFC3339), "today" or "tomorrow", a weekday with an optional "next"
+// ("friday", "next friday"), "next week" or "next month", or an offset such
+// as 3d, 2w, 4h, "in 3 days", or "in 2 weeks". Dates without a time are due
+// at noon.
+func parseDueDate(value string, now time.Time) (time.Time, error) {
+	text := strings.ToLower(strings.Join(strings.Fields(value), " "))
+	noon := time.Date(now.Year(), now.Month(), now.Day(), dueHour, 0, 0, 0, now.Location())
+
+	switch text {
+	case "today":
+		return noon, nil
+	case "tomorrow":
+		return noon.AddDate(0, 0, 1), nil
+	case "next week":
+		return noon.AddDate(0, 0, 7), nil
+	case "next month":
+		return noon.AddDate(0, 1, 0), nil
+	}
+
+	// The next one after today, so "friday" on a Friday is a week away
+	weekday := strings.TrimPrefix(strings.TrimPrefix(text, "next "), "this ")
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		name := strings.ToLower(day.String())
+		if weekday == name || weekday == name[:3] {
+			days := (int(day)-int(now.Weekday())+6)%7 + 1
+			return noon.AddDate(0, 0, days), nil
+		}
+	}
+
+	if due, ok := parseDueOffset(strings.TrimPrefix(text, "in "), now, noon); ok {
+		return due, nil
+	}
+
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	for _, layout := range []string{"2006-01-02 15:04", "2006-01-02T15:04"} {
+		if t, err := time.ParseInLocation(layout, value, now.Location()); err == nil {
+			return t, nil
+		}
+	}
+	if t, err := time.ParseInLocation("2006-01-02", value, now.Location()); err == nil {
+		return t.Add(dueHour * time.Hour), nil
+	}
+
+	return time.Time{}, fmt.Errorf("invalid due date %q: use a date like 2026-10-30, a day like friday or next friday, or an offset like 3d or \"in 2 weeks\"", value)
+}
+
+// parseDueOffset parses an offset from now, such as 3d, 4h, "3 days", or
+// "2 weeks". Days and weeks land on noon; hours keep the time of day.
+func parseDueOffset(text string, now, noon time.Time) (time.Time, bool) {
+	number := strings.TrimRightFunc(text, func(r rune) bool {
+		return r < '0' || r > '9'
+	})
+	n, err := strconv.Atoi(strings.TrimPrefix(number, "+"))
+	if err != nil || n < 0 {
+		return time.Time{}, false
+	}
+
+	switch strings.TrimSpace(text[len(number):]) {
+	case "h", "hour", "hours":
+		return now.Add(time.Duration(n) * time.Hour), true
+	case "d", "day", "days":
+		return noon.AddDate(0, 0, n), true
+	case "w", "week", "weeks":
+		return noon.AddDate(0, 0, 7*n), true
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,213 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"zd-cli/internal/client"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// NewTicketFieldCommand creates the ticket field command
+func NewTicketFieldCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "ticket-field",
+		Aliases: []string{"ticket-fields"},
+		Short:   "Browse Zendesk ticket fields",
+		Long:    "List ticket fields to discover custom field IDs, types, and dropdown options for use with --field.",
+	}
+
+	cmd.AddCommand(newTicketFieldListCommand())
+	cmd.AddCommand(newTicketFieldShowCommand())
+
+	// Add global output format flag to all subcommands
+	cmd.PersistentFlags().StringP("output", "o", "table", "Output format: table, json, csv")
+
+	return cmd
+}
+
+func newTicketFieldListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List ticket fields",
+		RunE:  withClient(runTicketFieldList),
+	}
+
+	cmd.Flags().Int("page", 1, "Page number")
+	cmd.Flags().Int("per-page", 100, "Results per page (max 100)")
+	cmd.Flags().Bool("custom", false, "Only show custom fields")
+	cmd.Flags().Bool("options", false, "Show dropdown and multi-select options")
+	cmd.Flags().Bool("refresh", false, "Bypass cache and fetch fresh data")
+
+	return cmd
+}
+
+func newTicketFieldShowCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show <field-id>",
+		Short: "Show detailed information for a specific ticket field",
+		Args:  cobra.ExactArgs(1),
+		RunE:  withClient(runTicketFieldShow),
+	}
+
+	cmd.Flags().Bool("refresh", false, "Bypass cache and fetch fresh data")
+
+	return cmd
+}
+
+func runTicketFieldList(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	page, _ := cmd.Flags().GetInt("page")
+	perPage, _ := cmd.Flags().GetInt("per-page")
+	customOnly, _ := cmd.Flags().GetBool("custom")
+	showOptions, _ := cmd.Flags().GetBool("options")
+
+	if perPage > 100 {
+		perPage = 100
+	}
+
+	ctx := context.Background()
+
+	resp, err := zdClient.ListTicketFields(ctx, page, perPage)
+	if err != nil {
+		return fmt.Errorf("failed to list ticket fields: %w", err)
+	}
+
+	fields := resp.TicketFields
+	if customOnly {
+		// System fields can't be removed; custom fields can
+		var custom []client.TicketField
+		for _, field := range fields {
+			if field.Removable {
+				custom = append(custom, field)
+			}
+		}
+		fields = custom
+	}
+
+	if len(fields) == 0 {
+		color.Yellow("No ticket fields found.\n")
+		return nil
+	}
+
+	return outputTicketFields(cmd, fields, page, resp.Count, resp.NextPage, showOptions)
+}
+
+func runTicketFieldShow(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	fieldID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid field ID: %s", args[0])
+	}
+
+	ctx := context.Background()
+
+	field, err := zdClient.GetTicketField(ctx, fieldID)
+	if err != nil {
+		return fmt.Errorf("failed to get ticket field: %w", err)
+	}
+
+	return outputTicketField(cmd, field)
+}
+
+// outputTicketField outputs a single ticket field in the requested format
+func outputTicketField(cmd *cobra.Command, field *client.TicketField) error {
+	headers := []string{"id", "title", "type", "active", "required", "removable", "created_at", "updated_at"}
+	return writeOutput(cmd, field, headers, func() {
+		displayTicketField(field)
+	})
+}
+
+// outputTicketFields outputs multiple ticket fields in the requested format
+func outputTicketFields(cmd *cobra.Command, fields []client.TicketField, page, total int, nextPage string, showOptions bool) error {
+	headers := []string{"id", "title", "type", "active", "required", "removable", "created_at", "updated_at"}
+	return writeOutput(cmd, fields, headers, func() {
+		color.Cyan("Ticket Fields (Page %d, showing %d of %d total)\n", page, len(fields), total)
+		color.White(strings.Repeat("─", 80) + "\n\n")
+
+		for i, field := range fields {
+			displayTicketFieldSummary(&field, i+1)
+			if showOptions {
+				for _, option := range field.CustomFieldOptions {
+					displayCustomFieldOption(option)
+				}
+			}
+		}
+
+		// Show pagination info
+		if nextPage != "" {
+			fmt.Println()
+			color.White("More results available. Use --page %d to see next page.\n", page+1)
+		}
+	})
+}
+
+// Display a ticket field summary (compact format)
+func displayTicketFieldSummary(field *client.TicketField, index int) {
+	badges := ""
+	if field.Required {
+		badges += " | " + color.RedString("required")
+	}
+	if !field.Active {
+		badges += " | " + color.YellowString("inactive")
+	}
+
+	fmt.Printf("#%-3d %s | ID: %d | %s%s\n",
+		index,
+		color.CyanString(field.Title),
+		field.ID,
+		field.Type,
+		badges)
+}
+
+// Display full ticket field details
+func displayTicketField(field *client.TicketField) {
+	color.Cyan("Ticket Field: %s\n", field.Title)
+	color.White(strings.Repeat("─", 80) + "\n")
+
+	color.White("ID:           %d\n", field.ID)
+	color.White("Type:         %s\n", field.Type)
+	if field.Active {
+		color.Green("Status:       active\n")
+	} else {
+		color.Yellow("Status:       inactive\n")
+	}
+	color.White("Required:     %t\n", field.Required)
+	color.White("Custom:       %t\n", field.Removable)
+
+	if field.Description != "" {
+		color.White("Description:  %s\n", field.Description)
+	}
+	if field.Tag != nil && *field.Tag != "" {
+		color.White("Tag:          %s\n", *field.Tag)
+	}
+	if field.RegexpForValidation != nil && *field.RegexpForValidation != "" {
+		color.White("Validation:   %s\n", *field.RegexpForValidation)
+	}
+
+	// Options
+	if len(field.CustomFieldOptions) > 0 {
+		color.White("\nOptions:\n")
+		for _, option := range field.CustomFieldOptions {
+			displayCustomFieldOption(option)
+		}
+	}
+
+	// Dates
+	color.White("\nDates:\n")
+	color.White("  Created:      %s\n", formatDate(field.CreatedAt))
+	color.White("  Last Updated: %s\n", formatDate(field.UpdatedAt))
+
+	color.White("\nUsage: zd ticket update <ticket-id> --field %d=<value>\n", field.ID)
+}
+
+// Display a dropdown option with the value to pass to --field
+func displayCustomFieldOption(option client.CustomFieldOption) {
+	defaultBadge := ""
+	if option.Default {
+		defaultBadge = color.GreenString(" (default)")
+	}
+	color.White("      %s → %s%s\n", option.Name, option.Value, defaultBadge)
+}
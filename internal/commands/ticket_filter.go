@@ -0,0 +1,142 @@
+package commands
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"zd-cli/internal/client"
+)
+
+// ticketFilterFields are the fields --filter expressions can test
+var ticketFilterFields = []string{"event", "status", "priority", "type", "group", "assignee", "requester", "organization", "brand", "tag", "subject", "via"}
+
+// ticketFilterOps are the operators of --filter expressions, longest first
+// so "!=" is found before "="
+var ticketFilterOps = []string{"!=", "!~", "=", "~"}
+
+// ticketFilter is one --filter expression: field, operator, and the values
+// it accepts. = and != compare exactly; ~ and !~ test for a substring,
+// ignoring case. A comma-separated value matches any of its values.
+type ticketFilter struct {
+	Field  string
+	Op     string
+	Values []string
+}
+
+// parseTicketFilters parses --filter expressions such as status=new,open,
+// priority!=low, tag~vip, or assignee=none
+func parseTicketFilters(exprs []string) ([]ticketFilter, error) {
+	var filters []ticketFilter
+	for _, expr := range exprs {
+		filter, err := parseTicketFilter(expr)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, filter)
+	}
+	return filters, nil
+}
+
+func parseTicketFilter(expr string) (ticketFilter, error) {
+	at, op := -1, ""
+	for _, candidate := range ticketFilterOps {
+		if i := strings.Index(expr, candidate); i > 0 && (at < 0 || i < at) {
+			at, op = i, candidate
+		}
+	}
+	if at < 0 {
+		return ticketFilter{}, fmt.Errorf("invalid filter %q: use field=value, field!=value, field~text, or field!~text", expr)
+	}
+
+	field := strings.ToLower(strings.TrimSpace(expr[:at]))
+	if field == "tags" {
+		field = "tag"
+	}
+	if !slices.Contains(ticketFilterFields, field) {
+		return ticketFilter{}, fmt.Errorf("invalid filter %q: unknown field %q (use %s)", expr, field, strings.Join(ticketFilterFields, ", "))
+	}
+
+	var values []string
+	for _, value := range strings.Split(expr[at+len(op):], ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, strings.ToLower(value))
+		}
+	}
+	if len(values) == 0 {
+		return ticketFilter{}, fmt.Errorf("invalid filter %q: no value", expr)
+	}
+
+	return ticketFilter{Field: field, Op: op, Values: values}, nil
+}
+
+// matchTicketFilters reports whether a ticket matches every filter. event is
+// "create" or "update".
+func matchTicketFilters(filters []ticketFilter, ticket *client.Ticket, event string) bool {
+	for _, filter := range filters {
+		if !filter.match(ticket, event) {
+			return false
+		}
+	}
+	return true
+}
+
+func (f ticketFilter) match(ticket *client.Ticket, event string) bool {
+	var actual []string
+	switch f.Field {
+	case "event":
+		actual = []string{event}
+	case "status":
+		actual = []string{ticket.Status}
+	case "priority":
+		actual = []string{ticket.Priority}
+	case "type":
+		actual = []string{ticket.Type}
+	case "group":
+		actual = []string{filterID(ticket.GroupID)}
+	case "assignee":
+		actual = []string{filterID(ticket.AssigneeID)}
+	case "requester":
+		actual = []string{strconv.FormatInt(ticket.RequesterID, 10)}
+	case "organization":
+		actual = []string{filterID(ticket.OrganizationID)}
+	case "brand":
+		actual = []string{strconv.FormatInt(ticket.BrandID, 10)}
+	case "tag":
+		actual = ticket.Tags
+	case "subject":
+		actual = []string{ticket.Subject}
+	case "via":
+		actual = []string{ticket.Via.Channel}
+	}
+
+	matched := false
+	for _, value := range f.Values {
+		for _, a := range actual {
+			a = strings.ToLower(a)
+			if f.Op == "=" || f.Op == "!=" {
+				matched = matched || a == value
+			} else {
+				matched = matched || strings.Contains(a, value)
+			}
+		}
+		// "none" matches an empty field
+		if value == "none" && (len(actual) == 0 || actual[0] == "") {
+			matched = true
+		}
+	}
+
+	if strings.HasPrefix(f.Op, "!") {
+		return !matched
+	}
+	return matched
+}
+
+// filterID formats an optional ID for comparison, empty when it is unset
+func filterID(id *int64) string {
+	if id == nil {
+		return ""
+	}
+	return strconv.FormatInt(*id, 10)
+}
@@ -0,0 +1,158 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"zd-cli/internal/client"
+	"zd-cli/internal/output"
+	"zd-cli/internal/progress"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+func newTicketImportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import historical tickets with their original timestamps",
+		Long: `Import tickets from another system with the ticket import API. Unlike
+create, imported tickets keep their created_at, updated_at, and solved_at
+timestamps, their comments with original authors and times, and their
+solved or closed status. Triggers don't run on imported tickets.
+
+The file holds a JSON array of tickets, {"tickets": [...]}, or one ticket
+per line (the format written by ticket export). Tickets are submitted in
+batches of 100 and each batch job is polled until it finishes. Examples:
+  zd ticket import --file tickets.json
+  zd ticket import --file closed.ndjson --archive-immediately`,
+		Args: cobra.NoArgs,
+		RunE: withClient(runTicketImport),
+	}
+
+	cmd.Flags().String("file", "", "JSON or NDJSON file of tickets to import (- for stdin)")
+	cmd.Flags().Bool("archive-immediately", false, "Archive closed tickets as they are imported")
+	cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+func runTicketImport(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	path, _ := cmd.Flags().GetString("file")
+	tickets, err := readDefinitions(path, "tickets")
+	if err != nil {
+		return err
+	}
+	if len(tickets) == 0 {
+		return fmt.Errorf("no tickets found in %s", path)
+	}
+
+	archive, _ := cmd.Flags().GetBool("archive-immediately")
+
+	// Import jobs can take a while, so allow more time than a single request
+	ctx, cancel := jobContext(zdClient, 30*time.Minute)
+	defer cancel()
+
+	var results []client.JobStatusResult
+	batches := (len(tickets) + client.MaxBulkTickets - 1) / client.MaxBulkTickets
+
+	bar := progress.NewBar("Importing tickets", len(tickets))
+	defer bar.Finish()
+	done := 0
+
+	for i := 0; i < batches; i++ {
+		start := i * client.MaxBulkTickets
+		batch := tickets[start:min(start+client.MaxBulkTickets, len(tickets))]
+
+		job, err := zdClient.ImportManyTickets(ctx, batch, archive)
+		if err != nil {
+			return fmt.Errorf("failed to submit batch %d/%d: %w", i+1, batches, err)
+		}
+
+		jobID := job.ID
+		job, err = zdClient.WaitForJob(ctx, job, func(job *client.JobStatus) {
+			bar.Set(done + job.Progress)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to track batch %d/%d (job %s): %w", i+1, batches, jobID, err)
+		}
+
+		if job.Status != "completed" {
+			bar.Clear()
+			color.Red("✗ Batch %d/%d %s: %s\n", i+1, batches, job.Status, job.Message)
+		}
+		results = append(results, importResultsFor(job, start, len(batch))...)
+		done += len(batch)
+		bar.Set(done)
+	}
+	bar.Finish()
+
+	return outputImportResults(cmd, results)
+}
+
+// importResultsFor returns one result per submitted ticket, with Index set
+// to the ticket's position in the file (counting from 1). Tickets missing
+// from the job results are reported as failures.
+func importResultsFor(job *client.JobStatus, offset, count int) []client.JobStatusResult {
+	byIndex := make(map[int]client.JobStatusResult)
+	for _, result := range job.Results {
+		byIndex[result.Index] = result
+	}
+
+	results := make([]client.JobStatusResult, 0, count)
+	for index := 0; index < count; index++ {
+		result, ok := byIndex[index]
+		if !ok {
+			result = client.JobStatusResult{Error: "no result returned", Details: job.Message}
+		} else if result.Error == "" && result.Status != "Failed" {
+			result.Success = true
+		}
+		result.Index = offset + index + 1
+		results = append(results, result)
+	}
+
+	return results
+}
+
+// outputImportResults outputs per-ticket import results in the requested
+// format
+func outputImportResults(cmd *cobra.Command, results []client.JobStatusResult) error {
+	format, _ := cmd.Flags().GetString("output")
+	writer := output.NewWriter(output.Format(format))
+
+	switch output.Format(format) {
+	case output.FormatJSON:
+		return writer.WriteJSON(results)
+
+	case output.FormatCSV:
+		headers := []string{"index", "id", "success", "status", "error", "details"}
+		return writer.WriteCSV(results, headers)
+
+	default:
+		var failures []client.JobStatusResult
+		for _, result := range results {
+			if !result.Success {
+				failures = append(failures, result)
+			}
+		}
+
+		imported := len(results) - len(failures)
+		if len(failures) == 0 {
+			color.Green("✓ Imported %d ticket(s)\n", imported)
+			return nil
+		}
+
+		color.Yellow("Imported %d of %d ticket(s), %d failed\n", imported, len(results), len(failures))
+		color.White(strings.Repeat("─", 80) + "\n")
+		for _, failure := range failures {
+			reason := failure.Error
+			if failure.Details != "" {
+				reason = fmt.Sprintf("%s (%s)", reason, failure.Details)
+			}
+			color.Red("  ✗ Ticket %d in file: %s\n", failure.Index, reason)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,110 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"zd-cli/internal/client"
+)
+
+// outputTicketMarkdown fetches a ticket's comment thread and writes the ticket
+// and the comments that pass filter as a Markdown document
+func outputTicketMarkdown(ctx context.Context, zdClient *client.Client, ticket *client.Ticket, filter commentFilter) error {
+	comments, err := zdClient.GetTicketComments(ctx, ticket.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get ticket comments: %w", err)
+	}
+	comments = filter.apply(comments)
+
+	userIDs := []int64{ticket.RequesterID}
+	for _, comment := range comments {
+		userIDs = append(userIDs, comment.AuthorID)
+	}
+
+	var authors map[int64]string
+	if names != nil {
+		names.prefetchUsers(userIDs)
+		authors = names.users
+	}
+
+	return writeTicketMarkdown(os.Stdout, ticket, comments, authors)
+}
+
+// writeTicketMarkdown writes a ticket as Markdown: YAML front matter with the
+// ticket's metadata, a title, and one section per comment
+func writeTicketMarkdown(w io.Writer, ticket *client.Ticket, comments []client.Comment, authors map[int64]string) error {
+	var b strings.Builder
+
+	// Front matter
+	b.WriteString("---\n")
+	fmt.Fprintf(&b, "id: %d\n", ticket.ID)
+	fmt.Fprintf(&b, "subject: %s\n", strconv.Quote(ticket.Subject))
+	fmt.Fprintf(&b, "status: %s\n", ticket.Status)
+	if ticket.Priority != "" {
+		fmt.Fprintf(&b, "priority: %s\n", ticket.Priority)
+	}
+	if ticket.Type != "" {
+		fmt.Fprintf(&b, "type: %s\n", ticket.Type)
+	}
+	fmt.Fprintf(&b, "requester: %s\n", strconv.Quote(authorName(ticket.RequesterID, authors)))
+	if ticket.AssigneeID != nil {
+		fmt.Fprintf(&b, "assignee_id: %d\n", *ticket.AssigneeID)
+	}
+	if ticket.GroupID != nil {
+		fmt.Fprintf(&b, "group_id: %d\n", *ticket.GroupID)
+	}
+	if ticket.OrganizationID != nil {
+		fmt.Fprintf(&b, "organization_id: %d\n", *ticket.OrganizationID)
+	}
+	if len(ticket.Tags) > 0 {
+		fmt.Fprintf(&b, "tags: [%s]\n", strings.Join(ticket.Tags, ", "))
+	}
+	fmt.Fprintf(&b, "created_at: %s\n", ticket.CreatedAt)
+	fmt.Fprintf(&b, "updated_at: %s\n", ticket.UpdatedAt)
+	if ticket.URL != "" {
+		fmt.Fprintf(&b, "url: %s\n", ticket.URL)
+	}
+	b.WriteString("---\n\n")
+
+	fmt.Fprintf(&b, "# %s (#%d)\n", ticket.Subject, ticket.ID)
+
+	// Comment thread
+	for i, comment := range comments {
+		visibility := "Public reply"
+		if !comment.Public {
+			visibility = "Internal note"
+		}
+
+		fmt.Fprintf(&b, "\n## Comment %d: %s\n\n", i+1, authorName(comment.AuthorID, authors))
+		fmt.Fprintf(&b, "*%s · %s*\n\n", visibility, formatDate(comment.CreatedAt))
+
+		body := comment.PlainBody
+		if body == "" {
+			body = comment.Body
+		}
+		b.WriteString(strings.TrimSpace(body))
+		b.WriteString("\n")
+
+		if len(comment.Attachments) > 0 {
+			b.WriteString("\n**Attachments:**\n\n")
+			for _, attachment := range comment.Attachments {
+				fmt.Fprintf(&b, "- [%s](%s)\n", attachment.FileName, attachment.ContentURL)
+			}
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// authorName returns the resolved name of a user, falling back to their ID
+func authorName(userID int64, authors map[int64]string) string {
+	if name := authors[userID]; name != "" {
+		return name
+	}
+	return fmt.Sprintf("User %d", userID)
+}
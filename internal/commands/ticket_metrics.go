@@ -0,0 +1,111 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"zd-cli/internal/client"
+	"zd-cli/internal/output"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// ticketWithMetrics is a ticket with its metrics, shaped like the ticket
+// Zendesk returns with include=metric_sets
+type ticketWithMetrics struct {
+	*client.Ticket
+	MetricSet *client.TicketMetric `json:"metric_set"`
+}
+
+func newTicketMetricsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "metrics <ticket-id>",
+		Short: "Show a ticket's reply, wait, and resolution times",
+		Long: `Show the metrics Zendesk records for a ticket: first reply time, first
+and full resolution times, agent and requester wait times, and time on hold,
+in calendar and business hours, along with reply, reopen, and reassignment
+counts. Examples:
+  zd ticket metrics 12345
+  zd ticket metrics 12345 -o json`,
+		Args: cobra.ExactArgs(1),
+		RunE: withClient(runTicketMetrics),
+	}
+
+	cmd.Flags().StringP("output", "o", "table", "Output format: table, json")
+
+	return cmd
+}
+
+func runTicketMetrics(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	ticketID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid ticket ID: %s", args[0])
+	}
+
+	ctx := context.Background()
+
+	metric, err := zdClient.GetTicketMetrics(ctx, ticketID)
+	if err != nil {
+		return fmt.Errorf("failed to get ticket metrics: %w", err)
+	}
+
+	format, _ := cmd.Flags().GetString("output")
+	if output.Format(format) == output.FormatJSON {
+		return output.NewWriter(output.FormatJSON).WriteJSON(metric)
+	}
+
+	color.Cyan("Ticket #%d metrics\n", ticketID)
+	color.White(strings.Repeat("─", 80) + "\n")
+	displayTicketMetrics(metric)
+
+	return nil
+}
+
+// displayTicketMetrics prints the times and counts of a ticket's metrics
+func displayTicketMetrics(metric *client.TicketMetric) {
+	color.White("\nMetrics:\n")
+	color.White("  First reply:       %s\n", formatMetricMinutes(metric.ReplyTimeInMinutes))
+	color.White("  First resolution:  %s\n", formatMetricMinutes(metric.FirstResolutionTimeInMinutes))
+	color.White("  Full resolution:   %s\n", formatMetricMinutes(metric.FullResolutionTimeInMinutes))
+	color.White("  Agent wait:        %s\n", formatMetricMinutes(metric.AgentWaitTimeInMinutes))
+	color.White("  Requester wait:    %s\n", formatMetricMinutes(metric.RequesterWaitTimeInMinutes))
+	color.White("  On hold:           %s\n", formatMetricMinutes(metric.OnHoldTimeInMinutes))
+	color.White("  Replies:           %d\n", metric.Replies)
+	color.White("  Reopens:           %d\n", metric.Reopens)
+	color.White("  Assignees:         %d\n", metric.AssigneeStations)
+	color.White("  Groups:            %d\n", metric.GroupStations)
+
+	dates := []struct {
+		label string
+		value *string
+	}{
+		{"Initially assigned", metric.InitiallyAssignedAt},
+		{"Assigned", metric.AssignedAt},
+		{"Solved", metric.SolvedAt},
+		{"Latest comment", metric.LatestCommentAddedAt},
+	}
+	for _, date := range dates {
+		if date.value != nil && *date.value != "" {
+			color.White("  %-19s%s\n", date.label+":", formatDate(*date.value))
+		}
+	}
+}
+
+// formatMetricMinutes formats a metric's calendar time, with its business
+// hours time when they differ
+func formatMetricMinutes(m client.MetricMinutes) string {
+	if m.Calendar == nil {
+		return "-"
+	}
+
+	calendar := float64(*m.Calendar)
+	s := formatMinutes(&calendar)
+	if m.Business != nil && *m.Business != *m.Calendar {
+		business := float64(*m.Business)
+		s += fmt.Sprintf(" (%s business)", formatMinutes(&business))
+	}
+	return s
+}
@@ -0,0 +1,236 @@
+package commands
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"zd-cli/internal/client"
+	"zd-cli/internal/output"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// maxMinePages caps how many pages of assigned tickets the dashboard reads
+const maxMinePages = 10
+
+// mineStatuses is the order statuses are shown in on the dashboard
+var mineStatuses = []string{"new", "open", "pending", "hold", "solved"}
+
+// slaRisk is a ticket whose next SLA target breaches within the risk window
+type slaRisk struct {
+	Ticket   client.Ticket `json:"ticket"`
+	Metric   string        `json:"metric"`
+	BreachAt string        `json:"breach_at"`
+	Breached bool          `json:"breached"`
+}
+
+// mineDashboard is the JSON form of the dashboard
+type mineDashboard struct {
+	User      *client.User    `json:"user"`
+	Counts    map[string]int  `json:"counts"`
+	Oldest    []client.Ticket `json:"oldest"`
+	SLAAtRisk []slaRisk       `json:"sla_at_risk"`
+	Truncated bool            `json:"truncated"`
+}
+
+func newTicketMineCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mine",
+		Short: "Show a dashboard of tickets assigned to you",
+		Long: `Show the tickets assigned to you grouped by status, the ones that have
+waited longest for an update, and the ones close to breaching an SLA.
+Closed tickets are left out. Examples:
+  zd ticket mine
+  zd ticket mine --sla-window 4h --oldest 10
+  zd ticket mine -o csv > my-tickets.csv`,
+		RunE: withClient(runTicketMine),
+	}
+
+	cmd.Flags().Duration("sla-window", 2*time.Hour, "Flag tickets whose SLA breaches within this window")
+	cmd.Flags().Int("oldest", 5, "Number of least recently updated tickets to show")
+	cmd.Flags().Bool("refresh", false, "Bypass cache and fetch fresh data")
+
+	return cmd
+}
+
+func runTicketMine(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	slaWindow, _ := cmd.Flags().GetDuration("sla-window")
+	oldestCount, _ := cmd.Flags().GetInt("oldest")
+
+	ctx, cancel := runContext(zdClient, 2*time.Minute)
+	defer cancel()
+
+	me, err := zdClient.GetMe(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get current user: %w", err)
+	}
+
+	// Collect every assigned ticket that is still in play
+	var tickets []client.Ticket
+	truncated := false
+	for page := 1; ; page++ {
+		resp, err := zdClient.GetUserTickets(ctx, me.ID, client.UserTicketsAssigned, page, 100)
+		if err != nil {
+			return fmt.Errorf("failed to get assigned tickets: %w", err)
+		}
+
+		for _, ticket := range resp.Tickets {
+			if ticket.Status != "closed" {
+				tickets = append(tickets, ticket)
+			}
+		}
+
+		if resp.NextPage == "" {
+			break
+		}
+		if page == maxMinePages {
+			truncated = true
+			break
+		}
+	}
+
+	dashboard := mineDashboard{
+		User:      me,
+		Counts:    make(map[string]int),
+		Oldest:    oldestTickets(tickets, oldestCount),
+		SLAAtRisk: ticketsAtRisk(tickets, time.Now(), slaWindow),
+		Truncated: truncated,
+	}
+	for _, ticket := range tickets {
+		dashboard.Counts[ticket.Status]++
+	}
+
+	return outputMineDashboard(cmd, &dashboard, tickets, slaWindow)
+}
+
+// oldestTickets returns up to n unsolved tickets, least recently updated first
+func oldestTickets(tickets []client.Ticket, n int) []client.Ticket {
+	var unsolved []client.Ticket
+	for _, ticket := range tickets {
+		if ticket.Status != "solved" {
+			unsolved = append(unsolved, ticket)
+		}
+	}
+
+	// RFC3339 timestamps in UTC sort correctly as strings
+	sort.SliceStable(unsolved, func(i, j int) bool {
+		return unsolved[i].UpdatedAt < unsolved[j].UpdatedAt
+	})
+
+	if len(unsolved) > n {
+		unsolved = unsolved[:n]
+	}
+	return unsolved
+}
+
+// ticketsAtRisk returns tickets with an active SLA target that breaches
+// before now+window, soonest breach first
+func ticketsAtRisk(tickets []client.Ticket, now time.Time, window time.Duration) []slaRisk {
+	var risks []slaRisk
+	for _, ticket := range tickets {
+		if ticket.SLAs == nil {
+			continue
+		}
+
+		var next *client.SLAPolicyMetric
+		var nextAt time.Time
+		for i, metric := range ticket.SLAs.PolicyMetrics {
+			if metric.Stage != "active" || metric.BreachAt == "" {
+				continue
+			}
+			breachAt, err := time.Parse(time.RFC3339, metric.BreachAt)
+			if err != nil {
+				continue
+			}
+			if next == nil || breachAt.Before(nextAt) {
+				next = &ticket.SLAs.PolicyMetrics[i]
+				nextAt = breachAt
+			}
+		}
+
+		if next != nil && nextAt.Before(now.Add(window)) {
+			risks = append(risks, slaRisk{
+				Ticket:   ticket,
+				Metric:   next.Metric,
+				BreachAt: next.BreachAt,
+				Breached: !nextAt.After(now),
+			})
+		}
+	}
+
+	sort.SliceStable(risks, func(i, j int) bool {
+		return risks[i].BreachAt < risks[j].BreachAt
+	})
+
+	return risks
+}
+
+// outputMineDashboard outputs the dashboard in the requested format
+func outputMineDashboard(cmd *cobra.Command, dashboard *mineDashboard, tickets []client.Ticket, slaWindow time.Duration) error {
+	format, _ := cmd.Flags().GetString("output")
+	writer := output.NewWriter(output.Format(format))
+
+	switch output.Format(format) {
+	case output.FormatJSON:
+		return writer.WriteJSON(dashboard)
+
+	case output.FormatCSV:
+		return writer.WriteCSV(tickets, ticketListHeaders)
+
+	default:
+		// Table format (default)
+		color.Cyan("My Work: %s (%d ticket(s))\n", dashboard.User.Name, len(tickets))
+		color.White(strings.Repeat("─", 80) + "\n")
+
+		// Status counts
+		var counts []string
+		for _, status := range mineStatuses {
+			counts = append(counts, fmt.Sprintf("%s %d", getColoredStatus(status), dashboard.Counts[status]))
+		}
+		fmt.Println(strings.Join(counts, "  |  "))
+
+		if dashboard.Truncated {
+			color.Yellow("Showing the first %d assigned tickets only.\n", maxMinePages*100)
+		}
+
+		// SLA at risk
+		fmt.Println()
+		if len(dashboard.SLAAtRisk) == 0 {
+			color.Green("SLA at risk (next %s): none\n", slaWindow)
+		} else {
+			color.Red("SLA at risk (next %s): %d\n", slaWindow, len(dashboard.SLAAtRisk))
+			for i, risk := range dashboard.SLAAtRisk {
+				due := "breaches " + formatDate(risk.BreachAt)
+				if risk.Breached {
+					due = color.RedString("breached %s", formatDate(risk.BreachAt))
+				}
+				fmt.Printf("#%-3d %s | %s | %s | ID: %d\n",
+					i+1,
+					getColoredPriority(risk.Ticket.Priority),
+					risk.Metric,
+					due,
+					risk.Ticket.ID)
+				color.White("     %s\n", risk.Ticket.Subject)
+			}
+		}
+
+		// Oldest updated
+		fmt.Println()
+		if len(dashboard.Oldest) > 0 {
+			color.Cyan("Least recently updated:\n")
+			for i, ticket := range dashboard.Oldest {
+				fmt.Printf("#%-3d %s | Updated %s | ID: %d\n",
+					i+1,
+					getColoredStatus(ticket.Status),
+					formatDate(ticket.UpdatedAt),
+					ticket.ID)
+				color.White("     %s\n", ticket.Subject)
+			}
+		}
+
+		return nil
+	}
+}
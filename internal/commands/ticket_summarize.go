@@ -0,0 +1,252 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"zd-cli/internal/client"
+	"zd-cli/internal/output"
+	"zd-cli/internal/progress"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// summarizeTimeout is how long the summarizer command or webhook may take.
+// Language models can be slow on long threads.
+const summarizeTimeout = 5 * time.Minute
+
+// ticketSummary is a ticket summary as output by ticket summarize
+type ticketSummary struct {
+	TicketID int64  `json:"ticket_id"`
+	Subject  string `json:"subject"`
+	Summary  string `json:"summary"`
+}
+
+func newTicketSummarizeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "summarize <ticket-id>",
+		Short: "Summarize a ticket's conversation with your own tool",
+		Long: `Summarize a ticket's conversation by handing it to a command or webhook you
+choose, such as an LLM command line tool or an internal service, and print
+what it returns. zd doesn't call any AI service itself.
+
+A command runs in the shell with the thread as Markdown on stdin, and the
+ticket in environment variables (ZD_TICKET_ID, ZD_TICKET_SUBJECT,
+ZD_TICKET_URL, ZD_INSTANCE). Its stdout is the summary.
+
+A webhook receives a JSON POST with the thread as Markdown in "text", plus
+"ticket", "comments", "url", and "instance". It may answer with plain text,
+or JSON with the summary in a "summary" or "text" field.
+
+Set the summarizer once with 'zd config set summarize_command' or
+'zd config set summarize_webhook'. Examples:
+  zd config set summarize_command 'llm -s "Summarize this support ticket"'
+  zd ticket summarize 12345
+  zd ticket summarize 12345 --public-only
+  zd ticket summarize 12345 --webhook https://summarizer.internal/tickets`,
+		Args: cobra.ExactArgs(1),
+		RunE: withClient(runTicketSummarize),
+	}
+
+	cmd.Flags().String("command", "", "Command to pipe the thread to (default: summarize_command from config)")
+	cmd.Flags().String("webhook", "", "URL to POST the thread to (default: summarize_webhook from config)")
+	cmd.Flags().Bool("public-only", false, "Leave internal notes out of the thread")
+
+	useConfigDefault(cmd, "summarize_command")
+	useConfigDefault(cmd, "summarize_webhook")
+
+	return cmd
+}
+
+func runTicketSummarize(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	ticketID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid ticket ID: %s", args[0])
+	}
+
+	command, _ := cmd.Flags().GetString("command")
+	webhook, _ := cmd.Flags().GetString("webhook")
+	publicOnly, _ := cmd.Flags().GetBool("public-only")
+
+	// An explicit flag wins over whichever summarizer the config sets
+	if cmd.Flags().Changed("command") {
+		webhook = ""
+	} else if cmd.Flags().Changed("webhook") {
+		command = ""
+	}
+
+	if command == "" && webhook == "" {
+		return fmt.Errorf("no summarizer configured: give --command or --webhook, or set one with 'zd config set summarize_command <command>'")
+	}
+	if command != "" && webhook != "" {
+		return fmt.Errorf("both summarize_command and summarize_webhook are set: unset one, or pick with --command or --webhook")
+	}
+
+	ctx := context.Background()
+
+	ticket, err := zdClient.GetTicket(ctx, ticketID)
+	if err != nil {
+		return fmt.Errorf("failed to get ticket: %w", err)
+	}
+
+	comments, err := zdClient.GetTicketComments(ctx, ticketID)
+	if err != nil {
+		return fmt.Errorf("failed to get ticket comments: %w", err)
+	}
+	comments = commentFilter{publicOnly: publicOnly}.apply(comments)
+
+	userIDs := []int64{ticket.RequesterID}
+	for _, comment := range comments {
+		userIDs = append(userIDs, comment.AuthorID)
+	}
+
+	var authors map[int64]string
+	if names != nil {
+		names.prefetchUsers(userIDs)
+		authors = names.users
+	}
+
+	var thread bytes.Buffer
+	if err := writeTicketMarkdown(&thread, ticket, comments, authors); err != nil {
+		return err
+	}
+
+	format, _ := cmd.Flags().GetString("output")
+	writer := output.NewWriter(output.Format(format))
+
+	summarizeCtx, cancelSummarize := context.WithTimeout(context.Background(), summarizeTimeout)
+	defer cancelSummarize()
+
+	// The spinner writes to stdout, so only show it above a table
+	spinner := progress.NewSpinner("Summarizing ticket...")
+	if writer.IsTable() {
+		spinner.Start()
+	}
+
+	var summary string
+	if command != "" {
+		summary, err = summarizeWithCommand(summarizeCtx, zdClient, command, ticket, thread.Bytes())
+	} else {
+		summary, err = summarizeWithWebhook(summarizeCtx, zdClient, webhook, ticket, comments, thread.String())
+	}
+	spinner.Stop()
+	if err != nil {
+		return fmt.Errorf("failed to summarize ticket: %w", err)
+	}
+
+	result := ticketSummary{TicketID: ticket.ID, Subject: ticket.Subject, Summary: strings.TrimSpace(summary)}
+
+	switch output.Format(format) {
+	case output.FormatJSON:
+		return writer.WriteJSON(result)
+
+	case output.FormatCSV:
+		return writer.WriteCSV([]ticketSummary{result}, []string{"ticket_id", "subject", "summary"})
+
+	default:
+		// Table format (default)
+		color.Cyan("Summary of ticket #%d: %s\n", ticket.ID, ticket.Subject)
+		color.White(strings.Repeat("─", 80) + "\n")
+		fmt.Println(result.Summary)
+
+		return nil
+	}
+}
+
+// summarizeWithCommand pipes the thread to a command and returns its stdout
+func summarizeWithCommand(ctx context.Context, zdClient *client.Client, command string, ticket *client.Ticket, thread []byte) (string, error) {
+	shell, flag := "sh", "-c"
+	if runtime.GOOS == "windows" {
+		shell, flag = "cmd", "/C"
+	}
+
+	var stdout bytes.Buffer
+	run := exec.CommandContext(ctx, shell, flag, command)
+	run.Env = append(os.Environ(),
+		fmt.Sprintf("ZD_TICKET_ID=%d", ticket.ID),
+		"ZD_TICKET_SUBJECT="+ticket.Subject,
+		"ZD_TICKET_URL="+zdClient.AgentURL("tickets", ticket.ID),
+		"ZD_INSTANCE="+zdClient.Subdomain(),
+	)
+	run.Stdin = bytes.NewReader(thread)
+	run.Stdout = &stdout
+	run.Stderr = os.Stderr
+	if err := run.Run(); err != nil {
+		return "", fmt.Errorf("command failed: %w", err)
+	}
+
+	if strings.TrimSpace(stdout.String()) == "" {
+		return "", fmt.Errorf("command printed no summary")
+	}
+	return stdout.String(), nil
+}
+
+// summarizeWithWebhook posts the thread to a webhook and returns the summary
+// from its response
+func summarizeWithWebhook(ctx context.Context, zdClient *client.Client, webhook string, ticket *client.Ticket, comments []client.Comment, thread string) (string, error) {
+	payload, err := json.Marshal(map[string]interface{}{
+		"text":     thread,
+		"instance": zdClient.Subdomain(),
+		"url":      zdClient.AgentURL("tickets", ticket.ID),
+		"ticket":   ticket,
+		"comments": comments,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook, bytes.NewReader(payload))
+	if err != nil {
+		return "", fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("webhook failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read webhook response: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", fmt.Errorf("webhook returned %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	return webhookSummary(body)
+}
+
+// webhookSummary reads the summary from a webhook response: the "summary" or
+// "text" field of a JSON object, or the whole body as plain text
+func webhookSummary(body []byte) (string, error) {
+	var fields struct {
+		Summary string `json:"summary"`
+		Text    string `json:"text"`
+	}
+	if err := json.Unmarshal(body, &fields); err == nil {
+		if fields.Summary != "" {
+			return fields.Summary, nil
+		}
+		if fields.Text != "" {
+			return fields.Text, nil
+		}
+	}
+
+	if strings.TrimSpace(string(body)) == "" {
+		return "", fmt.Errorf("webhook returned no summary")
+	}
+	return string(body), nil
+}
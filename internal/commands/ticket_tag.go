@@ -0,0 +1,119 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"zd-cli/internal/client"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+func newTicketTagCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tag",
+		Short: "Add or remove tags on a ticket",
+		Long: `Add or remove individual tags on a ticket without replacing its other tags.
+Unlike 'zd ticket update --tags', which replaces the full tag list, these
+commands only change the tags you name.`,
+	}
+
+	cmd.AddCommand(newTicketTagAddCommand())
+	cmd.AddCommand(newTicketTagRemoveCommand())
+
+	return cmd
+}
+
+func newTicketTagAddCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "add <ticket-id> <tag...>",
+		Short:             "Add tags to a ticket",
+		Args:              cobra.MinimumNArgs(2),
+		RunE:              withClient(runTicketTagAdd),
+		ValidArgsFunction: completeTicketTagArgs,
+	}
+
+	return cmd
+}
+
+func newTicketTagRemoveCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "remove <ticket-id> <tag...>",
+		Short:             "Remove tags from a ticket",
+		Args:              cobra.MinimumNArgs(2),
+		RunE:              withClient(runTicketTagRemove),
+		ValidArgsFunction: completeTicketTagArgs,
+	}
+
+	return cmd
+}
+
+func runTicketTagAdd(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	ticketID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid ticket ID: %s", args[0])
+	}
+
+	tags := parseTagArgs(args[1:])
+
+	ctx := context.Background()
+
+	result, err := zdClient.AddTicketTags(ctx, ticketID, tags)
+	if err != nil {
+		return fmt.Errorf("failed to add tags: %w", err)
+	}
+
+	color.Green("✓ Added %s to ticket #%d\n", strings.Join(tags, ", "), ticketID)
+	color.White("Tags: %s\n", strings.Join(result, ", "))
+
+	return nil
+}
+
+func runTicketTagRemove(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	ticketID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid ticket ID: %s", args[0])
+	}
+
+	tags := parseTagArgs(args[1:])
+
+	ctx := context.Background()
+
+	result, err := zdClient.RemoveTicketTags(ctx, ticketID, tags)
+	if err != nil {
+		return fmt.Errorf("failed to remove tags: %w", err)
+	}
+
+	color.Green("✓ Removed %s from ticket #%d\n", strings.Join(tags, ", "), ticketID)
+	if len(result) > 0 {
+		color.White("Tags: %s\n", strings.Join(result, ", "))
+	} else {
+		color.White("Tags: (none)\n")
+	}
+
+	return nil
+}
+
+// completeTicketTagArgs completes tag names after the ticket ID
+func completeTicketTagArgs(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) == 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return completeTags(cmd, args, toComplete)
+}
+
+// parseTagArgs splits tag arguments, accepting both "a b" and "a,b"
+func parseTagArgs(args []string) []string {
+	var tags []string
+	for _, arg := range args {
+		for _, tag := range strings.Split(arg, ",") {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				tags = append(tags, tag)
+			}
+		}
+	}
+	return tags
+}
@@ -0,0 +1,220 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"zd-cli/internal/client"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// minWatchInterval keeps watch from hammering the API
+const minWatchInterval = 5 * time.Second
+
+func newTicketWatchCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "watch <ticket-id>",
+		Short: "Watch a ticket for new comments and changes",
+		Long: `Poll a ticket and print new comments and field changes as they happen.
+New changes also fire the hooks set up with 'zd notify'. Press Ctrl+C to
+stop. Examples:
+  zd ticket watch 12345
+  zd ticket watch 12345 --interval 10s`,
+		Args: cobra.ExactArgs(1),
+		RunE: withClient(runTicketWatch),
+	}
+
+	cmd.Flags().Duration("interval", 30*time.Second, "Polling interval (minimum 5s)")
+	addNotifyFlag(cmd)
+
+	// Watching always needs fresh data
+	cmd.Flags().Bool("refresh", true, "Bypass cache and fetch fresh data")
+	cmd.Flags().MarkHidden("refresh")
+
+	return cmd
+}
+
+func runTicketWatch(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	ticketID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid ticket ID: %s", args[0])
+	}
+
+	interval, _ := cmd.Flags().GetDuration("interval")
+	if interval < minWatchInterval {
+		interval = minWatchInterval
+	}
+
+	notifier, err := newNotifier(cmd, zdClient)
+	if err != nil {
+		return err
+	}
+
+	// Stop cleanly on Ctrl+C
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	ticket, lastAuditID, _, err := pollTicket(ctx, zdClient, ticketID, 0)
+	if err != nil {
+		return err
+	}
+
+	color.Cyan("Watching Ticket #%d: %s\n", ticket.ID, ticket.Subject)
+	color.White("Status: %s | Priority: %s | Updated: %s\n", getColoredStatus(ticket.Status), ticket.Priority, formatDate(ticket.UpdatedAt))
+	if notifier != nil {
+		color.White("Notify hooks: %s\n", notifier.describe())
+	}
+	color.White("Polling every %s. Press Ctrl+C to stop.\n", interval)
+	color.White(strings.Repeat("─", 80) + "\n")
+
+	lastUpdated := ticket.UpdatedAt
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			fmt.Println()
+			color.White("Stopped watching ticket #%d.\n", ticketID)
+			return nil
+		case <-ticker.C:
+		}
+
+		ticket, err := zdClient.GetTicket(ctx, ticketID)
+		if err != nil {
+			if ctx.Err() != nil {
+				continue
+			}
+			color.Red("✗ Failed to refresh ticket: %v\n", err)
+			continue
+		}
+
+		if ticket.UpdatedAt == lastUpdated {
+			continue
+		}
+
+		ticket, newLastAuditID, audits, err := pollTicket(ctx, zdClient, ticketID, lastAuditID)
+		if err != nil {
+			if ctx.Err() != nil {
+				continue
+			}
+			color.Red("✗ Failed to fetch ticket changes: %v\n", err)
+			continue
+		}
+
+		lastUpdated = ticket.UpdatedAt
+		lastAuditID = newLastAuditID
+
+		for _, audit := range audits {
+			if entry := watchAuditEntry(ticket, &audit); len(entry.Changes) > 0 {
+				notifier.notify(ctx, entry)
+			}
+		}
+	}
+}
+
+// pollTicket fetches the ticket and prints audits newer than sinceAuditID,
+// returning the newest audit ID seen and the audits printed. When
+// sinceAuditID is 0 nothing is printed; the newest audit ID is only recorded
+// as the starting point.
+func pollTicket(ctx context.Context, zdClient *client.Client, ticketID int64, sinceAuditID int64) (*client.Ticket, int64, []client.Audit, error) {
+	ticket, err := zdClient.GetTicket(ctx, ticketID)
+	if err != nil {
+		return nil, 0, nil, fmt.Errorf("failed to get ticket: %w", err)
+	}
+
+	resp, err := zdClient.ListTicketAudits(ctx, ticketID, 1, 100, true)
+	if err != nil {
+		return nil, 0, nil, fmt.Errorf("failed to get ticket audits: %w", err)
+	}
+
+	// Print new audits in chronological order
+	var audits []client.Audit
+	lastAuditID := sinceAuditID
+	for _, audit := range resp.Audits {
+		if audit.ID > lastAuditID {
+			lastAuditID = audit.ID
+		}
+		if sinceAuditID > 0 && audit.ID > sinceAuditID {
+			audits = append(audits, audit)
+		}
+	}
+	sort.Slice(audits, func(i, j int) bool { return audits[i].ID < audits[j].ID })
+
+	for _, audit := range audits {
+		displayWatchAudit(&audit)
+	}
+
+	return ticket, lastAuditID, audits, nil
+}
+
+// watchAuditEntry describes an audit the way tail describes a ticket event,
+// for notify hooks
+func watchAuditEntry(ticket *client.Ticket, audit *client.Audit) *tailEntry {
+	entry := &tailEntry{
+		Time:      audit.CreatedAt,
+		Event:     "update",
+		TicketID:  ticket.ID,
+		UpdaterID: audit.AuthorID,
+		Via:       audit.Via.Channel,
+		Changes:   []string{},
+		Ticket:    ticket,
+	}
+	for _, event := range audit.Events {
+		switch event.Type {
+		case "Comment":
+			if event.Public {
+				entry.Changes = append(entry.Changes, "public comment")
+			} else {
+				entry.Changes = append(entry.Changes, "internal note")
+			}
+		case "Change":
+			entry.Changes = append(entry.Changes, fmt.Sprintf("%s: %s → %s", event.FieldName, formatAuditValue(event.PreviousValue), formatAuditValue(event.Value)))
+		}
+	}
+	return entry
+}
+
+// Display the comments and field changes in an audit
+func displayWatchAudit(audit *client.Audit) {
+	for _, event := range audit.Events {
+		switch event.Type {
+		case "Comment":
+			visibility := "public"
+			if !event.Public {
+				visibility = color.YellowString("private")
+			}
+			color.Cyan("[%s] ", formatDate(audit.CreatedAt))
+			color.White("New %s comment from author %d:\n", visibility, event.AuthorID)
+
+			body := event.Body
+			if len(body) > 500 {
+				body = body[:500] + "..."
+			}
+			color.White("%s\n\n", body)
+
+		case "Change":
+			color.Cyan("[%s] ", formatDate(audit.CreatedAt))
+			if event.FieldName == "status" {
+				color.White("Status: %s → %s (by %d)\n",
+					getColoredStatus(formatAuditValue(event.PreviousValue)),
+					getColoredStatus(formatAuditValue(event.Value)),
+					audit.AuthorID)
+			} else {
+				color.White("%s: %s → %s (by %d)\n",
+					event.FieldName,
+					formatAuditValue(event.PreviousValue),
+					formatAuditValue(event.Value),
+					audit.AuthorID)
+			}
+		}
+	}
+}
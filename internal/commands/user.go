@@ -3,10 +3,13 @@ package commands
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"os"
 	"strconv"
 	"strings"
 	"time"
 
+	"zd-cli/internal/cache"
 	"zd-cli/internal/client"
 	"zd-cli/internal/config"
 	"zd-cli/internal/output"
@@ -15,6 +18,9 @@ import (
 	"github.com/spf13/cobra"
 )
 
+// userListHeaders are the CSV columns for user lists
+var userListHeaders = []string{"id", "name", "email", "role", "active", "verified", "suspended", "organization_id", "phone", "time_zone", "created_at", "updated_at"}
+
 // NewUserCommand creates the user management command
 func NewUserCommand() *cobra.Command {
 	cmd := &cobra.Command{
@@ -27,11 +33,20 @@ func NewUserCommand() *cobra.Command {
 	cmd.AddCommand(newUserListCommand())
 	cmd.AddCommand(newUserSearchCommand())
 	cmd.AddCommand(newUserShowCommand())
+	cmd.AddCommand(newUserOpenCommand())
+	cmd.AddCommand(newUserTicketsCommand())
+	cmd.AddCommand(newUserRelatedCommand())
 	cmd.AddCommand(newUserCreateCommand())
 	cmd.AddCommand(newUserUpdateCommand())
 	cmd.AddCommand(newUserSuspendCommand())
 	cmd.AddCommand(newUserUnsuspendCommand())
+	cmd.AddCommand(newUserBulkSuspendCommand())
+	cmd.AddCommand(newUserBulkUnsuspendCommand())
+	cmd.AddCommand(newUserSetPasswordCommand())
+	cmd.AddCommand(newUserSendVerificationCommand())
 	cmd.AddCommand(newUserDeleteCommand())
+	cmd.AddCommand(newUserPurgeCommand())
+	cmd.AddCommand(newUserDeletedCommand())
 
 	// Add global output format flag to all subcommands
 	cmd.PersistentFlags().StringP("output", "o", "table", "Output format: table, json, csv")
@@ -43,7 +58,7 @@ func newUserMeCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "me",
 		Short: "Show current authenticated user",
-		RunE:  runUserMe,
+		RunE:  withClient(runUserMe),
 	}
 
 	cmd.Flags().Bool("refresh", false, "Bypass cache and fetch fresh data")
@@ -55,12 +70,13 @@ func newUserListCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "List all users",
-		RunE:  runUserList,
+		RunE:  withClient(runUserList),
 	}
 
 	cmd.Flags().Int("page", 1, "Page number")
 	cmd.Flags().Int("per-page", 100, "Results per page (max 100)")
 	cmd.Flags().Bool("refresh", false, "Bypass cache and fetch fresh data")
+	cmd.Flags().Bool("all", false, "Fetch every page using cursor pagination, streaming results as they arrive")
 
 	return cmd
 }
@@ -70,7 +86,7 @@ func newUserSearchCommand() *cobra.Command {
 		Use:   "search <query>",
 		Short: "Search users by name or email",
 		Args:  cobra.MinimumNArgs(1),
-		RunE:  runUserSearch,
+		RunE:  withClient(runUserSearch),
 	}
 
 	cmd.Flags().Bool("refresh", false, "Bypass cache and fetch fresh data")
@@ -83,22 +99,43 @@ func newUserShowCommand() *cobra.Command {
 		Use:   "show <user-id>",
 		Short: "Show detailed information for a specific user",
 		Args:  cobra.ExactArgs(1),
-		RunE:  runUserShow,
+		RunE:  withClient(runUserShow),
 	}
 
 	cmd.Flags().Bool("refresh", false, "Bypass cache and fetch fresh data")
+	cmd.Flags().Bool("detailed", false, "Also show counts of the user's requested, assigned, and CC'd tickets")
+	addCopyFlag(cmd)
 
 	return cmd
 }
 
-func runUserMe(cmd *cobra.Command, args []string) error {
-	zdClient, err := getClientFromFlags(cmd)
-	if err != nil {
-		return err
-	}
+func newUserTicketsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tickets <user-id>",
+		Short: "List tickets a user requested, is assigned to, or is CC'd on",
+		Long: `List tickets tied to a user. Shows the tickets they requested by default.
+Examples:
+  zd user tickets 12345
+  zd user tickets 12345 --assigned
+  zd user tickets 12345 --ccd -o csv`,
+		Args: cobra.ExactArgs(1),
+		RunE: withClient(runUserTickets),
+	}
+
+	cmd.Flags().Bool("requested", false, "Tickets the user requested (default)")
+	cmd.Flags().Bool("assigned", false, "Tickets assigned to the user")
+	cmd.Flags().Bool("ccd", false, "Tickets the user is CC'd on")
+	cmd.Flags().Int("page", 1, "Page number")
+	cmd.Flags().Int("per-page", 100, "Results per page (max 100)")
+	cmd.Flags().Bool("refresh", false, "Bypass cache and fetch fresh data")
+
+	cmd.MarkFlagsMutuallyExclusive("requested", "assigned", "ccd")
+
+	return cmd
+}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
+func runUserMe(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	ctx := context.Background()
 
 	user, err := zdClient.GetMe(ctx)
 	if err != nil {
@@ -108,21 +145,28 @@ func runUserMe(cmd *cobra.Command, args []string) error {
 	return outputUser(cmd, user, true)
 }
 
-func runUserList(cmd *cobra.Command, args []string) error {
-	zdClient, err := getClientFromFlags(cmd)
-	if err != nil {
-		return err
-	}
-
+func runUserList(cmd *cobra.Command, args []string, zdClient *client.Client) error {
 	page, _ := cmd.Flags().GetInt("page")
 	perPage, _ := cmd.Flags().GetInt("per-page")
+	all, _ := cmd.Flags().GetBool("all")
 
 	if perPage > 100 {
 		perPage = 100
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
+	if all {
+		ctx, cancel := runContext(zdClient, 30*time.Minute)
+		defer cancel()
+
+		stream := newListStream(cmd, zdClient, "users", userListHeaders, printUserTable)
+		defer stream.stop()
+		if err := zdClient.ListAllUsers(ctx, stream.write); err != nil {
+			return fmt.Errorf("failed to list users: %w", err)
+		}
+		return stream.finish()
+	}
+
+	ctx := context.Background()
 
 	resp, err := zdClient.ListUsers(ctx, page, perPage)
 	if err != nil {
@@ -137,16 +181,10 @@ func runUserList(cmd *cobra.Command, args []string) error {
 	return outputUsers(cmd, resp.Users, page, resp.Count, resp.NextPage)
 }
 
-func runUserSearch(cmd *cobra.Command, args []string) error {
-	zdClient, err := getClientFromFlags(cmd)
-	if err != nil {
-		return err
-	}
-
+func runUserSearch(cmd *cobra.Command, args []string, zdClient *client.Client) error {
 	query := strings.Join(args, " ")
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
+	ctx := context.Background()
 
 	users, err := zdClient.SearchUsers(ctx, query)
 	if err != nil {
@@ -161,32 +199,96 @@ func runUserSearch(cmd *cobra.Command, args []string) error {
 	return outputUsers(cmd, users, 0, len(users), "")
 }
 
-func runUserShow(cmd *cobra.Command, args []string) error {
-	zdClient, err := getClientFromFlags(cmd)
+func runUserShow(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	userID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid user ID: %s", args[0])
+	}
+
+	ctx := context.Background()
+
+	user, err := zdClient.GetUser(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	var related *client.UserRelated
+	if detailed, _ := cmd.Flags().GetBool("detailed"); detailed {
+		related, err = zdClient.GetUserRelated(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("failed to get related information: %w", err)
+		}
+	}
+
+	format, _ := cmd.Flags().GetString("output")
+	switch {
+	case related != nil && output.Format(format) == output.FormatJSON:
+		err = output.NewWriter(output.FormatJSON).WriteJSON(userWithRelated{User: user, UserRelated: related})
+	default:
+		err = outputUser(cmd, user, true)
+		if err == nil && related != nil && output.Format(format) == output.FormatTable {
+			displayUserRelated(user.ID, related)
+		}
+	}
 	if err != nil {
 		return err
 	}
 
+	return copyFromFlags(cmd, zdClient.AgentURL("users", user.ID), user)
+}
+
+func runUserTickets(cmd *cobra.Command, args []string, zdClient *client.Client) error {
 	userID, err := strconv.ParseInt(args[0], 10, 64)
 	if err != nil {
 		return fmt.Errorf("invalid user ID: %s", args[0])
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
+	page, _ := cmd.Flags().GetInt("page")
+	perPage, _ := cmd.Flags().GetInt("per-page")
+	assigned, _ := cmd.Flags().GetBool("assigned")
+	ccd, _ := cmd.Flags().GetBool("ccd")
 
-	user, err := zdClient.GetUser(ctx, userID)
+	if perPage > 100 {
+		perPage = 100
+	}
+
+	kind := client.UserTicketsRequested
+	switch {
+	case assigned:
+		kind = client.UserTicketsAssigned
+	case ccd:
+		kind = client.UserTicketsCCd
+	}
+
+	ctx := context.Background()
+
+	resp, err := zdClient.GetUserTickets(ctx, userID, kind, page, perPage)
 	if err != nil {
-		// Use user-friendly error formatting
-		return fmt.Errorf("%s", client.FormatUserFriendlyError(err))
+		return fmt.Errorf("failed to get user tickets: %w", err)
 	}
 
-	return outputUser(cmd, user, true)
+	if len(resp.Tickets) == 0 {
+		color.Yellow("No %s tickets found for user %d.\n", kind, userID)
+		return nil
+	}
+
+	return outputTickets(cmd, resp.Tickets, page, resp.Count, resp.NextPage)
 }
 
 // Helper function to get client with cache option from flags
 func getClientFromFlags(cmd *cobra.Command) (*client.Client, error) {
-	cfg, err := config.Load()
+	instance, err := resolveInstance(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	return newClientForInstance(cmd, instance)
+}
+
+// getClientForInstance returns a client for a configured instance by name,
+// for commands that work with two instances at once
+func getClientForInstance(cmd *cobra.Command, name string) (*client.Client, error) {
+	cfg, err := loadConfig(cmd)
 	if err == config.ErrConfigNotFound {
 		return nil, fmt.Errorf("no configuration found. Run 'zd init' to get started")
 	}
@@ -194,49 +296,233 @@ func getClientFromFlags(cmd *cobra.Command) (*client.Client, error) {
 		return nil, fmt.Errorf("failed to load configuration: %w", err)
 	}
 
-	instance, err := cfg.GetCurrentInstance()
+	instance, err := cfg.GetInstance(name)
 	if err != nil {
-		return nil, fmt.Errorf("no current instance set. Run 'zd instance switch <name>' to select an instance")
+		return nil, fmt.Errorf("instance '%s' not found. Run 'zd instance list' to see configured instances", name)
 	}
 
+	return newClientForInstance(cmd, instance)
+}
+
+// transport, when set, replaces the network for the clients commands create
+var transport http.RoundTripper
+
+// SetTransport sends the requests of every client commands create through
+// rt, such as a zdtest server's, so commands can run against a fake Zendesk.
+// Nil restores the network.
+func SetTransport(rt http.RoundTripper) {
+	transport = rt
+}
+
+// newClientForInstance creates a client for instance with the cache and
+// request settings from the global flags. The cache is off when SetTransport
+// sends requests to a fake Zendesk, so every request reaches it.
+func newClientForInstance(cmd *cobra.Command, instance *config.Instance) (*client.Client, error) {
 	refresh, _ := cmd.Flags().GetBool("refresh")
-	useCache := !refresh
+	useCache := !refresh && transport == nil
+
+	ttl, err := cacheTTLFromFlags(cmd, instance)
+	if err != nil {
+		return nil, err
+	}
+
+	zdClient, err := client.NewClientWithCache(instance, useCache, ttl)
+	if err != nil {
+		return nil, err
+	}
+	if transport != nil {
+		zdClient.SetTransport(transport)
+	}
+
+	if err := configureClient(cmd, instance, zdClient); err != nil {
+		return nil, err
+	}
+
+	setNameResolver(cmd, zdClient)
 
-	return client.NewClientWithCache(instance, useCache)
+	return zdClient, nil
 }
 
-// Display a user summary (compact format)
-func displayUserSummary(user *client.User, index int) {
-	email := user.Email
-	if email == "" {
-		email = "(no email)"
+// configureClient applies the instance's timeout and write safety settings
+// and the global --max-retries, --no-retry, --concurrency, --timeout,
+// --debug, and --confirm-production flags to zdClient
+func configureClient(cmd *cobra.Command, instance *config.Instance, zdClient *client.Client) error {
+	timeout, err := timeoutFromFlags(cmd, instance)
+	if err != nil {
+		return err
 	}
+	zdClient.SetTimeout(timeout)
 
-	// Build status badges
-	badges := []string{}
-	if user.Verified {
-		badges = append(badges, color.GreenString("✓"))
+	maxRetries, err := maxRetriesFromFlags(cmd)
+	if err != nil {
+		return err
 	}
-	if user.Suspended {
-		badges = append(badges, color.RedString("suspended"))
+	zdClient.SetMaxRetries(maxRetries)
+
+	concurrency, _ := cmd.Flags().GetInt("concurrency")
+	if concurrency < 1 {
+		return fmt.Errorf("--concurrency must be at least 1")
+	}
+	zdClient.SetConcurrency(concurrency)
+
+	debug, err := debugLevelFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+	zdClient.SetDebug(debug)
+
+	guard, err := writeGuardFromFlags(cmd, instance)
+	if err != nil {
+		return err
+	}
+	zdClient.SetWriteGuard(guard)
+
+	return nil
+}
+
+// debugLevelFromFlags returns how much to log from --debug, or from ZD_DEBUG
+// when the flag isn't given
+func debugLevelFromFlags(cmd *cobra.Command) (client.DebugLevel, error) {
+	if cmd.Flags().Changed("debug") {
+		value, _ := cmd.Flags().GetString("debug")
+		return client.ParseDebugLevel(value)
 	}
-	if !user.Active {
-		badges = append(badges, color.YellowString("inactive"))
+	return client.ParseDebugLevel(os.Getenv(client.DebugEnvVar))
+}
+
+// maxRetriesFromFlags returns how many times to retry failed requests, from
+// --max-retries and --no-retry
+func maxRetriesFromFlags(cmd *cobra.Command) (int, error) {
+	if noRetry, _ := cmd.Flags().GetBool("no-retry"); noRetry {
+		return 0, nil
 	}
 
-	statusStr := ""
-	if len(badges) > 0 {
-		statusStr = " | " + strings.Join(badges, " ")
+	maxRetries, _ := cmd.Flags().GetInt("max-retries")
+	if maxRetries < 0 {
+		return 0, fmt.Errorf("--max-retries must be 0 or more")
 	}
+	return maxRetries, nil
+}
 
-	// Single line format
-	fmt.Printf("#%-3d %s | %s | %s | ID: %d%s\n",
-		index,
-		color.CyanString(user.Name),
-		email,
-		user.Role,
-		user.ID,
-		statusStr)
+// timeoutFromFlags returns the per-request timeout from --timeout, or from
+// the instance's timeout setting when the flag isn't given. Only the global
+// flag counts; job wait has a --timeout of its own for how long to wait.
+func timeoutFromFlags(cmd *cobra.Command, instance *config.Instance) (time.Duration, error) {
+	if flag := cmd.InheritedFlags().Lookup("timeout"); flag != nil && flag.Changed {
+		timeout, err := client.ParseTimeout(flag.Value.String())
+		if err != nil {
+			return 0, fmt.Errorf("--timeout: %w", err)
+		}
+		return timeout, nil
+	}
+
+	timeout, err := client.ParseTimeout(instance.Timeout)
+	if err != nil {
+		return 0, fmt.Errorf("invalid timeout for instance '%s': %w", instance.Name, err)
+	}
+	return timeout, nil
+}
+
+// cacheTTLFromFlags returns the instance's cache_ttl setting with --cache-ttl
+// applied on top
+func cacheTTLFromFlags(cmd *cobra.Command, instance *config.Instance) (cache.TTL, error) {
+	ttl, err := cache.ParseTTL(instance.CacheTTL)
+	if err != nil {
+		return cache.TTL{}, fmt.Errorf("invalid cache_ttl for instance '%s': %w", instance.Name, err)
+	}
+
+	spec, _ := cmd.Flags().GetString("cache-ttl")
+	override, err := cache.ParseTTL(spec)
+	if err != nil {
+		return cache.TTL{}, err
+	}
+
+	return ttl.Override(override), nil
+}
+
+// resolveInstance returns the instance a command should talk to. Credentials
+// in ZD_SUBDOMAIN/ZD_EMAIL/ZD_API_TOKEN take precedence unless an instance is
+// named with --instance or ZD_INSTANCE; otherwise the instance comes from the
+// config file.
+func resolveInstance(cmd *cobra.Command) (*config.Instance, error) {
+	if !cmd.Flags().Changed("instance") && os.Getenv(config.InstanceEnvVar) == "" {
+		envInstance, err := config.InstanceFromEnv()
+		if err != nil {
+			return nil, err
+		}
+		if envInstance != nil {
+			return envInstance, nil
+		}
+	}
+
+	cfg, err := loadConfig(cmd)
+	if err == config.ErrConfigNotFound {
+		return nil, fmt.Errorf("no configuration found. Run 'zd init' or set %s, %s, and %s to get started",
+			config.SubdomainEnvVar, config.EmailEnvVar, config.APITokenEnvVar)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	return instanceFromFlags(cmd, cfg)
+}
+
+// instanceFromFlags returns the instance named by --instance or $ZD_INSTANCE,
+// falling back to the current instance
+func instanceFromFlags(cmd *cobra.Command, cfg *config.Config) (*config.Instance, error) {
+	name, _ := cmd.Flags().GetString("instance")
+	if name == "" {
+		name = os.Getenv(config.InstanceEnvVar)
+	}
+
+	if name == "" {
+		instance, err := cfg.GetCurrentInstance()
+		if err != nil {
+			return nil, fmt.Errorf("no current instance set. Run 'zd instance switch <name>' to select an instance")
+		}
+		return instance, nil
+	}
+
+	instance, err := cfg.GetInstance(name)
+	if err != nil {
+		return nil, fmt.Errorf("instance '%s' not found. Run 'zd instance list' to see configured instances", name)
+	}
+	return instance, nil
+}
+
+// configPathFromFlags returns the config file chosen by --config, $ZD_CONFIG,
+// or the default location
+func configPathFromFlags(cmd *cobra.Command) (string, error) {
+	path, _ := cmd.Flags().GetString("config")
+	return config.ResolvePath(path)
+}
+
+// loadConfig loads the config file chosen by configPathFromFlags
+func loadConfig(cmd *cobra.Command) (*config.Config, error) {
+	path, err := configPathFromFlags(cmd)
+	if err != nil {
+		return nil, err
+	}
+	return config.LoadFrom(path)
+}
+
+// loadOrCreateConfig loads the config file chosen by configPathFromFlags, or
+// returns an empty config if it doesn't exist yet
+func loadOrCreateConfig(cmd *cobra.Command) (*config.Config, error) {
+	path, err := configPathFromFlags(cmd)
+	if err != nil {
+		return nil, err
+	}
+	return config.LoadOrCreateFrom(path)
+}
+
+// saveConfig writes cfg to the config file chosen by configPathFromFlags
+func saveConfig(cmd *cobra.Command, cfg *config.Config) error {
+	path, err := configPathFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+	return config.SaveTo(cfg, path)
 }
 
 // User modification commands
@@ -245,7 +531,7 @@ func newUserCreateCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create",
 		Short: "Create a new user",
-		RunE:  runUserCreate,
+		RunE:  withClient(runUserCreate),
 	}
 
 	cmd.Flags().String("name", "", "User name")
@@ -261,7 +547,7 @@ func newUserUpdateCommand() *cobra.Command {
 		Use:   "update <user-id>",
 		Short: "Update a user",
 		Args:  cobra.ExactArgs(1),
-		RunE:  runUserUpdate,
+		RunE:  withClient(runUserUpdate),
 	}
 
 	cmd.Flags().String("name", "", "New name")
@@ -278,7 +564,7 @@ func newUserSuspendCommand() *cobra.Command {
 		Use:   "suspend <user-id>",
 		Short: "Suspend a user",
 		Args:  cobra.ExactArgs(1),
-		RunE:  runUserSuspend,
+		RunE:  withClient(runUserSuspend),
 	}
 
 	return cmd
@@ -289,7 +575,7 @@ func newUserUnsuspendCommand() *cobra.Command {
 		Use:   "unsuspend <user-id>",
 		Short: "Unsuspend a user",
 		Args:  cobra.ExactArgs(1),
-		RunE:  runUserUnsuspend,
+		RunE:  withClient(runUserUnsuspend),
 	}
 
 	return cmd
@@ -300,7 +586,7 @@ func newUserDeleteCommand() *cobra.Command {
 		Use:   "delete <user-id>",
 		Short: "Delete a user",
 		Args:  cobra.ExactArgs(1),
-		RunE:  runUserDelete,
+		RunE:  withClient(runUserDelete),
 	}
 
 	cmd.Flags().Bool("force", false, "Skip confirmation prompt")
@@ -308,12 +594,7 @@ func newUserDeleteCommand() *cobra.Command {
 	return cmd
 }
 
-func runUserCreate(cmd *cobra.Command, args []string) error {
-	zdClient, err := getClientFromFlags(cmd)
-	if err != nil {
-		return err
-	}
-
+func runUserCreate(cmd *cobra.Command, args []string, zdClient *client.Client) error {
 	// Get flags
 	name, _ := cmd.Flags().GetString("name")
 	email, _ := cmd.Flags().GetString("email")
@@ -321,15 +602,16 @@ func runUserCreate(cmd *cobra.Command, args []string) error {
 	phone, _ := cmd.Flags().GetString("phone")
 
 	// Interactive prompts if not provided
+	var err error
 	if name == "" {
-		name, err = promptString("Name", true)
+		name, err = promptString("Name", true, "--name")
 		if err != nil {
 			return err
 		}
 	}
 
 	if email == "" {
-		email, err = promptString("Email", true)
+		email, err = promptString("Email", true, "--email")
 		if err != nil {
 			return err
 		}
@@ -343,8 +625,7 @@ func runUserCreate(cmd *cobra.Command, args []string) error {
 		Phone: phone,
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
+	ctx := context.Background()
 
 	user, err := zdClient.CreateUser(ctx, req)
 	if err != nil {
@@ -360,12 +641,7 @@ func runUserCreate(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-func runUserUpdate(cmd *cobra.Command, args []string) error {
-	zdClient, err := getClientFromFlags(cmd)
-	if err != nil {
-		return err
-	}
-
+func runUserUpdate(cmd *cobra.Command, args []string, zdClient *client.Client) error {
 	userID, err := strconv.ParseInt(args[0], 10, 64)
 	if err != nil {
 		return fmt.Errorf("invalid user ID: %s", args[0])
@@ -409,8 +685,7 @@ func runUserUpdate(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("no updates specified. Use flags like --name, --email, --role, etc.")
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
+	ctx := context.Background()
 
 	user, err := zdClient.UpdateUser(ctx, userID, req)
 	if err != nil {
@@ -423,19 +698,13 @@ func runUserUpdate(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-func runUserSuspend(cmd *cobra.Command, args []string) error {
-	zdClient, err := getClientFromFlags(cmd)
-	if err != nil {
-		return err
-	}
-
+func runUserSuspend(cmd *cobra.Command, args []string, zdClient *client.Client) error {
 	userID, err := strconv.ParseInt(args[0], 10, 64)
 	if err != nil {
 		return fmt.Errorf("invalid user ID: %s", args[0])
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
+	ctx := context.Background()
 
 	user, err := zdClient.SuspendUser(ctx, userID)
 	if err != nil {
@@ -448,19 +717,13 @@ func runUserSuspend(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-func runUserUnsuspend(cmd *cobra.Command, args []string) error {
-	zdClient, err := getClientFromFlags(cmd)
-	if err != nil {
-		return err
-	}
-
+func runUserUnsuspend(cmd *cobra.Command, args []string, zdClient *client.Client) error {
 	userID, err := strconv.ParseInt(args[0], 10, 64)
 	if err != nil {
 		return fmt.Errorf("invalid user ID: %s", args[0])
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
+	ctx := context.Background()
 
 	user, err := zdClient.UnsuspendUser(ctx, userID)
 	if err != nil {
@@ -473,12 +736,7 @@ func runUserUnsuspend(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-func runUserDelete(cmd *cobra.Command, args []string) error {
-	zdClient, err := getClientFromFlags(cmd)
-	if err != nil {
-		return err
-	}
-
+func runUserDelete(cmd *cobra.Command, args []string, zdClient *client.Client) error {
 	userID, err := strconv.ParseInt(args[0], 10, 64)
 	if err != nil {
 		return fmt.Errorf("invalid user ID: %s", args[0])
@@ -487,8 +745,8 @@ func runUserDelete(cmd *cobra.Command, args []string) error {
 	// Confirmation unless --force
 	force, _ := cmd.Flags().GetBool("force")
 	if !force {
-		color.Yellow("WARNING: This will permanently delete user %d\n", userID)
-		confirm, err := promptString("Type 'yes' to confirm", true)
+		color.Yellow("WARNING: This will delete user %d\n", userID)
+		confirm, err := promptString("Type 'yes' to confirm", true, "--force")
 		if err != nil {
 			return err
 		}
@@ -498,14 +756,14 @@ func runUserDelete(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
+	ctx := context.Background()
 
 	if err := zdClient.DeleteUser(ctx, userID); err != nil {
 		return fmt.Errorf("failed to delete user: %w", err)
 	}
 
 	color.Green("✓ User #%d deleted\n", userID)
+	color.White("Erase their data for good with: zd user purge %d\n", userID)
 
 	return nil
 }
@@ -518,6 +776,9 @@ func displayUser(user *client.User, detailed bool) {
 	color.White("ID:           %d\n", user.ID)
 	color.White("Email:        %s\n", user.Email)
 	color.White("Role:         %s\n", user.Role)
+	if user.CustomRoleID != nil {
+		color.White("Custom Role:  %s\n", withID(names.roleName(*user.CustomRoleID), *user.CustomRoleID))
+	}
 
 	if user.Phone != "" {
 		color.White("Phone:        %s\n", user.Phone)
@@ -598,39 +859,18 @@ func formatDate(dateStr string) string {
 
 // outputUser outputs a single user in the requested format
 func outputUser(cmd *cobra.Command, user *client.User, detailed bool) error {
-	format, _ := cmd.Flags().GetString("output")
-	writer := output.NewWriter(output.Format(format))
-
-	switch output.Format(format) {
-	case output.FormatJSON:
-		return writer.WriteJSON(user)
-
-	case output.FormatCSV:
-		headers := []string{"id", "name", "email", "role", "active", "verified", "suspended", "created_at", "updated_at"}
-		return writer.WriteCSV(user, headers)
-
-	default:
-		// Table format (default)
+	headers := []string{"id", "name", "email", "role", "active", "verified", "suspended", "created_at", "updated_at"}
+	return writeOutput(cmd, user, headers, func() {
+		if user.CustomRoleID != nil {
+			names.prefetchRoles([]int64{*user.CustomRoleID})
+		}
 		displayUser(user, detailed)
-		return nil
-	}
+	})
 }
 
 // outputUsers outputs multiple users in the requested format
 func outputUsers(cmd *cobra.Command, users []client.User, page, total int, nextPage string) error {
-	format, _ := cmd.Flags().GetString("output")
-	writer := output.NewWriter(output.Format(format))
-
-	switch output.Format(format) {
-	case output.FormatJSON:
-		return writer.WriteJSON(users)
-
-	case output.FormatCSV:
-		headers := []string{"id", "name", "email", "role", "active", "verified", "suspended", "organization_id", "phone", "time_zone", "created_at", "updated_at"}
-		return writer.WriteCSV(users, headers)
-
-	default:
-		// Table format (default)
+	return writeOutput(cmd, users, userListHeaders, func() {
 		if page > 0 {
 			color.Cyan("Users (Page %d, showing %d of %d total)\n", page, len(users), total)
 		} else {
@@ -638,16 +878,12 @@ func outputUsers(cmd *cobra.Command, users []client.User, page, total int, nextP
 		}
 		color.White(strings.Repeat("─", 80) + "\n\n")
 
-		for i, user := range users {
-			displayUserSummary(&user, i+1)
-		}
+		printUserTable(users, 1, true)
 
 		// Show pagination info
 		if nextPage != "" {
 			fmt.Println()
 			color.White("More results available. Use --page %d to see next page.\n", page+1)
 		}
-
-		return nil
-	}
+	})
 }
@@ -0,0 +1,226 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"zd-cli/internal/client"
+	"zd-cli/internal/output"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+func newUserBulkSuspendCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bulk-suspend [user-id...]",
+		Short: "Suspend many users at once",
+		Long: `Suspend many users using the Zendesk batch update endpoint.
+
+Users can be passed as IDs, read from a file with --from-file (use - for
+stdin), or found with a user search query with --query. The matching users
+are listed and must be confirmed before anything changes; --dry-run stops
+after the listing. Users who are already suspended are skipped. Examples:
+  zd user bulk-suspend --query "organization:1234" --dry-run
+  zd user bulk-suspend --from-file ids.txt
+  zd user bulk-suspend 101 102 103 --force`,
+		RunE: withClient(func(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+			return runUserBulkSetSuspended(cmd, args, zdClient, true)
+		}),
+	}
+
+	addUserBulkFlags(cmd)
+
+	return cmd
+}
+
+func newUserBulkUnsuspendCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bulk-unsuspend [user-id...]",
+		Short: "Unsuspend many users at once",
+		Long: `Unsuspend many users using the Zendesk batch update endpoint. Users are
+selected as with 'zd user bulk-suspend'; users who are not suspended are
+skipped. Examples:
+  zd user bulk-unsuspend --query "organization:1234" --dry-run
+  zd user bulk-unsuspend --from-file ids.txt --force`,
+		RunE: withClient(func(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+			return runUserBulkSetSuspended(cmd, args, zdClient, false)
+		}),
+	}
+
+	addUserBulkFlags(cmd)
+
+	return cmd
+}
+
+// addUserBulkFlags adds the user selection and confirmation flags shared by
+// the bulk user commands
+func addUserBulkFlags(cmd *cobra.Command) {
+	cmd.Flags().String("from-file", "", "Read user IDs from a file (use - for stdin)")
+	cmd.Flags().String("query", "", "Select the users matching a user search query")
+	cmd.Flags().Bool("dry-run", false, "List the users that would change without changing them")
+	cmd.Flags().Bool("force", false, "Skip confirmation prompt")
+
+	cmd.RegisterFlagCompletionFunc("query", cobra.NoFileCompletions)
+}
+
+func runUserBulkSetSuspended(cmd *cobra.Command, args []string, zdClient *client.Client, suspend bool) error {
+	action := "suspend"
+	if !suspend {
+		action = "unsuspend"
+	}
+
+	fromFile, _ := cmd.Flags().GetString("from-file")
+	query, _ := cmd.Flags().GetString("query")
+	userIDs, err := collectIDs(args, fromFile)
+	if err != nil {
+		return err
+	}
+	if len(userIDs) == 0 && query == "" {
+		return fmt.Errorf("no users specified. Pass IDs as arguments, or use --from-file or --query")
+	}
+
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	force, _ := cmd.Flags().GetBool("force")
+	format, _ := cmd.Flags().GetString("output")
+	writer := output.NewWriter(output.Format(format))
+
+	// Bulk jobs can take a while, so allow more time than a single request
+	ctx, cancel := jobContext(zdClient, 10*time.Minute)
+	defer cancel()
+
+	users, err := resolveBulkUsers(ctx, zdClient, userIDs, query)
+	if err != nil {
+		return err
+	}
+
+	// Skip users already in the requested state
+	var pending []client.User
+	for _, user := range users {
+		if user.Suspended != suspend {
+			pending = append(pending, user)
+		}
+	}
+	if skipped := len(users) - len(pending); skipped > 0 {
+		fmt.Fprintf(os.Stderr, "Skipping %d user(s) already %sed\n", skipped, action)
+	}
+
+	if dryRun && !writer.IsTable() {
+		if pending == nil {
+			pending = []client.User{}
+		}
+		if writer.IsJSON() {
+			return writer.WriteJSON(pending)
+		}
+		return writer.WriteCSV(pending, userListHeaders)
+	}
+
+	if len(pending) == 0 {
+		color.Yellow("No users to %s.\n", action)
+		return nil
+	}
+
+	if writer.IsTable() {
+		color.Cyan("Users to %s (%d)\n", action, len(pending))
+		color.White(strings.Repeat("─", 80) + "\n")
+		table := output.NewTable("ID", "NAME", "EMAIL", "ROLE")
+		table.SetFlexColumn(1)
+		for _, user := range pending {
+			table.AddRow(fmt.Sprintf("%d", user.ID), user.Name, orDash(user.Email), user.Role)
+		}
+		table.Print()
+		fmt.Println()
+	}
+
+	if dryRun {
+		color.Yellow("Dry run: no users were changed.\n")
+		return nil
+	}
+
+	// Confirmation unless --force
+	if !force {
+		color.Yellow("WARNING: This will %s %d user(s)\n", action, len(pending))
+		confirm, err := promptString("Type 'yes' to confirm", true, "--force")
+		if err != nil {
+			return err
+		}
+		if strings.ToLower(confirm) != "yes" {
+			color.Yellow("Cancelled.\n")
+			return nil
+		}
+	}
+
+	ids := make([]int64, len(pending))
+	for i, user := range pending {
+		ids[i] = user.ID
+	}
+
+	results, batchErr := runBulkBatches(ctx, zdClient, "Updating users", ids, client.MaxBulkUsers, func(batch []int64) (*client.JobStatus, error) {
+		return zdClient.UpdateManyUsers(ctx, batch, map[string]interface{}{"suspended": suspend})
+	})
+	if batchErr != nil && len(results) == 0 {
+		return batchErr
+	}
+
+	// Earlier batches were applied even if a later one failed
+	if err := outputBulkResults(cmd, results, "user"); err != nil {
+		return err
+	}
+	return batchErr
+}
+
+// resolveBulkUsers looks up the given user IDs and the users matching query,
+// returning each user once, ordered by ID. IDs that match no user are
+// reported on stderr and left out.
+func resolveBulkUsers(ctx context.Context, zdClient *client.Client, userIDs []int64, query string) ([]client.User, error) {
+	byID := make(map[int64]client.User)
+
+	if len(userIDs) > 0 {
+		users, err := zdClient.GetUsersByIDs(ctx, userIDs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get users: %w", err)
+		}
+		for _, user := range users {
+			byID[user.ID] = user
+		}
+
+		var notFound []string
+		for _, id := range userIDs {
+			if _, ok := byID[id]; !ok {
+				notFound = append(notFound, fmt.Sprintf("%d", id))
+			}
+		}
+		if len(notFound) > 0 {
+			fmt.Fprintf(os.Stderr, "Warning: no user found with ID %s\n", strings.Join(notFound, ", "))
+		}
+	}
+
+	if query != "" {
+		total, err := zdClient.SearchAll(ctx, "type:user "+query, client.SearchOptions{PerPage: 100}, func(page []client.SearchResult) error {
+			for _, result := range page {
+				if result.User != nil {
+					byID[result.User.ID] = *result.User
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to search users: %w", err)
+		}
+		if total > client.MaxSearchResults {
+			fmt.Fprintf(os.Stderr, "Warning: %d users match the query but search returns at most %d; narrow the query to cover the rest\n", total, client.MaxSearchResults)
+		}
+	}
+
+	users := make([]client.User, 0, len(byID))
+	for _, user := range byID {
+		users = append(users, user)
+	}
+	sort.Slice(users, func(i, j int) bool { return users[i].ID < users[j].ID })
+
+	return users, nil
+}
@@ -0,0 +1,153 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"zd-cli/internal/client"
+	"zd-cli/internal/output"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+func newUserPurgeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "purge <user-id>",
+		Short: "Permanently delete a deleted user",
+		Long: `Permanently delete a user and their personal data, for data-subject
+erasure (GDPR) requests. The user must already be deleted with
+'zd user delete'; purging cannot be undone.
+
+You are asked to confirm twice: once with 'yes' and once by typing the
+user's ID. Examples:
+  zd user delete 12345 --force
+  zd user purge 12345`,
+		Args: cobra.ExactArgs(1),
+		RunE: withClient(runUserPurge),
+	}
+
+	cmd.Flags().Bool("force", false, "Skip both confirmation prompts")
+
+	return cmd
+}
+
+func newUserDeletedCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deleted",
+		Short: "Manage deleted users",
+	}
+
+	cmd.AddCommand(newUserDeletedListCommand())
+
+	return cmd
+}
+
+func newUserDeletedListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List deleted users awaiting permanent deletion",
+		RunE:  withClient(runUserDeletedList),
+	}
+
+	cmd.Flags().Int("page", 1, "Page number")
+	cmd.Flags().Int("per-page", 25, "Results per page (max 100)")
+
+	return cmd
+}
+
+func runUserPurge(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	userID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid user ID: %s", args[0])
+	}
+
+	ctx := context.Background()
+
+	user, err := zdClient.GetDeletedUser(ctx, userID)
+	if err != nil {
+		if client.IsNotFoundError(err) {
+			return fmt.Errorf("user %d is not deleted. Delete it first with: zd user delete %d", userID, userID)
+		}
+		return fmt.Errorf("failed to get deleted user: %w", err)
+	}
+
+	// Double confirmation unless --force
+	force, _ := cmd.Flags().GetBool("force")
+	if !force {
+		color.Red("WARNING: This will permanently delete user %d (%s, %s)\n", user.ID, user.Name, orDash(user.Email))
+		color.Red("Their personal data is erased and cannot be restored.\n")
+		confirm, err := promptString("Type 'yes' to confirm", true, "--force")
+		if err != nil {
+			return err
+		}
+		if strings.ToLower(confirm) != "yes" {
+			color.Yellow("Permanent deletion cancelled.\n")
+			return nil
+		}
+
+		confirm, err = promptString(fmt.Sprintf("Type the user ID (%d) to permanently delete", user.ID), true, "--force")
+		if err != nil {
+			return err
+		}
+		if confirm != strconv.FormatInt(user.ID, 10) {
+			color.Yellow("User ID did not match. Permanent deletion cancelled.\n")
+			return nil
+		}
+	}
+
+	if err := zdClient.PermanentlyDeleteUser(ctx, userID); err != nil {
+		return fmt.Errorf("failed to permanently delete user: %w", err)
+	}
+
+	color.Green("✓ User #%d permanently deleted\n", userID)
+
+	return nil
+}
+
+func runUserDeletedList(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	page, _ := cmd.Flags().GetInt("page")
+	perPage, _ := cmd.Flags().GetInt("per-page")
+
+	if perPage > 100 {
+		perPage = 100
+	}
+
+	ctx := context.Background()
+
+	resp, err := zdClient.ListDeletedUsers(ctx, page, perPage)
+	if err != nil {
+		return fmt.Errorf("failed to list deleted users: %w", err)
+	}
+
+	if len(resp.DeletedUsers) == 0 {
+		color.Yellow("No deleted users found.\n")
+		return nil
+	}
+
+	return outputDeletedUsers(cmd, resp.DeletedUsers, page, resp.Count, resp.NextPage)
+}
+
+// outputDeletedUsers outputs deleted users in the requested format
+func outputDeletedUsers(cmd *cobra.Command, users []client.DeletedUser, page, total int, nextPage string) error {
+	headers := []string{"id", "name", "email", "role", "created_at", "updated_at"}
+	return writeOutput(cmd, users, headers, func() {
+		color.Cyan("Deleted Users (Page %d, showing %d of %d total)\n", page, len(users), total)
+		color.White(strings.Repeat("─", 80) + "\n")
+
+		table := output.NewTable("ID", "NAME", "EMAIL", "ROLE", "DELETED")
+		table.SetFlexColumn(1)
+		for _, user := range users {
+			table.AddRow(fmt.Sprintf("%d", user.ID), user.Name, orDash(user.Email), user.Role, formatDate(user.UpdatedAt))
+		}
+		table.Print()
+
+		// Show pagination info
+		if nextPage != "" {
+			fmt.Println()
+			color.White("More results available. Use --page %d to see next page.\n", page+1)
+		}
+	})
+}
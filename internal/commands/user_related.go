@@ -0,0 +1,80 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"zd-cli/internal/client"
+	"zd-cli/internal/output"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// userWithRelated is a user with the counts of their related tickets
+type userWithRelated struct {
+	*client.User
+	UserRelated *client.UserRelated `json:"user_related"`
+}
+
+func newUserRelatedCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "related <user-id>",
+		Short: "Count the tickets a user requested, is assigned to, or is CC'd on",
+		Long: `Count the tickets tied to a user, and the organizations they subscribe to.
+List the tickets themselves with 'zd user tickets'. Examples:
+  zd user related 12345
+  zd user related 12345 -o json`,
+		Args: cobra.ExactArgs(1),
+		RunE: withClient(runUserRelated),
+	}
+
+	cmd.Flags().Bool("refresh", false, "Bypass cache and fetch fresh data")
+
+	return cmd
+}
+
+func runUserRelated(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	userID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid user ID: %s", args[0])
+	}
+
+	ctx := context.Background()
+
+	related, err := zdClient.GetUserRelated(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get related information: %w", err)
+	}
+
+	format, _ := cmd.Flags().GetString("output")
+	writer := output.NewWriter(output.Format(format))
+
+	switch output.Format(format) {
+	case output.FormatJSON:
+		return writer.WriteJSON(related)
+
+	case output.FormatCSV:
+		headers := []string{"requested_tickets", "assigned_tickets", "ccd_tickets", "organization_subscriptions"}
+		return writer.WriteCSV(related, headers)
+
+	default:
+		color.Cyan("User #%d related\n", userID)
+		color.White(strings.Repeat("─", 80) + "\n")
+		displayUserRelated(userID, related)
+		color.White("\nSubscriptions:\n")
+		color.White("  Organizations: %d\n", related.OrganizationSubscriptions)
+		return nil
+	}
+}
+
+// displayUserRelated prints a user's related ticket counts with the command
+// that lists each kind
+func displayUserRelated(userID int64, related *client.UserRelated) {
+	color.White("\nRelated Tickets:\n")
+	color.White("  Requested:    %-6d zd user tickets %d\n", related.RequestedTickets, userID)
+	color.White("  Assigned:     %-6d zd user tickets %d --assigned\n", related.AssignedTickets, userID)
+	color.White("  CC'd:         %-6d zd user tickets %d --ccd\n", related.CCDTickets, userID)
+}
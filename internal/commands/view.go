@@ -0,0 +1,235 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"zd-cli/internal/client"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// NewViewCommand creates the view management command
+func NewViewCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "view",
+		Short: "Browse Zendesk views",
+		Long:  "List Zendesk views and the ticket queues they contain.",
+	}
+
+	cmd.AddCommand(newViewListCommand())
+	cmd.AddCommand(newViewShowCommand())
+	cmd.AddCommand(newViewTicketsCommand())
+
+	// Add global output format flag to all subcommands
+	cmd.PersistentFlags().StringP("output", "o", "table", "Output format: table, json, csv")
+
+	return cmd
+}
+
+func newViewListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List views",
+		RunE:  withClient(runViewList),
+	}
+
+	cmd.Flags().Int("page", 1, "Page number")
+	cmd.Flags().Int("per-page", 100, "Results per page (max 100)")
+	cmd.Flags().Bool("active", false, "Only show active views")
+	cmd.Flags().Bool("refresh", false, "Bypass cache and fetch fresh data")
+
+	return cmd
+}
+
+func newViewShowCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show <view-id>",
+		Short: "Show detailed information for a specific view",
+		Args:  cobra.ExactArgs(1),
+		RunE:  withClient(runViewShow),
+	}
+
+	cmd.Flags().Bool("refresh", false, "Bypass cache and fetch fresh data")
+
+	return cmd
+}
+
+func newViewTicketsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tickets <view-id>",
+		Short: "List tickets in a view",
+		Args:  cobra.ExactArgs(1),
+		RunE:  withClient(runViewTickets),
+	}
+
+	cmd.Flags().Int("page", 1, "Page number")
+	cmd.Flags().Int("per-page", 30, "Results per page (max 100)")
+	cmd.Flags().Bool("refresh", false, "Bypass cache and fetch fresh data")
+
+	return cmd
+}
+
+func runViewList(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	page, _ := cmd.Flags().GetInt("page")
+	perPage, _ := cmd.Flags().GetInt("per-page")
+	activeOnly, _ := cmd.Flags().GetBool("active")
+
+	if perPage > 100 {
+		perPage = 100
+	}
+
+	ctx := context.Background()
+
+	resp, err := zdClient.ListViews(ctx, page, perPage, activeOnly)
+	if err != nil {
+		return fmt.Errorf("failed to list views: %w", err)
+	}
+
+	if len(resp.Views) == 0 {
+		color.Yellow("No views found.\n")
+		return nil
+	}
+
+	return outputViews(cmd, resp.Views, page, resp.Count, resp.NextPage)
+}
+
+func runViewShow(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	viewID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid view ID: %s", args[0])
+	}
+
+	ctx := context.Background()
+
+	view, err := zdClient.GetView(ctx, viewID)
+	if err != nil {
+		return fmt.Errorf("failed to get view: %w", err)
+	}
+
+	return outputView(cmd, view)
+}
+
+func runViewTickets(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	viewID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid view ID: %s", args[0])
+	}
+
+	page, _ := cmd.Flags().GetInt("page")
+	perPage, _ := cmd.Flags().GetInt("per-page")
+
+	if perPage > 100 {
+		perPage = 100
+	}
+
+	ctx := context.Background()
+
+	resp, err := zdClient.GetViewTickets(ctx, viewID, page, perPage)
+	if err != nil {
+		return fmt.Errorf("failed to get view tickets: %w", err)
+	}
+
+	if len(resp.Tickets) == 0 {
+		color.Yellow("No tickets found in view %d.\n", viewID)
+		return nil
+	}
+
+	return outputTickets(cmd, resp.Tickets, page, resp.Count, resp.NextPage)
+}
+
+// outputView outputs a single view in the requested format
+func outputView(cmd *cobra.Command, view *client.View) error {
+	headers := []string{"id", "title", "active", "description", "position", "created_at", "updated_at"}
+	return writeOutput(cmd, view, headers, func() {
+		displayView(view)
+	})
+}
+
+// outputViews outputs multiple views in the requested format
+func outputViews(cmd *cobra.Command, views []client.View, page, total int, nextPage string) error {
+	headers := []string{"id", "title", "active", "description", "position", "created_at", "updated_at"}
+	return writeOutput(cmd, views, headers, func() {
+		if page > 0 {
+			color.Cyan("Views (Page %d, showing %d of %d total)\n", page, len(views), total)
+		} else {
+			color.Cyan("Found %d view(s)\n", len(views))
+		}
+		color.White(strings.Repeat("─", 80) + "\n\n")
+
+		for i, view := range views {
+			displayViewSummary(&view, i+1)
+		}
+
+		// Show pagination info
+		if nextPage != "" {
+			fmt.Println()
+			color.White("More results available. Use --page %d to see next page.\n", page+1)
+		}
+	})
+}
+
+// Display a view summary (compact format)
+func displayViewSummary(view *client.View, index int) {
+	activeBadge := ""
+	if !view.Active {
+		activeBadge = " | " + color.YellowString("inactive")
+	}
+
+	fmt.Printf("#%-3d %s | ID: %d%s\n",
+		index,
+		color.CyanString(view.Title),
+		view.ID,
+		activeBadge)
+}
+
+// Display full view details
+func displayView(view *client.View) {
+	color.Cyan("View: %s\n", view.Title)
+	color.White(strings.Repeat("─", 80) + "\n")
+
+	color.White("ID:           %d\n", view.ID)
+	if view.Active {
+		color.Green("Status:       active\n")
+	} else {
+		color.Yellow("Status:       inactive\n")
+	}
+
+	if view.Description != "" {
+		color.White("Description:  %s\n", view.Description)
+	}
+
+	// Conditions
+	if len(view.Conditions.All) > 0 {
+		color.White("\nMatches ALL of:\n")
+		for _, condition := range view.Conditions.All {
+			displayViewCondition(condition)
+		}
+	}
+	if len(view.Conditions.Any) > 0 {
+		color.White("\nMatches ANY of:\n")
+		for _, condition := range view.Conditions.Any {
+			displayViewCondition(condition)
+		}
+	}
+
+	// Sorting
+	if view.Execution.SortBy != "" {
+		color.White("\nSorted by:    %s %s\n", view.Execution.SortBy, view.Execution.SortOrder)
+	}
+
+	// Dates
+	color.White("\nDates:\n")
+	color.White("  Created:      %s\n", formatDate(view.CreatedAt))
+	color.White("  Last Updated: %s\n", formatDate(view.UpdatedAt))
+
+	color.White("\nURL: %s\n", view.URL)
+}
+
+// Display a single view condition
+func displayViewCondition(condition client.ViewCondition) {
+	color.White("  %s %s %v\n", condition.Field, condition.Operator, condition.Value)
+}
@@ -0,0 +1,525 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"zd-cli/internal/client"
+	"zd-cli/internal/output"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// webhookListHeaders are the CSV columns for webhook lists
+var webhookListHeaders = []string{"id", "name", "status", "http_method", "request_format", "endpoint", "updated_at"}
+
+// webhookAuthTypes maps --auth values to the API's authentication types
+var webhookAuthTypes = map[string]string{
+	"basic":   "basic_auth",
+	"bearer":  "bearer_token",
+	"api-key": "api_key",
+}
+
+// NewWebhookCommand creates the webhook command
+func NewWebhookCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "webhook",
+		Aliases: []string{"webhooks"},
+		Short:   "Manage webhooks",
+		Long:    "List, inspect, create, test, and delete webhooks, and view their invocation logs.",
+	}
+
+	cmd.AddCommand(newWebhookListCommand())
+	cmd.AddCommand(newWebhookShowCommand())
+	cmd.AddCommand(newWebhookCreateCommand())
+	cmd.AddCommand(newWebhookTestCommand())
+	cmd.AddCommand(newWebhookLogsCommand())
+	cmd.AddCommand(newWebhookDeleteCommand())
+
+	return cmd
+}
+
+func newWebhookListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List webhooks",
+		Args:  cobra.NoArgs,
+		RunE:  withClient(runWebhookList),
+	}
+
+	cmd.Flags().StringP("output", "o", "table", "Output format: table, json, csv")
+
+	return cmd
+}
+
+func newWebhookShowCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show <webhook-id>",
+		Short: "Show a webhook",
+		Long: `Show a webhook's settings. With --secret, also show the secret it signs
+requests with, which the endpoint needs to verify that requests came from
+Zendesk.`,
+		Args: cobra.ExactArgs(1),
+		RunE: withClient(runWebhookShow),
+	}
+
+	cmd.Flags().Bool("secret", false, "Also show the signing secret")
+	cmd.Flags().Bool("refresh", false, "Bypass cache and fetch fresh data")
+	cmd.Flags().StringP("output", "o", "table", "Output format: table, json")
+
+	return cmd
+}
+
+func newWebhookCreateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a webhook",
+		Long: `Create a webhook. By default it can be used by triggers and automations;
+use --subscription to subscribe it to Zendesk events instead. Examples:
+  zd webhook create --name "Slack alerts" --endpoint https://hooks.slack.com/services/T000/B000/XXXX
+  zd webhook create --name "CRM sync" --endpoint https://crm.example.com/zendesk --auth bearer --auth-token $CRM_TOKEN
+  zd webhook create --name "User events" --endpoint https://example.com/events --subscription zen:event-type:user.created`,
+		Args: cobra.NoArgs,
+		RunE: withClient(runWebhookCreate),
+	}
+
+	cmd.Flags().String("name", "", "Webhook name")
+	cmd.Flags().String("endpoint", "", "URL to send requests to")
+	cmd.Flags().String("description", "", "Webhook description")
+	cmd.Flags().String("method", "POST", "HTTP method: GET, POST, PUT, PATCH, DELETE")
+	cmd.Flags().String("format", "json", "Request format: json, xml, form_encoded")
+	cmd.Flags().Bool("inactive", false, "Create the webhook inactive")
+	cmd.Flags().StringSlice("subscription", []string{"conditional_ticket_events"}, "Events to subscribe to")
+	cmd.Flags().StringArray("header", nil, "Custom header as name=value (repeatable)")
+	cmd.Flags().String("auth", "", "Authentication: basic, bearer, api-key")
+	cmd.Flags().String("auth-user", "", "Username for basic authentication")
+	cmd.Flags().String("auth-password", "", "Password for basic authentication")
+	cmd.Flags().String("auth-token", "", "Token for bearer authentication")
+	cmd.Flags().String("auth-key-name", "", "Header name for API key authentication")
+	cmd.Flags().String("auth-key-value", "", "Header value for API key authentication")
+	cmd.MarkFlagRequired("name")
+	cmd.MarkFlagRequired("endpoint")
+
+	cmd.RegisterFlagCompletionFunc("method", cobra.FixedCompletions([]string{"GET", "POST", "PUT", "PATCH", "DELETE"}, cobra.ShellCompDirectiveNoFileComp))
+	cmd.RegisterFlagCompletionFunc("format", cobra.FixedCompletions([]string{"json", "xml", "form_encoded"}, cobra.ShellCompDirectiveNoFileComp))
+	cmd.RegisterFlagCompletionFunc("auth", cobra.FixedCompletions([]string{"basic", "bearer", "api-key"}, cobra.ShellCompDirectiveNoFileComp))
+
+	return cmd
+}
+
+func newWebhookTestCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "test [webhook-id]",
+		Short: "Send a test request through a webhook",
+		Long: `Send a test request through an existing webhook, or to an endpoint given
+with --endpoint, and show what the endpoint answered. Examples:
+  zd webhook test 01GDXYD7ZTWYP6XA4SQ9J9Q1MS
+  zd webhook test 01GDXYD7ZTWYP6XA4SQ9J9Q1MS --payload @sample.json
+  zd webhook test --endpoint https://example.com/hook --method PUT`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: withClient(runWebhookTest),
+	}
+
+	cmd.Flags().String("payload", "", "Request body (@file to read a file, - for stdin; default: a sample payload)")
+	cmd.Flags().String("endpoint", "", "Test this endpoint instead of an existing webhook")
+	cmd.Flags().String("method", "POST", "HTTP method for --endpoint")
+	cmd.Flags().String("format", "json", "Request format for --endpoint: json, xml, form_encoded")
+
+	return cmd
+}
+
+func newWebhookLogsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "logs <webhook-id> [invocation-id]",
+		Aliases: []string{"invocations"},
+		Short:   "Show a webhook's invocation log",
+		Long: `Show a webhook's most recent invocations, newest first. Given an
+invocation ID, show each attempt to deliver it and what the endpoint
+answered. Examples:
+  zd webhook logs 01GDXYD7ZTWYP6XA4SQ9J9Q1MS --status failed
+  zd webhook logs 01GDXYD7ZTWYP6XA4SQ9J9Q1MS 01GDY1S7K2VZ0R5W0ZJ9Q2M7B6`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: withClient(runWebhookLogs),
+	}
+
+	cmd.Flags().String("status", "", "Only invocations whose latest attempt had this status: success, failed, circuit_broken")
+	cmd.Flags().Int("limit", 20, "Number of invocations to show (max 100)")
+	cmd.Flags().StringP("output", "o", "table", "Output format: table, json")
+
+	cmd.RegisterFlagCompletionFunc("status", cobra.FixedCompletions([]string{"success", "failed", "circuit_broken"}, cobra.ShellCompDirectiveNoFileComp))
+
+	return cmd
+}
+
+func newWebhookDeleteCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete <webhook-id>",
+		Short: "Delete a webhook",
+		Args:  cobra.ExactArgs(1),
+		RunE:  withClient(runWebhookDelete),
+	}
+
+	cmd.Flags().Bool("force", false, "Skip confirmation prompt")
+
+	return cmd
+}
+
+func runWebhookList(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	ctx := context.Background()
+
+	webhooks, err := zdClient.ListWebhooks(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list webhooks: %w", err)
+	}
+	sort.Slice(webhooks, func(i, j int) bool { return webhooks[i].Name < webhooks[j].Name })
+
+	format, _ := cmd.Flags().GetString("output")
+	writer := output.NewWriter(output.Format(format))
+
+	switch output.Format(format) {
+	case output.FormatJSON:
+		if webhooks == nil {
+			webhooks = []client.Webhook{}
+		}
+		return writer.WriteJSON(webhooks)
+
+	case output.FormatCSV:
+		return writer.WriteCSV(webhooks, webhookListHeaders)
+
+	default:
+		if len(webhooks) == 0 {
+			color.Yellow("No webhooks found.\n")
+			return nil
+		}
+
+		color.Cyan("Found %d webhook(s)\n", len(webhooks))
+		color.White(strings.Repeat("─", 80) + "\n")
+
+		table := output.NewTable("#", "ID", "NAME", "STATUS", "METHOD", "ENDPOINT")
+		table.SetFlexColumn(5)
+		for i, webhook := range webhooks {
+			table.AddRow(
+				fmt.Sprintf("%d", i+1),
+				webhook.ID,
+				webhook.Name,
+				webhookStatus(webhook.Status),
+				webhook.HTTPMethod,
+				webhook.Endpoint)
+		}
+		table.Print()
+
+		return nil
+	}
+}
+
+func runWebhookShow(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	ctx := context.Background()
+
+	webhook, err := zdClient.GetWebhook(ctx, args[0])
+	if err != nil {
+		return fmt.Errorf("failed to get webhook: %w", err)
+	}
+
+	var secret *client.WebhookSigningSecret
+	if showSecret, _ := cmd.Flags().GetBool("secret"); showSecret {
+		secret, err = zdClient.GetWebhookSigningSecret(ctx, webhook.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get signing secret: %w", err)
+		}
+	}
+
+	format, _ := cmd.Flags().GetString("output")
+	if output.Format(format) == output.FormatJSON {
+		if secret == nil {
+			return output.NewWriter(output.FormatJSON).WriteJSON(webhook)
+		}
+		return output.NewWriter(output.FormatJSON).WriteJSON(map[string]interface{}{
+			"webhook":        webhook,
+			"signing_secret": secret,
+		})
+	}
+
+	color.Cyan("Webhook: %s\n", webhook.Name)
+	color.White(strings.Repeat("─", 80) + "\n")
+
+	color.White("ID:             %s\n", webhook.ID)
+	fmt.Printf("Status:         %s\n", webhookStatus(webhook.Status))
+	if webhook.Description != "" {
+		color.White("Description:    %s\n", webhook.Description)
+	}
+	color.White("Endpoint:       %s %s\n", webhook.HTTPMethod, webhook.Endpoint)
+	color.White("Format:         %s\n", webhook.RequestFormat)
+	if len(webhook.Subscriptions) > 0 {
+		color.White("Subscriptions:  %s\n", strings.Join(webhook.Subscriptions, ", "))
+	}
+	if webhook.Authentication != nil {
+		color.White("Authentication: %s (%s)\n", webhook.Authentication.Type, webhook.Authentication.AddPosition)
+	}
+	if len(webhook.CustomHeaders) > 0 {
+		var names []string
+		for name := range webhook.CustomHeaders {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		color.White("Headers:        %s\n", strings.Join(names, ", "))
+	}
+	color.White("Created:        %s\n", formatDate(webhook.CreatedAt))
+	color.White("Updated:        %s\n", formatDate(webhook.UpdatedAt))
+
+	if secret != nil {
+		fmt.Println()
+		color.White("Signing secret: %s\n", secret.Secret)
+		color.White("Algorithm:      %s\n", secret.Algorithm)
+	}
+
+	return nil
+}
+
+func runWebhookCreate(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	webhook := client.Webhook{Status: "active"}
+	webhook.Name, _ = cmd.Flags().GetString("name")
+	webhook.Endpoint, _ = cmd.Flags().GetString("endpoint")
+	webhook.Description, _ = cmd.Flags().GetString("description")
+	webhook.Subscriptions, _ = cmd.Flags().GetStringSlice("subscription")
+	method, _ := cmd.Flags().GetString("method")
+	webhook.HTTPMethod = strings.ToUpper(method)
+	webhook.RequestFormat, _ = cmd.Flags().GetString("format")
+	if inactive, _ := cmd.Flags().GetBool("inactive"); inactive {
+		webhook.Status = "inactive"
+	}
+
+	headers, _ := cmd.Flags().GetStringArray("header")
+	for _, header := range headers {
+		name, value, ok := strings.Cut(header, "=")
+		if !ok || strings.TrimSpace(name) == "" {
+			return fmt.Errorf("invalid header %q: use name=value", header)
+		}
+		if webhook.CustomHeaders == nil {
+			webhook.CustomHeaders = make(map[string]string)
+		}
+		webhook.CustomHeaders[strings.TrimSpace(name)] = value
+	}
+
+	auth, err := webhookAuthFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+	webhook.Authentication = auth
+
+	ctx := context.Background()
+
+	created, err := zdClient.CreateWebhook(ctx, webhook)
+	if err != nil {
+		return fmt.Errorf("failed to create webhook: %w", err)
+	}
+
+	color.Green("✓ Webhook created successfully!\n")
+	color.White("Webhook ID: %s\n", created.ID)
+	color.White("Use 'zd webhook test %s' to send it a test request.\n", created.ID)
+
+	return nil
+}
+
+func runWebhookTest(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	endpoint, _ := cmd.Flags().GetString("endpoint")
+	if len(args) == 0 && endpoint == "" {
+		return fmt.Errorf("give a webhook ID or --endpoint")
+	}
+	if len(args) > 0 && endpoint != "" {
+		return fmt.Errorf("give a webhook ID or --endpoint, not both")
+	}
+
+	var req client.WebhookTestRequest
+	payload, err := messageFromFlag(cmd, "payload")
+	if err != nil {
+		return err
+	}
+	req.Payload = payload
+
+	webhookID := ""
+	if len(args) > 0 {
+		webhookID = args[0]
+	} else {
+		req.Endpoint = endpoint
+		method, _ := cmd.Flags().GetString("method")
+		req.HTTPMethod = strings.ToUpper(method)
+		req.RequestFormat, _ = cmd.Flags().GetString("format")
+	}
+
+	ctx := context.Background()
+
+	resp, err := zdClient.TestWebhook(ctx, webhookID, req)
+	if err != nil {
+		return fmt.Errorf("failed to test webhook: %w", err)
+	}
+
+	if resp.Status >= 200 && resp.Status < 300 {
+		color.Green("✓ Endpoint answered %d\n", resp.Status)
+	} else {
+		color.Red("✗ Endpoint answered %d\n", resp.Status)
+	}
+	if resp.Body != "" {
+		fmt.Println()
+		fmt.Println(resp.Body)
+	}
+
+	if resp.Status < 200 || resp.Status >= 300 {
+		return fmt.Errorf("test request failed with status %d", resp.Status)
+	}
+	return nil
+}
+
+func runWebhookLogs(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	ctx := context.Background()
+
+	format, _ := cmd.Flags().GetString("output")
+
+	if len(args) == 2 {
+		attempts, err := zdClient.ListWebhookInvocationAttempts(ctx, args[0], args[1])
+		if err != nil {
+			return fmt.Errorf("failed to get invocation attempts: %w", err)
+		}
+
+		if output.Format(format) == output.FormatJSON {
+			if attempts == nil {
+				attempts = []client.WebhookInvocationAttempt{}
+			}
+			return output.NewWriter(output.FormatJSON).WriteJSON(attempts)
+		}
+
+		if len(attempts) == 0 {
+			color.Yellow("No attempts found.\n")
+			return nil
+		}
+
+		color.Cyan("Invocation %s (%d attempt(s))\n", args[1], len(attempts))
+		color.White(strings.Repeat("─", 80) + "\n")
+		for i, attempt := range attempts {
+			fmt.Printf("%d. %s  %s  HTTP %d\n", i+1, formatDate(attempt.CompletedAt), webhookStatus(attempt.Status), attempt.Response.Status)
+			if attempt.Response.Body != "" {
+				fmt.Printf("   %s\n", strings.ReplaceAll(strings.TrimSpace(attempt.Response.Body), "\n", "\n   "))
+			}
+			fmt.Println()
+		}
+		return nil
+	}
+
+	status, _ := cmd.Flags().GetString("status")
+	limit, _ := cmd.Flags().GetInt("limit")
+
+	invocations, err := zdClient.ListWebhookInvocations(ctx, args[0], status, limit)
+	if err != nil {
+		return fmt.Errorf("failed to list invocations: %w", err)
+	}
+
+	if output.Format(format) == output.FormatJSON {
+		if invocations == nil {
+			invocations = []client.WebhookInvocation{}
+		}
+		return output.NewWriter(output.FormatJSON).WriteJSON(invocations)
+	}
+
+	if len(invocations) == 0 {
+		color.Yellow("No invocations found.\n")
+		return nil
+	}
+
+	color.Cyan("Recent invocations (%d)\n", len(invocations))
+	color.White(strings.Repeat("─", 80) + "\n")
+
+	table := output.NewTable("#", "INVOCATION ID", "CREATED", "STATUS")
+	for i, invocation := range invocations {
+		table.AddRow(
+			fmt.Sprintf("%d", i+1),
+			invocation.ID,
+			formatDate(invocation.CreatedAt),
+			webhookStatus(invocation.LatestCompletionStatus))
+	}
+	table.Print()
+
+	fmt.Println()
+	color.White("Use 'zd webhook logs %s <invocation-id>' to see the endpoint's responses.\n", args[0])
+
+	return nil
+}
+
+func runWebhookDelete(cmd *cobra.Command, args []string, zdClient *client.Client) error {
+	webhookID := args[0]
+
+	// Confirmation unless --force
+	force, _ := cmd.Flags().GetBool("force")
+	if !force {
+		color.Yellow("WARNING: This will permanently delete webhook %s; triggers and automations using it will stop notifying\n", webhookID)
+		confirm, err := promptString("Type 'yes' to confirm", true, "--force")
+		if err != nil {
+			return err
+		}
+		if strings.ToLower(confirm) != "yes" {
+			color.Yellow("Deletion cancelled.\n")
+			return nil
+		}
+	}
+
+	ctx := context.Background()
+
+	if err := zdClient.DeleteWebhook(ctx, webhookID); err != nil {
+		return fmt.Errorf("failed to delete webhook: %w", err)
+	}
+
+	color.Green("✓ Webhook %s deleted\n", webhookID)
+
+	return nil
+}
+
+// webhookAuthFromFlags builds a webhook's authentication from the --auth flags
+func webhookAuthFromFlags(cmd *cobra.Command) (*client.WebhookAuthentication, error) {
+	kind, _ := cmd.Flags().GetString("auth")
+	if kind == "" {
+		return nil, nil
+	}
+
+	authType, ok := webhookAuthTypes[kind]
+	if !ok {
+		return nil, fmt.Errorf("invalid --auth %q: use basic, bearer, or api-key", kind)
+	}
+
+	auth := &client.WebhookAuthentication{Type: authType, AddPosition: "header", Data: make(map[string]string)}
+	required := map[string][]string{
+		"basic":   {"auth-user", "auth-password"},
+		"bearer":  {"auth-token"},
+		"api-key": {"auth-key-name", "auth-key-value"},
+	}[kind]
+	keys := map[string]string{
+		"auth-user":      "username",
+		"auth-password":  "password",
+		"auth-token":     "token",
+		"auth-key-name":  "name",
+		"auth-key-value": "value",
+	}
+	for _, flag := range required {
+		value, _ := cmd.Flags().GetString(flag)
+		if value == "" {
+			return nil, fmt.Errorf("--auth %s needs --%s", kind, flag)
+		}
+		auth.Data[keys[flag]] = value
+	}
+
+	return auth, nil
+}
+
+// webhookStatus colors a webhook or invocation status
+func webhookStatus(status string) string {
+	switch status {
+	case "active", "success":
+		return color.GreenString(status)
+	case "inactive":
+		return color.YellowString(status)
+	case "failed", "circuit_broken":
+		return color.RedString(status)
+	default:
+		return status
+	}
+}
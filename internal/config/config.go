@@ -1,6 +1,8 @@
 package config
 
 import (
+	"fmt"
+	"strings"
 	"time"
 )
 
@@ -12,6 +14,15 @@ const (
 	AuthTypeOAuth AuthType = "oauth"
 )
 
+// Environment says whether an instance is live. Changes to a production
+// instance must be confirmed.
+type Environment string
+
+const (
+	EnvironmentProduction Environment = "production"
+	EnvironmentSandbox    Environment = "sandbox"
+)
+
 // Instance represents a Zendesk instance configuration
 type Instance struct {
 	Name           string `ini:"-"`
@@ -24,6 +35,15 @@ type Instance struct {
 	OAuthToken     string `ini:"oauth_token,omitempty"`
 	OAuthRefresh   string `ini:"oauth_refresh,omitempty"`
 	OAuthExpiry    string `ini:"oauth_expiry,omitempty"` // Store as RFC3339 string
+	OAuthScopes    string `ini:"oauth_scopes,omitempty"` // Space-separated; "read write" when empty
+	OAuthPKCE      bool   `ini:"oauth_pkce,omitempty"`
+	OAuthPort      int    `ini:"oauth_callback_port,omitempty"`
+	SecretStore    string `ini:"secret_store,omitempty"` // "keychain" or "file" (default)
+	CacheTTL       string `ini:"cache_ttl,omitempty"` // e.g. "5m" or "5m,tickets=1m,users=1h"
+	Timeout        string `ini:"timeout,omitempty"`   // Per-request timeout, e.g. "45s"; "0" for no limit
+	Environment    Environment `ini:"environment,omitempty"` // "production" or "sandbox"
+	ReadOnly       bool   `ini:"read_only,omitempty"` // Refuse requests that change data
+	Defaults       Defaults `ini:"-"` // Stored in its own [defaults "name"] section
 }
 
 // GetOAuthExpiry returns the OAuth expiry as a time.Time
@@ -39,17 +59,83 @@ func (i *Instance) SetOAuthExpiry(t time.Time) {
 	i.OAuthExpiry = t.Format(time.RFC3339)
 }
 
+// GetOAuthScopes returns the OAuth scopes to request, or nil for the defaults
+func (i *Instance) GetOAuthScopes() []string {
+	return strings.Fields(i.OAuthScopes)
+}
+
+// SetOAuthScopes sets the OAuth scopes to request
+func (i *Instance) SetOAuthScopes(scopes []string) {
+	i.OAuthScopes = strings.Join(scopes, " ")
+}
+
+// GetEnvironment returns the instance's environment, which is empty if it
+// isn't set
+func (i *Instance) GetEnvironment() (Environment, error) {
+	switch env := Environment(strings.ToLower(string(i.Environment))); env {
+	case "", EnvironmentProduction, EnvironmentSandbox:
+		return env, nil
+	}
+	return "", fmt.Errorf("invalid environment %q for instance '%s' (use production or sandbox)", i.Environment, i.Name)
+}
+
+// DefaultKeys are the settings a [defaults] section can hold
+var DefaultKeys = []string{"output", "group", "priority", "per_page", "summarize_command", "summarize_webhook"}
+
+// Defaults holds default flag values, keyed by one of DefaultKeys
+type Defaults map[string]string
+
+// IsDefaultKey reports whether key is one of DefaultKeys
+func IsDefaultKey(key string) bool {
+	for _, k := range DefaultKeys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+// NotifyHook runs a command or posts to a webhook when zd tail or ticket
+// watch sees a ticket matching its filter
+type NotifyHook struct {
+	Name    string `ini:"-"`
+	Filter  string `ini:"filter,omitempty"`  // Filter expressions separated by " and "
+	Command string `ini:"command,omitempty"` // Run by the shell
+	Webhook string `ini:"webhook,omitempty"` // URL to POST JSON to
+}
+
 // Config represents the entire CLI configuration
 type Config struct {
-	Current   string               `ini:"-"`
-	Instances map[string]*Instance `ini:"-"`
+	Current   string                 `ini:"-"`
+	Instances map[string]*Instance   `ini:"-"`
+	Defaults  Defaults               `ini:"-"` // Defaults for every instance
+	Aliases   map[string]string      `ini:"-"` // Command aliases and their expansions
+	Notify    map[string]*NotifyHook `ini:"-"` // Stored in [notify "name"] sections
 }
 
 // NewConfig creates a new empty configuration
 func NewConfig() *Config {
 	return &Config{
 		Instances: make(map[string]*Instance),
+		Defaults:  make(Defaults),
+		Aliases:   make(map[string]string),
+		Notify:    make(map[string]*NotifyHook),
+	}
+}
+
+// DefaultsFor returns the defaults that apply to the named instance: its own
+// defaults, falling back to the global ones
+func (c *Config) DefaultsFor(name string) Defaults {
+	merged := make(Defaults)
+	for key, value := range c.Defaults {
+		merged[key] = value
+	}
+	if instance, ok := c.Instances[name]; ok {
+		for key, value := range instance.Defaults {
+			merged[key] = value
+		}
 	}
+	return merged
 }
 
 // GetCurrentInstance returns the currently active instance
@@ -66,6 +152,16 @@ func (c *Config) GetCurrentInstance() (*Instance, error) {
 	return instance, nil
 }
 
+// GetInstance returns the instance with the given name
+func (c *Config) GetInstance(name string) (*Instance, error) {
+	instance, ok := c.Instances[name]
+	if !ok {
+		return nil, ErrInstanceNotFound
+	}
+
+	return instance, nil
+}
+
 // AddInstance adds a new instance to the configuration
 func (c *Config) AddInstance(instance *Instance) error {
 	return c.AddInstanceWithSwitch(instance, false)
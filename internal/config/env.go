@@ -0,0 +1,41 @@
+package config
+
+import (
+	"fmt"
+	"os"
+)
+
+// Environment variables for zero-config API token authentication
+const (
+	SubdomainEnvVar = "ZD_SUBDOMAIN"
+	EmailEnvVar     = "ZD_EMAIL"
+	APITokenEnvVar  = "ZD_API_TOKEN"
+)
+
+// EnvInstanceName is the name given to the instance built from the environment
+const EnvInstanceName = "env"
+
+// InstanceFromEnv builds an API token instance from ZD_SUBDOMAIN, ZD_EMAIL,
+// and ZD_API_TOKEN. It returns nil when none of them are set, and an error
+// when only some of them are.
+func InstanceFromEnv() (*Instance, error) {
+	subdomain := os.Getenv(SubdomainEnvVar)
+	email := os.Getenv(EmailEnvVar)
+	apiToken := os.Getenv(APITokenEnvVar)
+
+	if subdomain == "" && email == "" && apiToken == "" {
+		return nil, nil
+	}
+	if subdomain == "" || email == "" || apiToken == "" {
+		return nil, fmt.Errorf("%s, %s, and %s must all be set to authenticate from the environment",
+			SubdomainEnvVar, EmailEnvVar, APITokenEnvVar)
+	}
+
+	return &Instance{
+		Name:      EnvInstanceName,
+		Subdomain: subdomain,
+		AuthType:  AuthTypeToken,
+		Email:     email,
+		APIToken:  apiToken,
+	}, nil
+}
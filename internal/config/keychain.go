@@ -0,0 +1,96 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/zalando/go-keyring"
+)
+
+// Secret stores for an instance's credentials
+const (
+	// SecretStoreFile keeps secrets in plaintext in the config file
+	SecretStoreFile = "file"
+
+	// SecretStoreKeychain keeps secrets in the OS keychain (macOS Keychain,
+	// Windows Credential Manager, or libsecret on Linux)
+	SecretStoreKeychain = "keychain"
+)
+
+// keychainService is the service name keychain entries are stored under
+const keychainService = "zd-cli"
+
+// UsesKeychain reports whether the instance keeps its secrets in the OS keychain
+func (i *Instance) UsesKeychain() bool {
+	return i.SecretStore == SecretStoreKeychain
+}
+
+// secrets returns the instance's secret fields keyed by their config name
+func (i *Instance) secrets() map[string]*string {
+	return map[string]*string{
+		"api_token":     &i.APIToken,
+		"oauth_secret":  &i.OAuthSecret,
+		"oauth_token":   &i.OAuthToken,
+		"oauth_refresh": &i.OAuthRefresh,
+	}
+}
+
+// withoutSecrets returns a copy of the instance with its secret fields cleared,
+// for writing to the config file
+func (i *Instance) withoutSecrets() *Instance {
+	stripped := *i
+	for _, secret := range stripped.secrets() {
+		*secret = ""
+	}
+	return &stripped
+}
+
+// keychainAccount returns the keychain account name for one instance secret
+func keychainAccount(instanceName, key string) string {
+	return instanceName + "/" + key
+}
+
+// loadSecrets reads the instance's secrets from the OS keychain. Secrets
+// that were never stored are left empty.
+func loadSecrets(instance *Instance) error {
+	for key, secret := range instance.secrets() {
+		value, err := keyring.Get(keychainService, keychainAccount(instance.Name, key))
+		if errors.Is(err, keyring.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read %s for instance %s from the OS keychain: %w", key, instance.Name, err)
+		}
+		*secret = value
+	}
+	return nil
+}
+
+// storeSecrets writes the instance's secrets to the OS keychain, removing
+// entries for secrets that are now empty
+func storeSecrets(instance *Instance) error {
+	for key, secret := range instance.secrets() {
+		account := keychainAccount(instance.Name, key)
+		if *secret == "" {
+			if err := keyring.Delete(keychainService, account); err != nil && !errors.Is(err, keyring.ErrNotFound) {
+				return fmt.Errorf("failed to remove %s for instance %s from the OS keychain: %w", key, instance.Name, err)
+			}
+			continue
+		}
+		if err := keyring.Set(keychainService, account, *secret); err != nil {
+			return fmt.Errorf("failed to store %s for instance %s in the OS keychain (use --insecure-store to keep secrets in the config file): %w", key, instance.Name, err)
+		}
+	}
+	return nil
+}
+
+// DeleteSecrets removes every keychain entry stored for the named instance
+func DeleteSecrets(instanceName string) error {
+	for key := range (&Instance{}).secrets() {
+		err := keyring.Delete(keychainService, keychainAccount(instanceName, key))
+		if err != nil && !errors.Is(err, keyring.ErrNotFound) {
+			return fmt.Errorf("failed to remove %s for instance %s from the OS keychain: %w", key, instanceName, err)
+		}
+	}
+	return nil
+}
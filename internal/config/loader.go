@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"gopkg.in/ini.v1"
@@ -12,6 +13,12 @@ import (
 const (
 	configDirName  = ".zd"
 	configFileName = "config"
+
+	// ConfigEnvVar names the environment variable that overrides the config file path
+	ConfigEnvVar = "ZD_CONFIG"
+
+	// InstanceEnvVar names the environment variable that overrides the current instance
+	InstanceEnvVar = "ZD_INSTANCE"
 )
 
 // GetConfigPath returns the full path to the config file
@@ -24,6 +31,18 @@ func GetConfigPath() (string, error) {
 	return filepath.Join(home, configDirName, configFileName), nil
 }
 
+// ResolvePath returns the config file to use: path if it is set, otherwise
+// $ZD_CONFIG, otherwise the default ~/.zd/config
+func ResolvePath(path string) (string, error) {
+	if path != "" {
+		return path, nil
+	}
+	if envPath := os.Getenv(ConfigEnvVar); envPath != "" {
+		return envPath, nil
+	}
+	return GetConfigPath()
+}
+
 // GetConfigDir returns the full path to the config directory
 func GetConfigDir() (string, error) {
 	home, err := os.UserHomeDir()
@@ -49,13 +68,18 @@ func EnsureConfigDir() error {
 	return nil
 }
 
-// Load reads the configuration from the config file
+// Load reads the configuration from the config file chosen by ResolvePath
 func Load() (*Config, error) {
-	configPath, err := GetConfigPath()
+	configPath, err := ResolvePath("")
 	if err != nil {
 		return nil, err
 	}
 
+	return LoadFrom(configPath)
+}
+
+// LoadFrom reads the configuration from the config file at configPath
+func LoadFrom(configPath string) (*Config, error) {
 	// Check if config file exists
 	if _, err := os.Stat(configPath); os.IsNotExist(err) {
 		return nil, ErrConfigNotFound
@@ -75,10 +99,33 @@ func Load() (*Config, error) {
 		config.Current = coreSection.Key("current").String()
 	}
 
+	// Read the global defaults section
+	if section, err := iniFile.GetSection("defaults"); err == nil {
+		config.Defaults = readDefaults(section)
+	}
+
+	// Read the alias section
+	if section, err := iniFile.GetSection("alias"); err == nil {
+		for _, key := range section.Keys() {
+			config.Aliases[key.Name()] = key.String()
+		}
+	}
+
 	// Read instance sections
 	for _, section := range iniFile.Sections() {
-		// Skip default and core sections
-		if section.Name() == ini.DefaultSection || section.Name() == "core" {
+		// Skip default, core, and defaults sections
+		if section.Name() == ini.DefaultSection || section.Name() == "core" || section.Name() == "defaults" || section.Name() == "alias" {
+			continue
+		}
+
+		// Parse notify sections (format: notify "name")
+		if strings.HasPrefix(section.Name(), "notify \"") && strings.HasSuffix(section.Name(), "\"") {
+			name := strings.TrimSuffix(strings.TrimPrefix(section.Name(), "notify \""), "\"")
+			hook := &NotifyHook{Name: name}
+			if err := section.MapTo(hook); err != nil {
+				return nil, fmt.Errorf("failed to parse notify hook %s: %w", name, err)
+			}
+			config.Notify[name] = hook
 			continue
 		}
 
@@ -95,25 +142,77 @@ func Load() (*Config, error) {
 				return nil, fmt.Errorf("failed to parse instance %s: %w", instanceName, err)
 			}
 
+			if instance.UsesKeychain() {
+				if err := loadSecrets(instance); err != nil {
+					return nil, err
+				}
+			}
+
 			config.Instances[instanceName] = instance
 		}
 	}
 
+	// Read per-instance defaults sections (format: defaults "name"); defaults
+	// for instances that no longer exist are dropped
+	for name, instance := range config.Instances {
+		if section, err := iniFile.GetSection(fmt.Sprintf("defaults \"%s\"", name)); err == nil {
+			instance.Defaults = readDefaults(section)
+		}
+	}
+
 	return config, nil
 }
 
-// Save writes the configuration to the config file
-func Save(config *Config) error {
-	// Ensure config directory exists
-	if err := EnsureConfigDir(); err != nil {
-		return err
+// readDefaults reads the known default keys from a defaults section
+func readDefaults(section *ini.Section) Defaults {
+	defaults := make(Defaults)
+	for _, key := range DefaultKeys {
+		if value := section.Key(key).String(); value != "" {
+			defaults[key] = value
+		}
+	}
+	return defaults
+}
+
+// writeDefaults writes a defaults section, skipping it when there are none
+func writeDefaults(iniFile *ini.File, sectionName string, defaults Defaults) error {
+	if len(defaults) == 0 {
+		return nil
 	}
 
-	configPath, err := GetConfigPath()
+	section, err := iniFile.NewSection(sectionName)
+	if err != nil {
+		return fmt.Errorf("failed to create section %s: %w", sectionName, err)
+	}
+
+	for _, key := range DefaultKeys {
+		if value, ok := defaults[key]; ok {
+			if _, err := section.NewKey(key, value); err != nil {
+				return fmt.Errorf("failed to write default %s: %w", key, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Save writes the configuration to the config file chosen by ResolvePath
+func Save(config *Config) error {
+	configPath, err := ResolvePath("")
 	if err != nil {
 		return err
 	}
 
+	return SaveTo(config, configPath)
+}
+
+// SaveTo writes the configuration to the config file at configPath
+func SaveTo(config *Config, configPath string) error {
+	// Ensure config directory exists with secure permissions (0700 = rwx------)
+	if err := os.MkdirAll(filepath.Dir(configPath), 0700); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+
 	// Create new INI file
 	iniFile := ini.Empty()
 
@@ -127,6 +226,46 @@ func Save(config *Config) error {
 		return fmt.Errorf("failed to write current instance: %w", err)
 	}
 
+	if err := writeDefaults(iniFile, "defaults", config.Defaults); err != nil {
+		return err
+	}
+
+	if len(config.Aliases) > 0 {
+		aliasSection, err := iniFile.NewSection("alias")
+		if err != nil {
+			return fmt.Errorf("failed to create alias section: %w", err)
+		}
+
+		names := make([]string, 0, len(config.Aliases))
+		for name := range config.Aliases {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			if _, err := aliasSection.NewKey(name, config.Aliases[name]); err != nil {
+				return fmt.Errorf("failed to write alias %s: %w", name, err)
+			}
+		}
+	}
+
+	// Write notify sections
+	notifyNames := make([]string, 0, len(config.Notify))
+	for name := range config.Notify {
+		notifyNames = append(notifyNames, name)
+	}
+	sort.Strings(notifyNames)
+
+	for _, name := range notifyNames {
+		section, err := iniFile.NewSection(fmt.Sprintf("notify \"%s\"", name))
+		if err != nil {
+			return fmt.Errorf("failed to create section for notify hook %s: %w", name, err)
+		}
+		if err := section.ReflectFrom(config.Notify[name]); err != nil {
+			return fmt.Errorf("failed to write notify hook %s: %w", name, err)
+		}
+	}
+
 	// Write instance sections
 	for name, instance := range config.Instances {
 		sectionName := fmt.Sprintf("instance \"%s\"", name)
@@ -135,9 +274,21 @@ func Save(config *Config) error {
 			return fmt.Errorf("failed to create section for instance %s: %w", name, err)
 		}
 
+		// Keychain secrets are stored outside the config file
+		if instance.UsesKeychain() {
+			if err := storeSecrets(instance); err != nil {
+				return err
+			}
+			instance = instance.withoutSecrets()
+		}
+
 		if err := section.ReflectFrom(instance); err != nil {
 			return fmt.Errorf("failed to write instance %s: %w", name, err)
 		}
+
+		if err := writeDefaults(iniFile, fmt.Sprintf("defaults \"%s\"", name), instance.Defaults); err != nil {
+			return err
+		}
 	}
 
 	// Save to file with secure permissions (0600 = rw-------)
@@ -155,7 +306,18 @@ func Save(config *Config) error {
 
 // LoadOrCreate loads the config file or creates a new one if it doesn't exist
 func LoadOrCreate() (*Config, error) {
-	config, err := Load()
+	configPath, err := ResolvePath("")
+	if err != nil {
+		return nil, err
+	}
+
+	return LoadOrCreateFrom(configPath)
+}
+
+// LoadOrCreateFrom loads the config file at configPath or creates a new one
+// if it doesn't exist
+func LoadOrCreateFrom(configPath string) (*Config, error) {
+	config, err := LoadFrom(configPath)
 	if err == ErrConfigNotFound {
 		return NewConfig(), nil
 	}
@@ -0,0 +1,93 @@
+// Package htmltoken splits HTML fragments, such as comment and article
+// bodies, into text and tags. It is lenient rather than strict: anything it
+// can't read as a tag is kept as text.
+package htmltoken
+
+import "strings"
+
+// Token is a run of text or one tag
+type Token struct {
+	Tag  bool   // Whether this is a tag rather than text
+	Data string // The text, still escaped, or the tag without its angle brackets
+}
+
+// Split splits src into tokens. Comments are dropped, and the contents of
+// <script> and <style> are skipped, though their tags are kept. A '<' that
+// is never closed makes the rest of src text.
+func Split(src string) []Token {
+	var tokens []Token
+
+	for len(src) > 0 {
+		lt := strings.IndexByte(src, '<')
+		if lt < 0 {
+			tokens = append(tokens, Token{Data: src})
+			break
+		}
+		if lt > 0 {
+			tokens = append(tokens, Token{Data: src[:lt]})
+			src = src[lt:]
+		}
+
+		if strings.HasPrefix(src, "<!--") {
+			end := strings.Index(src, "-->")
+			if end < 0 {
+				break
+			}
+			src = src[end+3:]
+			continue
+		}
+
+		end := tagEnd(src)
+		if end < 0 {
+			tokens = append(tokens, Token{Data: src})
+			break
+		}
+		content := src[1:end]
+		src = src[end+1:]
+		tokens = append(tokens, Token{Tag: true, Data: content})
+
+		// Script and style contents may hold '<' and quotes that aren't tags
+		if name := Name(content); name == "script" || name == "style" {
+			if i := strings.Index(strings.ToLower(src), "</"+name); i >= 0 {
+				src = src[i:]
+			} else {
+				src = ""
+			}
+		}
+	}
+
+	return tokens
+}
+
+// Name returns the lowercase name at the start of a tag, or "" for a
+// closing tag or one without a name
+func Name(tag string) string {
+	end := 0
+	for end < len(tag) {
+		c := tag[end]
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-') {
+			break
+		}
+		end++
+	}
+	return strings.ToLower(tag[:end])
+}
+
+// tagEnd returns the index of the '>' closing the tag at the start of src,
+// skipping any inside quoted attribute values, or -1 if there is none
+func tagEnd(src string) int {
+	var quote byte
+	for i := 1; i < len(src); i++ {
+		switch c := src[i]; {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '>':
+			return i
+		}
+	}
+	return -1
+}
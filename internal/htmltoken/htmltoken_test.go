@@ -0,0 +1,56 @@
+package htmltoken
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want []Token
+	}{
+		{
+			name: "text and tags",
+			src:  `<p class="x">Hi <b>there</b></p>`,
+			want: []Token{{Tag: true, Data: `p class="x"`}, {Data: "Hi "}, {Tag: true, Data: "b"}, {Data: "there"}, {Tag: true, Data: "/b"}, {Tag: true, Data: "/p"}},
+		},
+		{
+			name: "quoted >",
+			src:  `<a title="a > b">x</a>`,
+			want: []Token{{Tag: true, Data: `a title="a > b"`}, {Data: "x"}, {Tag: true, Data: "/a"}},
+		},
+		{
+			name: "comments",
+			src:  "a<!-- <b> -->b",
+			want: []Token{{Data: "a"}, {Data: "b"}},
+		},
+		{
+			name: "script contents",
+			src:  "<script>if (a<b) { x = 'y' }</script>c",
+			want: []Token{{Tag: true, Data: "script"}, {Tag: true, Data: "/script"}, {Data: "c"}},
+		},
+		{
+			name: "unclosed tag",
+			src:  "a <b",
+			want: []Token{{Data: "a "}, {Data: "<b"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Split(tt.src); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Split(%q) = %+v, want %+v", tt.src, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestName(t *testing.T) {
+	for tag, want := range map[string]string{"H2 id=x": "h2", "br/": "br", "/p": "", "!DOCTYPE html": ""} {
+		if got := Name(tag); got != want {
+			t.Errorf("Name(%q) = %q, want %q", tag, got, want)
+		}
+	}
+}
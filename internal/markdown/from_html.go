@@ -0,0 +1,424 @@
+// Package markdown converts between Markdown and the HTML Zendesk stores
+// Help Center articles in
+package markdown
+
+import (
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"zd-cli/internal/htmltoken"
+)
+
+// attrPattern finds one attribute of a tag
+var attrPattern = regexp.MustCompile(`([a-zA-Z_:][-a-zA-Z0-9_:.]*)(?:\s*=\s*(?:"([^"]*)"|'([^']*)'|([^\s"'>]+)))?`)
+
+// whitespacePattern matches runs of whitespace, which HTML shows as one space
+var whitespacePattern = regexp.MustCompile(`\s+`)
+
+// blankLinesPattern matches more than one blank line
+var blankLinesPattern = regexp.MustCompile(`\n{3,}`)
+
+// paragraphBreakPattern matches one or more blank lines
+var paragraphBreakPattern = regexp.MustCompile(`\n\n+`)
+
+// voidElements never have children or a closing tag
+var voidElements = map[string]bool{
+	"br": true, "hr": true, "img": true, "input": true, "meta": true, "link": true,
+	"area": true, "base": true, "col": true, "embed": true, "source": true, "wbr": true,
+}
+
+// node is an element or text in a parsed HTML fragment
+type node struct {
+	tag      string // Empty for text
+	text     string
+	attrs    map[string]string
+	children []*node
+	parent   *node
+}
+
+// FromHTML converts an HTML fragment, such as an article body, to Markdown.
+// Headings, paragraphs, emphasis, code, links, images, lists, quotes, and
+// simple tables are kept; other tags are dropped and their text kept.
+func FromHTML(src string) string {
+	root := parseHTML(src)
+
+	var b strings.Builder
+	renderChildren(&b, root)
+
+	var lines []string
+	for _, line := range strings.Split(b.String(), "\n") {
+		// Keep the two trailing spaces of a line break, but no other
+		if !strings.HasSuffix(line, "  ") || strings.TrimSpace(line) == "" {
+			line = strings.TrimRight(line, " ")
+		}
+		lines = append(lines, line)
+	}
+
+	out := blankLinesPattern.ReplaceAllString(strings.Join(lines, "\n"), "\n\n")
+	return strings.TrimSpace(out) + "\n"
+}
+
+// parseHTML parses an HTML fragment into a tree, tolerating unclosed and
+// stray tags
+func parseHTML(src string) *node {
+	root := &node{tag: "#root"}
+	current := root
+
+	for _, token := range htmltoken.Split(src) {
+		if !token.Tag {
+			current.addText(token.Data)
+			continue
+		}
+		content := token.Data
+
+		if strings.HasPrefix(content, "!") || strings.HasPrefix(content, "?") {
+			continue
+		}
+
+		if strings.HasPrefix(content, "/") {
+			name := htmltoken.Name(content[1:])
+			for n := current; n != root; n = n.parent {
+				if n.tag == name {
+					current = n.parent
+					break
+				}
+			}
+			continue
+		}
+
+		name := htmltoken.Name(content)
+		if name == "" {
+			current.addText("<" + content + ">")
+			continue
+		}
+
+		// Script and style contents are never shown
+		if name == "script" || name == "style" {
+			continue
+		}
+
+		// A new paragraph or list item closes an open one
+		if name == "p" || name == "li" {
+			for n := current; n != root; n = n.parent {
+				if n.tag == name {
+					current = n.parent
+					break
+				}
+				if n.tag == "ul" || n.tag == "ol" || n.tag == "div" || n.tag == "td" || n.tag == "th" || n.tag == "blockquote" {
+					break
+				}
+			}
+		}
+
+		n := &node{tag: name, attrs: parseAttrs(content[len(name):]), parent: current}
+		current.children = append(current.children, n)
+		if !voidElements[name] && !strings.HasSuffix(content, "/") {
+			current = n
+		}
+	}
+
+	return root
+}
+
+// parseAttrs parses the attributes after a tag's name
+func parseAttrs(s string) map[string]string {
+	attrs := make(map[string]string)
+	for _, m := range attrPattern.FindAllStringSubmatch(s, -1) {
+		attrs[strings.ToLower(m[1])] = html.UnescapeString(m[2] + m[3] + m[4])
+	}
+	return attrs
+}
+
+func (n *node) addText(s string) {
+	n.children = append(n.children, &node{text: html.UnescapeString(s), parent: n})
+}
+
+// rawText returns the text inside n exactly as written
+func (n *node) rawText() string {
+	if n.tag == "" {
+		return n.text
+	}
+	if n.tag == "br" {
+		return "\n"
+	}
+	var b strings.Builder
+	for _, child := range n.children {
+		b.WriteString(child.rawText())
+	}
+	return b.String()
+}
+
+// renderChildren writes the Markdown for each child of n
+func renderChildren(b *strings.Builder, n *node) {
+	for _, child := range n.children {
+		render(b, child)
+	}
+}
+
+// renderInline returns the Markdown for the children of n on one line
+func renderInline(n *node) string {
+	var b strings.Builder
+	renderChildren(&b, n)
+	return strings.TrimSpace(whitespacePattern.ReplaceAllString(b.String(), " "))
+}
+
+// render writes the Markdown for one node
+func render(b *strings.Builder, n *node) {
+	if n.tag == "" {
+		text := whitespacePattern.ReplaceAllString(n.text, " ")
+		// Spaces at the start of a line would read as indentation
+		if b.Len() == 0 || strings.HasSuffix(b.String(), "\n") {
+			text = strings.TrimLeft(text, " ")
+		}
+		b.WriteString(escapeText(text))
+		return
+	}
+
+	switch n.tag {
+	case "h1", "h2", "h3", "h4", "h5", "h6":
+		level := int(n.tag[1] - '0')
+		b.WriteString("\n\n" + strings.Repeat("#", level) + " " + renderInline(n) + "\n\n")
+
+	case "p", "div", "section", "article", "header", "footer", "figure", "dl", "dt", "dd":
+		var inner strings.Builder
+		renderChildren(&inner, n)
+		b.WriteString("\n\n" + strings.TrimSpace(inner.String()) + "\n\n")
+
+	case "br":
+		b.WriteString("  \n")
+
+	case "hr":
+		b.WriteString("\n\n---\n\n")
+
+	case "strong", "b":
+		b.WriteString(wrapInline(n, "**"))
+
+	case "em", "i":
+		b.WriteString(wrapInline(n, "*"))
+
+	case "del", "s", "strike":
+		b.WriteString(wrapInline(n, "~~"))
+
+	case "code", "kbd", "samp", "tt":
+		text := n.rawText()
+		if strings.TrimSpace(text) == "" {
+			return
+		}
+		fence := "`"
+		if strings.Contains(text, "`") {
+			fence = "``"
+		}
+		b.WriteString(fence + text + fence)
+
+	case "pre":
+		lang := ""
+		for _, child := range n.children {
+			if child.tag == "code" {
+				lang = codeLanguage(child.attrs["class"])
+			}
+		}
+		if lang == "" {
+			lang = codeLanguage(n.attrs["class"])
+		}
+		text := strings.Trim(n.rawText(), "\n")
+		b.WriteString("\n\n```" + lang + "\n" + text + "\n```\n\n")
+
+	case "a":
+		text := renderInline(n)
+		href := n.attrs["href"]
+		switch {
+		case href == "":
+			b.WriteString(text)
+		case text == "" || text == escapeText(href) && autolinkPattern.MatchString("<"+href+">"):
+			b.WriteString("<" + href + ">")
+		default:
+			b.WriteString("[" + text + "](" + escapeURL(href) + ")")
+		}
+
+	case "img":
+		if src := n.attrs["src"]; src != "" {
+			b.WriteString("![" + escapeText(n.attrs["alt"]) + "](" + escapeURL(src) + ")")
+		}
+
+	case "ul", "ol":
+		b.WriteString("\n\n" + renderList(n) + "\n\n")
+
+	case "blockquote":
+		var inner strings.Builder
+		renderChildren(&inner, n)
+		text := blankLinesPattern.ReplaceAllString(strings.TrimSpace(inner.String()), "\n\n")
+		var lines []string
+		for _, line := range strings.Split(text, "\n") {
+			quoted := "> " + line
+			// Keep the two trailing spaces of a line break, but no other
+			if !strings.HasSuffix(line, "  ") || strings.TrimSpace(line) == "" {
+				quoted = strings.TrimRight(quoted, " ")
+			}
+			lines = append(lines, quoted)
+		}
+		b.WriteString("\n\n" + strings.Join(lines, "\n") + "\n\n")
+
+	case "table":
+		b.WriteString("\n\n" + renderTable(n) + "\n\n")
+
+	default:
+		renderChildren(b, n)
+	}
+}
+
+// wrapInline wraps the inline Markdown of n in a marker such as **,
+// keeping surrounding spaces outside the markers
+func wrapInline(n *node, marker string) string {
+	var inner strings.Builder
+	renderChildren(&inner, n)
+	text := whitespacePattern.ReplaceAllString(inner.String(), " ")
+	trimmed := strings.TrimSpace(text)
+	if trimmed == "" {
+		return text
+	}
+
+	var b strings.Builder
+	if strings.HasPrefix(text, " ") {
+		b.WriteString(" ")
+	}
+	b.WriteString(marker + trimmed + marker)
+	if strings.HasSuffix(text, " ") {
+		b.WriteString(" ")
+	}
+	return b.String()
+}
+
+// renderList returns the Markdown for a <ul> or <ol>
+func renderList(n *node) string {
+	start := 1
+	if n.tag == "ol" {
+		if v, err := strconv.Atoi(n.attrs["start"]); err == nil && v > 0 {
+			start = v
+		}
+	}
+
+	var items []string
+	for _, child := range n.children {
+		if child.tag != "li" {
+			continue
+		}
+
+		marker := "- "
+		if n.tag == "ol" {
+			marker = strconv.Itoa(start+len(items)) + ". "
+		}
+
+		var inner strings.Builder
+		renderChildren(&inner, child)
+		text := strings.TrimSpace(inner.String())
+
+		// Items without paragraphs stay tight
+		loose := false
+		for _, grandchild := range child.children {
+			if grandchild.tag == "p" {
+				loose = true
+			}
+		}
+		if loose {
+			text = blankLinesPattern.ReplaceAllString(text, "\n\n")
+		} else {
+			text = paragraphBreakPattern.ReplaceAllString(text, "\n")
+		}
+
+		indent := strings.Repeat(" ", len(marker))
+		lines := strings.Split(text, "\n")
+		for i := 1; i < len(lines); i++ {
+			if lines[i] != "" {
+				lines[i] = indent + lines[i]
+			}
+		}
+		items = append(items, marker+strings.Join(lines, "\n"))
+	}
+
+	return strings.Join(items, "\n")
+}
+
+// renderTable returns a table as a GitHub-flavored Markdown table, with the
+// first row as the header
+func renderTable(n *node) string {
+	var rows [][]string
+	var collect func(*node)
+	collect = func(n *node) {
+		for _, child := range n.children {
+			switch child.tag {
+			case "tr":
+				var row []string
+				for _, cell := range child.children {
+					if cell.tag == "td" || cell.tag == "th" {
+						row = append(row, strings.ReplaceAll(renderInline(cell), "|", `\|`))
+					}
+				}
+				rows = append(rows, row)
+			case "thead", "tbody", "tfoot":
+				collect(child)
+			}
+		}
+	}
+	collect(n)
+
+	if len(rows) == 0 {
+		return ""
+	}
+
+	columns := 0
+	for _, row := range rows {
+		columns = max(columns, len(row))
+	}
+
+	var lines []string
+	for i, row := range rows {
+		for len(row) < columns {
+			row = append(row, "")
+		}
+		lines = append(lines, "| "+strings.Join(row, " | ")+" |")
+		if i == 0 {
+			lines = append(lines, "|"+strings.Repeat(" --- |", columns))
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// codeLanguage returns the language of a code block from a class such as
+// "language-go"
+func codeLanguage(class string) string {
+	for _, c := range strings.Fields(class) {
+		if lang, ok := strings.CutPrefix(c, "language-"); ok {
+			return lang
+		}
+		if lang, ok := strings.CutPrefix(c, "lang-"); ok {
+			return lang
+		}
+	}
+	return ""
+}
+
+// markdownSpecial are the characters escaped in text so it isn't read back
+// as formatting
+var markdownSpecial = strings.NewReplacer(`\`, `\\`, "*", `\*`, "_", `\_`, "`", "\\`", "[", `\[`, "]", `\]`)
+
+func escapeText(s string) string {
+	s = markdownSpecial.Replace(s)
+
+	// < and & are only escaped where they'd be read back as a tag or entity
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '<' && tagAt(s[i:]) || s[i] == '&' && entityAt(s[i:]) {
+			b.WriteByte('\\')
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+// escapeURL keeps a link target from ending the link early
+func escapeURL(s string) string {
+	return strings.NewReplacer(" ", "%20", "(", "%28", ")", "%29").Replace(s)
+}
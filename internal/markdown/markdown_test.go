@@ -0,0 +1,121 @@
+package markdown
+
+import "testing"
+
+func TestRoundTrip(t *testing.T) {
+	tests := []struct {
+		name     string
+		markdown string
+		html     string
+	}{
+		{
+			name:     "headings",
+			markdown: "# Title\n\n## Setup\n\n###### Small\n",
+			html:     "<h1>Title</h1>\n<h2>Setup</h2>\n<h6>Small</h6>",
+		},
+		{
+			name:     "nested lists",
+			markdown: "- one\n- two\n  - nested\n  - more\n- three\n",
+			html:     "<ul>\n<li>one</li>\n<li>two\n<ul>\n<li>nested</li>\n<li>more</li>\n</ul></li>\n<li>three</li>\n</ul>",
+		},
+		{
+			name:     "ordered list with a nested list",
+			markdown: "1. first\n2. second\n   - inner\n",
+			html:     "<ol>\n<li>first</li>\n<li>second\n<ul>\n<li>inner</li>\n</ul></li>\n</ol>",
+		},
+		{
+			name:     "code block",
+			markdown: "```go\nfmt.Println(\"<hi>\")\n```\n",
+			html:     "<pre><code class=\"language-go\">fmt.Println(&#34;&lt;hi&gt;&#34;)</code></pre>",
+		},
+		{
+			name:     "inline code",
+			markdown: "Run `zd ticket list` first.\n",
+			html:     "<p>Run <code>zd ticket list</code> first.</p>",
+		},
+		{
+			name:     "links",
+			markdown: "See [the docs](https://example.com/a_b) and <https://example.com>.\n",
+			html:     "<p>See <a href=\"https://example.com/a_b\">the docs</a> and <a href=\"https://example.com\">https://example.com</a>.</p>",
+		},
+		{
+			name:     "emphasis",
+			markdown: "**bold**, *italic*, and ~~gone~~\n",
+			html:     "<p><strong>bold</strong>, <em>italic</em>, and <del>gone</del></p>",
+		},
+		{
+			name:     "table",
+			markdown: "| Name | Count |\n| --- | --- |\n| a | 1 |\n| b | 2 |\n",
+			html:     "<table>\n<thead>\n<tr><th>Name</th><th>Count</th></tr>\n</thead>\n<tbody>\n<tr><td>a</td><td>1</td></tr>\n<tr><td>b</td><td>2</td></tr>\n</tbody>\n</table>",
+		},
+		{
+			name:     "escaped markers",
+			markdown: "2 \\* 3 is snake\\_case, a < b & c\n",
+			html:     "<p>2 * 3 is snake_case, a &lt; b &amp; c</p>",
+		},
+		{
+			name:     "escaped tag and entity",
+			markdown: "Wrap it in \\<div> tags, not \\&amp;\n",
+			html:     "<p>Wrap it in &lt;div> tags, not &amp;amp;</p>",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			html := ToHTML(tt.markdown)
+			if html != tt.html {
+				t.Errorf("ToHTML(%q) = %q, want %q", tt.markdown, html, tt.html)
+			}
+			if back := FromHTML(html); back != tt.markdown {
+				t.Errorf("FromHTML(%q) = %q, want %q", html, back, tt.markdown)
+			}
+		})
+	}
+}
+
+func TestFromHTML(t *testing.T) {
+	tests := []struct {
+		name string
+		html string
+		want string
+	}{
+		{
+			name: "escaped text stays text",
+			html: "<p>Use &lt;b&gt;bold&lt;/b&gt; &amp;amp; *stars*</p>",
+			want: "Use \\<b>bold\\</b> \\&amp; \\*stars\\*\n",
+		},
+		{
+			name: "unclosed tags",
+			html: "<p>one<p>two<ul><li>a<li>b</ul>",
+			want: "one\n\ntwo\n\n- a\n- b\n",
+		},
+		{
+			name: "script and style are dropped",
+			html: "<p>before</p><script>if (a<b) { x = 'y' }</script><style>p > a {}</style><p>after</p>",
+			want: "before\n\nafter\n",
+		},
+		{
+			name: "comments are dropped",
+			html: "<p>kept<!-- <b>not</b> --></p>",
+			want: "kept\n",
+		},
+		{
+			name: "code with a backtick",
+			html: "<p><code>a`b</code></p>",
+			want: "``a`b``\n",
+		},
+		{
+			name: "line break and quote",
+			html: "<blockquote><p>first<br>second</p></blockquote>",
+			want: "> first  \n> second\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FromHTML(tt.html); got != tt.want {
+				t.Errorf("FromHTML(%q) = %q, want %q", tt.html, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,451 @@
+package markdown
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+)
+
+var (
+	headingPattern   = regexp.MustCompile(`^(#{1,6})\s+(.*?)\s*#*\s*$`)
+	rulePattern      = regexp.MustCompile(`^\s{0,3}(?:(?:-\s*){3,}|(?:\*\s*){3,}|(?:_\s*){3,})$`)
+	fencePattern     = regexp.MustCompile("^\\s{0,3}(```+|~~~+)\\s*([^`\\s]*)")
+	listItemPattern  = regexp.MustCompile(`^(\s*)([-*+]|\d{1,9}[.)])\s+(.*)$`)
+	tableSepPattern  = regexp.MustCompile(`^\s*\|?\s*:?-+:?\s*(\|\s*:?-+:?\s*)*\|?\s*$`)
+	htmlBlockPattern = regexp.MustCompile(`^\s{0,3}</?[a-zA-Z][a-zA-Z0-9-]*[\s/>]`)
+
+	codeSpanPattern    = regexp.MustCompile("(`+)(.+?)(`+)")
+	imagePattern       = regexp.MustCompile(`!\[([^\]]*)\]\(\s*<?([^)\s>]+)>?(?:\s+"([^"]*)")?\s*\)`)
+	linkPattern        = regexp.MustCompile(`\[([^\]]+)\]\(\s*<?([^)\s>]+)>?(?:\s+"([^"]*)")?\s*\)`)
+	autolinkPattern    = regexp.MustCompile(`<((?:https?|mailto):[^>\s]+)>`)
+	boldPattern        = regexp.MustCompile(`\*\*(\S(?:.*?\S)?)\*\*|__(\S(?:.*?\S)?)__`)
+	italicPattern      = regexp.MustCompile(`\*(\S(?:[^*]*?\S)?)\*|\b_(\S(?:[^_]*?\S)?)_\b`)
+	strikePattern      = regexp.MustCompile(`~~(\S(?:.*?\S)?)~~`)
+	placeholderPattern = regexp.MustCompile("\x00(\\d+)\x00")
+)
+
+// ToHTML converts Markdown, including GitHub-style fenced code and tables,
+// to HTML for an article body. HTML in the Markdown is passed through.
+func ToHTML(src string) string {
+	src = strings.ReplaceAll(src, "\r\n", "\n")
+	src = strings.ReplaceAll(src, "\t", "    ")
+	return strings.Join(blocks(strings.Split(src, "\n")), "\n")
+}
+
+// blocks converts lines of Markdown to a list of HTML blocks
+func blocks(lines []string) []string {
+	var out []string
+
+	for i := 0; i < len(lines); {
+		line := lines[i]
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case trimmed == "":
+			i++
+
+		case fencePattern.MatchString(line):
+			m := fencePattern.FindStringSubmatch(line)
+			fence := m[1]
+			var code []string
+			i++
+			for i < len(lines) && !strings.HasPrefix(strings.TrimSpace(lines[i]), fence) {
+				code = append(code, lines[i])
+				i++
+			}
+			i++ // Closing fence
+
+			class := ""
+			if m[2] != "" {
+				class = fmt.Sprintf(` class="language-%s"`, html.EscapeString(m[2]))
+			}
+			out = append(out, fmt.Sprintf("<pre><code%s>%s</code></pre>", class, html.EscapeString(strings.Join(code, "\n"))))
+
+		case headingPattern.MatchString(trimmed):
+			m := headingPattern.FindStringSubmatch(trimmed)
+			out = append(out, fmt.Sprintf("<h%d>%s</h%d>", len(m[1]), inline(m[2]), len(m[1])))
+			i++
+
+		case rulePattern.MatchString(line):
+			out = append(out, "<hr>")
+			i++
+
+		case strings.HasPrefix(trimmed, ">"):
+			var quoted []string
+			for i < len(lines) && strings.HasPrefix(strings.TrimSpace(lines[i]), ">") {
+				q := strings.TrimPrefix(strings.TrimSpace(lines[i]), ">")
+				quoted = append(quoted, strings.TrimPrefix(q, " "))
+				i++
+			}
+			out = append(out, "<blockquote>\n"+strings.Join(blocks(quoted), "\n")+"\n</blockquote>")
+
+		case listItemPattern.MatchString(line):
+			var list string
+			list, i = parseList(lines, i)
+			out = append(out, list)
+
+		case strings.Contains(line, "|") && i+1 < len(lines) && tableSepPattern.MatchString(lines[i+1]) && strings.Contains(lines[i+1], "-"):
+			var table string
+			table, i = parseTable(lines, i)
+			out = append(out, table)
+
+		case htmlBlockPattern.MatchString(line):
+			var raw []string
+			for i < len(lines) && strings.TrimSpace(lines[i]) != "" {
+				raw = append(raw, lines[i])
+				i++
+			}
+			out = append(out, strings.Join(raw, "\n"))
+
+		default:
+			var para []string
+			for i < len(lines) && startsParagraphLine(lines, i, len(para) == 0) {
+				para = append(para, lines[i])
+				i++
+			}
+			out = append(out, "<p>"+paragraph(para)+"</p>")
+		}
+	}
+
+	return out
+}
+
+// startsParagraphLine reports whether lines[i] continues a paragraph, rather
+// than being blank or starting another block
+func startsParagraphLine(lines []string, i int, first bool) bool {
+	line := lines[i]
+	trimmed := strings.TrimSpace(line)
+	if trimmed == "" {
+		return false
+	}
+	if first {
+		return true
+	}
+	return !fencePattern.MatchString(line) &&
+		!headingPattern.MatchString(trimmed) &&
+		!rulePattern.MatchString(line) &&
+		!strings.HasPrefix(trimmed, ">") &&
+		!listItemPattern.MatchString(line) &&
+		!htmlBlockPattern.MatchString(line)
+}
+
+// paragraph joins the lines of a paragraph, turning lines that end in two
+// spaces or a backslash into line breaks
+func paragraph(lines []string) string {
+	var parts []string
+	for i, line := range lines {
+		line = strings.TrimLeft(line, " ")
+		last := i == len(lines)-1
+		switch {
+		case !last && strings.HasSuffix(line, "  "):
+			parts = append(parts, inline(strings.TrimRight(line, " "))+"<br>")
+		case !last && strings.HasSuffix(line, `\`):
+			parts = append(parts, inline(strings.TrimSuffix(line, `\`))+"<br>")
+		default:
+			parts = append(parts, inline(strings.TrimRight(line, " ")))
+		}
+	}
+	return strings.Join(parts, "\n")
+}
+
+// parseList converts the list starting at lines[start], returning its HTML
+// and the index of the first line after it
+func parseList(lines []string, start int) (string, int) {
+	first := listItemPattern.FindStringSubmatch(lines[start])
+	indent := len(first[1])
+	contentIndent := indent + len(first[2]) + 1
+	ordered := !strings.ContainsAny(first[2], "-*+")
+
+	type item struct {
+		lines []string
+	}
+	var items []item
+	loose := false
+
+	i := start
+	for i < len(lines) {
+		line := lines[i]
+		m := listItemPattern.FindStringSubmatch(line)
+		if m != nil && len(m[1]) == indent {
+			if !sameList(line, indent, ordered) {
+				break // A different kind of list
+			}
+			items = append(items, item{lines: []string{m[3]}})
+			i++
+			continue
+		}
+
+		if strings.TrimSpace(line) == "" {
+			// A blank line inside the list makes it loose, if it continues
+			j := i
+			for j < len(lines) && strings.TrimSpace(lines[j]) == "" {
+				j++
+			}
+			if j == len(lines) || leadingSpaces(lines[j]) <= indent && !sameList(lines[j], indent, ordered) {
+				break
+			}
+			loose = true
+			last := &items[len(items)-1]
+			last.lines = append(last.lines, "")
+			i++
+			continue
+		}
+
+		// Indented lines belong to the current item; unindented ones are a
+		// lazy continuation of its paragraph
+		if leadingSpaces(line) > indent || !startsBlock(line) {
+			last := &items[len(items)-1]
+			last.lines = append(last.lines, dedent(line, contentIndent))
+			i++
+			continue
+		}
+
+		break
+	}
+
+	tag := "ul"
+	if ordered {
+		tag = "ol"
+	}
+
+	var b strings.Builder
+	b.WriteString("<" + tag)
+	if ordered {
+		if n := strings.TrimRight(first[2], ".)"); n != "1" {
+			b.WriteString(fmt.Sprintf(` start="%s"`, strings.TrimLeft(n, "0")))
+		}
+	}
+	b.WriteString(">\n")
+
+	for _, it := range items {
+		content := blocks(it.lines)
+		inner := strings.Join(content, "\n")
+		// Tight lists don't wrap their text in paragraphs
+		if !loose && len(content) > 0 && strings.HasPrefix(content[0], "<p>") {
+			content[0] = strings.TrimSuffix(strings.TrimPrefix(content[0], "<p>"), "</p>")
+			inner = strings.Join(content, "\n")
+		}
+		b.WriteString("<li>" + inner + "</li>\n")
+	}
+	b.WriteString("</" + tag + ">")
+
+	return b.String(), i
+}
+
+// sameList reports whether line is an item of the list at indent
+func sameList(line string, indent int, ordered bool) bool {
+	m := listItemPattern.FindStringSubmatch(line)
+	return m != nil && len(m[1]) == indent && strings.ContainsAny(m[2], "-*+") != ordered
+}
+
+// startsBlock reports whether line starts a block other than a paragraph
+func startsBlock(line string) bool {
+	trimmed := strings.TrimSpace(line)
+	return fencePattern.MatchString(line) ||
+		headingPattern.MatchString(trimmed) ||
+		rulePattern.MatchString(line) ||
+		strings.HasPrefix(trimmed, ">") ||
+		listItemPattern.MatchString(line)
+}
+
+// parseTable converts the table starting at lines[start], returning its HTML
+// and the index of the first line after it
+func parseTable(lines []string, start int) (string, int) {
+	header := tableCells(lines[start])
+	aligns := tableCells(lines[start+1])
+
+	align := func(col int) string {
+		if col >= len(aligns) {
+			return ""
+		}
+		a := aligns[col]
+		switch {
+		case strings.HasPrefix(a, ":") && strings.HasSuffix(a, ":"):
+			return ` style="text-align: center"`
+		case strings.HasSuffix(a, ":"):
+			return ` style="text-align: right"`
+		}
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString("<table>\n<thead>\n<tr>")
+	for col, cell := range header {
+		b.WriteString(fmt.Sprintf("<th%s>%s</th>", align(col), inline(cell)))
+	}
+	b.WriteString("</tr>\n</thead>\n<tbody>\n")
+
+	i := start + 2
+	for i < len(lines) && strings.TrimSpace(lines[i]) != "" && strings.Contains(lines[i], "|") {
+		b.WriteString("<tr>")
+		for col, cell := range tableCells(lines[i]) {
+			b.WriteString(fmt.Sprintf("<td%s>%s</td>", align(col), inline(cell)))
+		}
+		b.WriteString("</tr>\n")
+		i++
+	}
+	b.WriteString("</tbody>\n</table>")
+
+	return b.String(), i
+}
+
+// tableCells splits a table row into its cells, honoring escaped pipes
+func tableCells(line string) []string {
+	line = strings.TrimSpace(line)
+	line = strings.TrimPrefix(line, "|")
+	if strings.HasSuffix(line, "|") && !strings.HasSuffix(line, `\|`) {
+		line = strings.TrimSuffix(line, "|")
+	}
+
+	var cells []string
+	var cell strings.Builder
+	for i := 0; i < len(line); i++ {
+		switch {
+		case line[i] == '\\' && i+1 < len(line) && line[i+1] == '|':
+			cell.WriteByte('|')
+			i++
+		case line[i] == '|':
+			cells = append(cells, strings.TrimSpace(cell.String()))
+			cell.Reset()
+		default:
+			cell.WriteByte(line[i])
+		}
+	}
+	return append(cells, strings.TrimSpace(cell.String()))
+}
+
+// inline converts the inline Markdown of one block: code, links, images,
+// emphasis, and escapes
+func inline(s string) string {
+	var held []string
+	hold := func(html string) string {
+		held = append(held, html)
+		return fmt.Sprintf("\x00%d\x00", len(held)-1)
+	}
+
+	// Backslash escapes
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) && strings.IndexByte("\\`*_{}[]()#+-.!|<>~&", s[i+1]) >= 0 {
+			b.WriteString(hold(html.EscapeString(string(s[i+1]))))
+			i++
+			continue
+		}
+		b.WriteByte(s[i])
+	}
+	s = b.String()
+
+	s = codeSpanPattern.ReplaceAllStringFunc(s, func(m string) string {
+		parts := codeSpanPattern.FindStringSubmatch(m)
+		if parts[1] != parts[3] {
+			return m
+		}
+		return hold("<code>" + html.EscapeString(strings.TrimSpace(parts[2])) + "</code>")
+	})
+
+	s = imagePattern.ReplaceAllStringFunc(s, func(m string) string {
+		parts := imagePattern.FindStringSubmatch(m)
+		return hold(fmt.Sprintf(`<img src="%s" alt="%s"%s>`, html.EscapeString(parts[2]), html.EscapeString(parts[1]), titleAttr(parts[3])))
+	})
+
+	s = linkPattern.ReplaceAllStringFunc(s, func(m string) string {
+		parts := linkPattern.FindStringSubmatch(m)
+		return hold(fmt.Sprintf(`<a href="%s"%s>%s</a>`, html.EscapeString(parts[2]), titleAttr(parts[3]), emphasis(escapeHTML(parts[1]))))
+	})
+
+	s = autolinkPattern.ReplaceAllStringFunc(s, func(m string) string {
+		url := autolinkPattern.FindStringSubmatch(m)[1]
+		return hold(fmt.Sprintf(`<a href="%s">%s</a>`, html.EscapeString(url), html.EscapeString(url)))
+	})
+
+	s = emphasis(escapeHTML(s))
+
+	// Restore held HTML; links may hold code spans of their own
+	for placeholderPattern.MatchString(s) {
+		s = placeholderPattern.ReplaceAllStringFunc(s, func(m string) string {
+			var n int
+			fmt.Sscanf(placeholderPattern.FindStringSubmatch(m)[1], "%d", &n)
+			return held[n]
+		})
+	}
+
+	return s
+}
+
+// emphasis converts bold, italic, and strikethrough markers
+func emphasis(s string) string {
+	s = boldPattern.ReplaceAllString(s, "<strong>$1$2</strong>")
+	s = italicPattern.ReplaceAllString(s, "<em>$1$2</em>")
+	return strikePattern.ReplaceAllString(s, "<del>$1</del>")
+}
+
+// escapeHTML escapes text for HTML, leaving inline tags and entities the
+// Markdown already contains alone
+func escapeHTML(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '&':
+			if entityAt(s[i:]) {
+				b.WriteByte(c)
+			} else {
+				b.WriteString("&amp;")
+			}
+		case '<':
+			if tagAt(s[i:]) {
+				b.WriteByte(c)
+			} else {
+				b.WriteString("&lt;")
+			}
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+// tagAt reports whether s starts with what reads as an HTML tag, such as
+// <b> or </div>
+func tagAt(s string) bool {
+	return len(s) > 1 && (isLetter(s[1]) || s[1] == '/' || s[1] == '!') && strings.IndexByte(s, '>') > 0
+}
+
+// entityAt reports whether s starts with an HTML entity such as &amp; or &#39;
+func entityAt(s string) bool {
+	end := strings.IndexByte(s, ';')
+	if end < 2 || end > 10 {
+		return false
+	}
+	name := s[1:end]
+	if name[0] == '#' {
+		name = strings.TrimPrefix(strings.TrimPrefix(name[1:], "x"), "X")
+	}
+	for i := 0; i < len(name); i++ {
+		if !isLetter(name[i]) && !(name[i] >= '0' && name[i] <= '9') {
+			return false
+		}
+	}
+	return name != ""
+}
+
+func isLetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+func titleAttr(title string) string {
+	if title == "" {
+		return ""
+	}
+	return fmt.Sprintf(` title="%s"`, html.EscapeString(title))
+}
+
+// leadingSpaces counts the spaces at the start of line
+func leadingSpaces(line string) int {
+	return len(line) - len(strings.TrimLeft(line, " "))
+}
+
+// dedent removes up to n leading spaces from line
+func dedent(line string, n int) string {
+	return line[min(leadingSpaces(line), n):]
+}
@@ -0,0 +1,81 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+)
+
+// activeFields, when set, limits JSON and CSV output to these fields
+var activeFields []string
+
+// SetFields limits JSON and CSV output to the named fields, in the given
+// order. Names are the JSON field names, e.g. id, subject, status.
+func SetFields(fields []string) {
+	activeFields = nil
+	for _, field := range fields {
+		if field = strings.TrimSpace(field); field != "" {
+			activeFields = append(activeFields, field)
+		}
+	}
+}
+
+// csvHeaders returns the columns to write, honoring SetFields
+func csvHeaders(headers []string) []string {
+	if len(activeFields) > 0 {
+		return activeFields
+	}
+	return headers
+}
+
+// selectedFields is an object restricted to the selected fields. It
+// marshals its keys in the order they were requested.
+type selectedFields struct {
+	keys   []string
+	values map[string]json.RawMessage
+}
+
+func (s selectedFields) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, key := range s.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		name, _ := json.Marshal(key)
+		buf.Write(name)
+		buf.WriteByte(':')
+		if value, ok := s.values[key]; ok {
+			buf.Write(value)
+		} else {
+			buf.WriteString("null")
+		}
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// selectFields restricts data to the selected fields. Objects and lists of
+// objects are filtered; anything else is returned unchanged.
+func selectFields(data interface{}) (interface{}, error) {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(encoded, &object); err == nil {
+		return selectedFields{keys: activeFields, values: object}, nil
+	}
+
+	var list []map[string]json.RawMessage
+	if err := json.Unmarshal(encoded, &list); err == nil {
+		selected := make([]selectedFields, len(list))
+		for i, item := range list {
+			selected[i] = selectedFields{keys: activeFields, values: item}
+		}
+		return selected, nil
+	}
+
+	return data, nil
+}
@@ -17,6 +17,11 @@ const (
 	FormatTable Format = "table"
 	FormatJSON  Format = "json"
 	FormatCSV   Format = "csv"
+
+	// FormatMarkdown renders a ticket and its comment thread, or a Help
+	// Center article, as a Markdown document. Only ticket show, ticket
+	// comments, and hc article show support it.
+	FormatMarkdown Format = "markdown"
 )
 
 // Writer handles output formatting
@@ -33,8 +38,29 @@ func NewWriter(format Format) *Writer {
 	}
 }
 
-// WriteJSON writes data as JSON
+// NewWriterTo creates a new output writer that writes to w instead of stdout
+func NewWriterTo(format Format, w io.Writer) *Writer {
+	return &Writer{
+		format: format,
+		writer: w,
+	}
+}
+
+// WriteJSON writes data as JSON, or through the output template if one is
+// set. Output is limited to the fields chosen with SetFields, if any.
 func (w *Writer) WriteJSON(data interface{}) error {
+	if len(activeFields) > 0 {
+		selected, err := selectFields(data)
+		if err != nil {
+			return err
+		}
+		data = selected
+	}
+
+	if activeTemplate != nil {
+		return writeTemplate(w.writer, data)
+	}
+
 	encoder := json.NewEncoder(w.writer)
 	encoder.SetIndent("", "  ")
 	return encoder.Encode(data)
@@ -43,6 +69,8 @@ func (w *Writer) WriteJSON(data interface{}) error {
 // WriteCSV writes data as CSV
 // data should be a slice of structs or maps
 func (w *Writer) WriteCSV(data interface{}, headers []string) error {
+	headers = csvHeaders(headers)
+
 	csvWriter := csv.NewWriter(w.writer)
 	defer csvWriter.Flush()
 
@@ -67,6 +95,70 @@ func (w *Writer) WriteCSV(data interface{}, headers []string) error {
 	return nil
 }
 
+// WriteNDJSON writes data as newline-delimited JSON (one object per line).
+// Slices are written one element per line so large exports can be streamed.
+func (w *Writer) WriteNDJSON(data interface{}) error {
+	if len(activeFields) > 0 {
+		selected, err := selectFields(data)
+		if err != nil {
+			return err
+		}
+		data = selected
+	}
+
+	if activeTemplate != nil {
+		return writeTemplate(w.writer, data)
+	}
+
+	encoder := json.NewEncoder(w.writer)
+
+	val := reflect.ValueOf(data)
+	if val.Kind() != reflect.Slice {
+		return encoder.Encode(data)
+	}
+
+	for i := 0; i < val.Len(); i++ {
+		if err := encoder.Encode(val.Index(i).Interface()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WriteCSVHeader writes only the CSV header row, for streamed CSV output
+func (w *Writer) WriteCSVHeader(headers []string) error {
+	headers = csvHeaders(headers)
+
+	csvWriter := csv.NewWriter(w.writer)
+	if err := csvWriter.Write(headers); err != nil {
+		return err
+	}
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
+
+// WriteCSVRows writes data as CSV rows without a header row, for streamed CSV output
+func (w *Writer) WriteCSVRows(data interface{}, headers []string) error {
+	headers = csvHeaders(headers)
+
+	csvWriter := csv.NewWriter(w.writer)
+	defer csvWriter.Flush()
+
+	rows, err := convertToCSVRows(data, headers)
+	if err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+		if err := csvWriter.Write(row); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // convertToCSVRows converts interface{} to CSV rows based on headers
 func convertToCSVRows(data interface{}, headers []string) ([][]string, error) {
 	var rows [][]string
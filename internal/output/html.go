@@ -0,0 +1,290 @@
+package output
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"zd-cli/internal/htmltoken"
+
+	"github.com/fatih/color"
+)
+
+// hrefPattern finds the href attribute of an <a> tag
+var hrefPattern = regexp.MustCompile(`(?i)\bhref\s*=\s*(?:"([^"]*)"|'([^']*)'|([^\s>]+))`)
+
+// altPattern finds the alt attribute of an <img> tag
+var altPattern = regexp.MustCompile(`(?i)\balt\s*=\s*(?:"([^"]*)"|'([^']*)'|([^\s>]+))`)
+
+// whitespacePattern matches runs of whitespace, which HTML shows as one space
+var whitespacePattern = regexp.MustCompile(`\s+`)
+
+// htmlRenderer turns the HTML of a comment into terminal text, keeping
+// paragraphs, lists, and links, and styling bold, italic, and code with
+// color codes (dropped when color is off)
+type htmlRenderer struct {
+	out    []byte
+	bold   int
+	italic int
+	code   int
+	pre    int
+	skip   int // Inside <script> or <style>
+	lists  []htmlList
+	links  []htmlLink
+	quotes []int // Start of each open <blockquote> in out
+}
+
+type htmlList struct {
+	ordered bool
+	items   int
+}
+
+type htmlLink struct {
+	href  string
+	start int
+}
+
+// RenderHTML renders an HTML fragment, such as a comment's html_body, as
+// readable terminal text
+func RenderHTML(src string) string {
+	r := &htmlRenderer{}
+
+	for _, token := range htmltoken.Split(src) {
+		if token.Tag {
+			r.tag(token.Data)
+		} else {
+			r.text(token.Data)
+		}
+	}
+
+	text := strings.TrimSpace(string(r.out))
+	for strings.Contains(text, "\n\n\n") {
+		text = strings.ReplaceAll(text, "\n\n\n", "\n\n")
+	}
+	return text
+}
+
+// TruncateRendered shortens rendered text to width visible characters,
+// ending it with "...". Color codes are dropped from truncated text.
+func TruncateRendered(s string, width int) string {
+	plain := ansiPattern.ReplaceAllString(s, "")
+	if utf8.RuneCountInString(plain) <= width {
+		return s
+	}
+	return string([]rune(plain)[:width]) + "..."
+}
+
+// tag handles the contents of one tag, without the angle brackets
+func (r *htmlRenderer) tag(content string) {
+	closing := strings.HasPrefix(content, "/")
+	content = strings.TrimPrefix(content, "/")
+	content = strings.TrimSuffix(content, "/")
+	name := htmltoken.Name(content)
+
+	switch name {
+	case "script", "style":
+		r.skip += depthChange(closing)
+	case "b", "strong":
+		r.bold += depthChange(closing)
+	case "i", "em":
+		r.italic += depthChange(closing)
+	case "code":
+		r.code += depthChange(closing)
+	case "br":
+		r.write("\n")
+	case "p", "div", "table":
+		r.breakLine(2)
+	case "h1", "h2", "h3", "h4", "h5", "h6":
+		r.breakLine(2)
+		r.bold += depthChange(closing)
+	case "pre":
+		r.breakLine(2)
+		r.pre += depthChange(closing)
+	case "tr":
+		r.breakLine(1)
+	case "td", "th":
+		if !closing && !r.atLineStart() {
+			r.write(" | ")
+		}
+	case "hr":
+		r.breakLine(1)
+		r.write(strings.Repeat("─", 40))
+		r.breakLine(1)
+	case "ul", "ol":
+		r.breakLine(1)
+		if closing {
+			if len(r.lists) > 0 {
+				r.lists = r.lists[:len(r.lists)-1]
+			}
+			if len(r.lists) == 0 {
+				r.breakLine(2)
+			}
+		} else {
+			r.lists = append(r.lists, htmlList{ordered: name == "ol"})
+		}
+	case "li":
+		r.breakLine(1)
+		if !closing {
+			r.listItem()
+		}
+	case "blockquote":
+		r.breakLine(2)
+		if closing {
+			r.closeQuote()
+		} else {
+			r.quotes = append(r.quotes, len(r.out))
+		}
+	case "a":
+		if closing {
+			r.closeLink()
+		} else {
+			r.links = append(r.links, htmlLink{href: attribute(hrefPattern, content), start: len(r.out)})
+		}
+	case "img":
+		if alt := attribute(altPattern, content); alt != "" {
+			r.text("[image: " + alt + "]")
+		} else {
+			r.text("[image]")
+		}
+	}
+}
+
+// depthChange returns how an opening or closing tag changes a nesting count
+func depthChange(closing bool) int {
+	if closing {
+		return -1
+	}
+	return 1
+}
+
+// attribute returns the unescaped value of an attribute matched by pattern
+func attribute(pattern *regexp.Regexp, content string) string {
+	m := pattern.FindStringSubmatch(content)
+	if m == nil {
+		return ""
+	}
+	return html.UnescapeString(m[1] + m[2] + m[3])
+}
+
+// text writes a run of text between tags, collapsing whitespace outside <pre>
+func (r *htmlRenderer) text(s string) {
+	if r.skip > 0 || s == "" {
+		return
+	}
+	s = html.UnescapeString(s)
+
+	if r.pre == 0 {
+		s = whitespacePattern.ReplaceAllString(s, " ")
+		if r.atLineStart() || strings.HasSuffix(string(r.out), " ") {
+			s = strings.TrimLeft(s, " ")
+		}
+		if s == "" {
+			return
+		}
+	}
+
+	r.write(r.style(s))
+}
+
+// style applies the color codes for the open inline tags
+func (r *htmlRenderer) style(s string) string {
+	var attrs []color.Attribute
+	if r.bold > 0 {
+		attrs = append(attrs, color.Bold)
+	}
+	if r.italic > 0 {
+		attrs = append(attrs, color.Italic)
+	}
+	if r.code > 0 || r.pre > 0 {
+		attrs = append(attrs, color.FgCyan)
+	}
+	if len(r.links) > 0 {
+		attrs = append(attrs, color.Underline)
+	}
+	if len(attrs) == 0 {
+		return s
+	}
+	return color.New(attrs...).Sprint(s)
+}
+
+// write appends s to the output
+func (r *htmlRenderer) write(s string) {
+	r.out = append(r.out, s...)
+}
+
+// atLineStart reports whether the output is empty or ends with a newline
+func (r *htmlRenderer) atLineStart() bool {
+	return len(r.out) == 0 || r.out[len(r.out)-1] == '\n'
+}
+
+// breakLine ends the output with at least n newlines, so blocks are
+// separated; nothing is added at the very start
+func (r *htmlRenderer) breakLine(n int) {
+	if len(r.out) == 0 {
+		return
+	}
+	// Trailing spaces before a break are noise
+	for len(r.out) > 0 && r.out[len(r.out)-1] == ' ' {
+		r.out = r.out[:len(r.out)-1]
+	}
+	have := len(r.out) - len(strings.TrimRight(string(r.out), "\n"))
+	for ; have < n; have++ {
+		r.out = append(r.out, '\n')
+	}
+}
+
+// listItem writes the bullet or number of a list item, indented by nesting
+func (r *htmlRenderer) listItem() {
+	depth := len(r.lists)
+	if depth == 0 {
+		r.write("• ")
+		return
+	}
+
+	list := &r.lists[depth-1]
+	list.items++
+	indent := strings.Repeat("  ", depth-1)
+	if list.ordered {
+		r.write(fmt.Sprintf("%s%d. ", indent, list.items))
+	} else {
+		r.write(indent + "• ")
+	}
+}
+
+// closeLink follows link text with its URL, unless the text is the URL
+func (r *htmlRenderer) closeLink() {
+	if len(r.links) == 0 {
+		return
+	}
+	link := r.links[len(r.links)-1]
+	r.links = r.links[:len(r.links)-1]
+
+	if link.href == "" || strings.HasPrefix(link.href, "#") {
+		return
+	}
+	text := ansiPattern.ReplaceAllString(string(r.out[link.start:]), "")
+	href := strings.TrimPrefix(link.href, "mailto:")
+	if strings.TrimSpace(text) == href || strings.TrimSpace(text) == link.href {
+		return
+	}
+	r.write(" (" + link.href + ")")
+}
+
+// closeQuote prefixes every line written since the matching <blockquote>
+func (r *htmlRenderer) closeQuote() {
+	if len(r.quotes) == 0 {
+		return
+	}
+	start := r.quotes[len(r.quotes)-1]
+	r.quotes = r.quotes[:len(r.quotes)-1]
+
+	quoted := strings.Trim(string(r.out[start:]), "\n")
+	lines := strings.Split(quoted, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight("│ "+line, " ")
+	}
+	r.out = append(r.out[:start], strings.Join(lines, "\n")...)
+	r.breakLine(2)
+}
@@ -0,0 +1,165 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/fatih/color"
+	"golang.org/x/term"
+)
+
+// Table options set from the global --wide and --no-truncate flags
+var (
+	wideTables     bool
+	truncateTables = true
+)
+
+// SetTableOptions controls how tables are rendered. wide asks commands to
+// include their extra columns; noTruncate keeps long cells intact instead of
+// shrinking them to fit the terminal.
+func SetTableOptions(wide, noTruncate bool) {
+	wideTables = wide
+	truncateTables = !noTruncate
+}
+
+// Wide reports whether tables should include their extra columns
+func Wide() bool {
+	return wideTables
+}
+
+// ansiPattern matches ANSI color escape sequences
+var ansiPattern = regexp.MustCompile("\x1b\\[[0-9;]*m")
+
+// minFlexWidth is the narrowest a truncated column is allowed to get
+const minFlexWidth = 10
+
+// columnGap separates table columns
+const columnGap = "  "
+
+// Table renders rows as aligned columns. Cells may contain color codes;
+// widths are measured on the visible text. One column can be marked as
+// flexible and is truncated so rows fit the terminal.
+type Table struct {
+	headers    []string
+	rows       [][]string
+	flex       int
+	hideHeader bool
+}
+
+// NewTable creates a table with the given column headers
+func NewTable(headers ...string) *Table {
+	return &Table{headers: headers, flex: -1}
+}
+
+// SetFlexColumn marks the column that is truncated to fit the terminal
+func (t *Table) SetFlexColumn(index int) {
+	t.flex = index
+}
+
+// HideHeader leaves the header row out, for continuing a table page by page
+func (t *Table) HideHeader() {
+	t.hideHeader = true
+}
+
+// AddRow adds a row of cells
+func (t *Table) AddRow(cells ...string) {
+	t.rows = append(t.rows, cells)
+}
+
+// Print renders the table to stdout
+func (t *Table) Print() {
+	t.Render(os.Stdout, terminalWidth())
+}
+
+// Render writes the table to w, fitting it into maxWidth columns when
+// maxWidth is positive and truncation is enabled
+func (t *Table) Render(w io.Writer, maxWidth int) {
+	widths := make([]int, len(t.headers))
+	for i, header := range t.headers {
+		widths[i] = visibleWidth(header)
+	}
+	for _, row := range t.rows {
+		for i := range t.headers {
+			if i < len(row) && visibleWidth(row[i]) > widths[i] {
+				widths[i] = visibleWidth(row[i])
+			}
+		}
+	}
+
+	// Shrink the flexible column so the table fits the terminal
+	if truncateTables && maxWidth > 0 && t.flex >= 0 && t.flex < len(widths) {
+		total := 0
+		for _, width := range widths {
+			total += width
+		}
+		total += len(columnGap) * (len(widths) - 1)
+		if over := total - maxWidth; over > 0 {
+			widths[t.flex] = max(widths[t.flex]-over, minFlexWidth)
+		}
+	}
+
+	cells := make([]string, len(t.headers))
+	if !t.hideHeader {
+		headerColor := color.New(color.Bold)
+		for i, header := range t.headers {
+			cells[i] = headerColor.Sprint(pad(header, widths[i], i == len(t.headers)-1))
+		}
+		fmt.Fprintln(w, strings.Join(cells, columnGap))
+	}
+
+	for _, row := range t.rows {
+		for i := range t.headers {
+			cell := ""
+			if i < len(row) {
+				cell = row[i]
+			}
+			if visibleWidth(cell) > widths[i] {
+				cell = truncate(cell, widths[i])
+			}
+			cells[i] = pad(cell, widths[i], i == len(t.headers)-1)
+		}
+		fmt.Fprintln(w, strings.Join(cells, columnGap))
+	}
+}
+
+// visibleWidth returns the printed width of s, ignoring color codes
+func visibleWidth(s string) int {
+	return utf8.RuneCountInString(ansiPattern.ReplaceAllString(s, ""))
+}
+
+// pad right-pads s to width. The last column is left unpadded.
+func pad(s string, width int, last bool) string {
+	if last {
+		return s
+	}
+	if gap := width - visibleWidth(s); gap > 0 {
+		return s + strings.Repeat(" ", gap)
+	}
+	return s
+}
+
+// truncate shortens s to width, ending it with "…". Color codes are dropped
+// from truncated cells.
+func truncate(s string, width int) string {
+	runes := []rune(ansiPattern.ReplaceAllString(s, ""))
+	if len(runes) <= width {
+		return string(runes)
+	}
+	if width <= 1 {
+		return string(runes[:width])
+	}
+	return string(runes[:width-1]) + "…"
+}
+
+// terminalWidth returns the width of the terminal on stdout, or 0 when
+// stdout is not a terminal so piped output is never truncated
+func terminalWidth() int {
+	if width, _, err := term.GetSize(int(os.Stdout.Fd())); err == nil && width > 0 {
+		return width
+	}
+	return 0
+}
@@ -0,0 +1,88 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+)
+
+// activeTemplate, when set, replaces JSON output with Go template output
+var activeTemplate *template.Template
+
+// templateFuncs are the helper functions available to output templates
+var templateFuncs = template.FuncMap{
+	"json": func(v interface{}) (string, error) {
+		data, err := json.Marshal(v)
+		return string(data), err
+	},
+	"join": func(sep string, v interface{}) string {
+		if v == nil {
+			return ""
+		}
+		items, ok := v.([]interface{})
+		if !ok {
+			return fmt.Sprint(v)
+		}
+		parts := make([]string, len(items))
+		for i, item := range items {
+			parts[i] = fmt.Sprint(item)
+		}
+		return strings.Join(parts, sep)
+	},
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+	"truncate": func(n int, s string) string {
+		if len(s) <= n {
+			return s
+		}
+		return s[:n] + "..."
+	},
+}
+
+// SetTemplate makes WriteJSON and WriteNDJSON render data through a Go
+// text/template instead of printing JSON. Fields are addressed by their JSON
+// names, e.g. {{.id}} {{.subject}}. Lists are rendered once per item.
+func SetTemplate(text string) error {
+	tmpl, err := template.New("output").Funcs(templateFuncs).Option("missingkey=zero").Parse(text)
+	if err != nil {
+		return fmt.Errorf("invalid template: %w", err)
+	}
+	activeTemplate = tmpl
+	return nil
+}
+
+// writeTemplate renders data through the active template
+func writeTemplate(w io.Writer, data interface{}) error {
+	// Round-trip through JSON so templates see the same field names as -o json
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	var generic interface{}
+	if err := json.Unmarshal(encoded, &generic); err != nil {
+		return err
+	}
+
+	items, ok := generic.([]interface{})
+	if !ok {
+		items = []interface{}{generic}
+	}
+
+	for _, item := range items {
+		var buf bytes.Buffer
+		if err := activeTemplate.Execute(&buf, item); err != nil {
+			return fmt.Errorf("failed to render template: %w", err)
+		}
+		if !bytes.HasSuffix(buf.Bytes(), []byte("\n")) {
+			buf.WriteByte('\n')
+		}
+		if _, err := w.Write(buf.Bytes()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,146 @@
+package progress
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/term"
+)
+
+const (
+	// barWidth is the number of cells in the bar
+	barWidth = 30
+
+	// redrawInterval limits how often the bar is redrawn
+	redrawInterval = 100 * time.Millisecond
+)
+
+// Bar shows progress toward a total: a bar, the count, the rate, and the
+// estimated time left. With a total of zero, as when an export doesn't know
+// how much is left, only the count and rate are shown.
+//
+// The bar is drawn on stderr, so it never mixes with output piped from
+// stdout, and only when stderr is a terminal. It is safe for concurrent use.
+type Bar struct {
+	mu      sync.Mutex
+	out     io.Writer
+	message string
+	total   int
+	current int
+	start   time.Time
+	drawn   time.Time
+	enabled bool
+}
+
+// NewBar creates a bar for total items, which may be zero if unknown, and
+// draws it
+func NewBar(message string, total int) *Bar {
+	b := &Bar{
+		out:     os.Stderr,
+		message: message,
+		total:   total,
+		start:   time.Now(),
+		enabled: term.IsTerminal(int(os.Stderr.Fd())),
+	}
+	b.mu.Lock()
+	b.draw(true)
+	b.mu.Unlock()
+	return b
+}
+
+// SetTotal changes the total, e.g. once the first page of a list says how
+// many items there are
+func (b *Bar) SetTotal(total int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.total = total
+	b.draw(true)
+}
+
+// Set sets how many items are done
+func (b *Bar) Set(current int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.current = current
+	b.draw(false)
+}
+
+// Add adds n to the items done
+func (b *Bar) Add(n int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.current += n
+	b.draw(false)
+}
+
+// Clear clears the bar so a message can be printed on its line. The bar is
+// drawn again on the next update.
+func (b *Bar) Clear() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.enabled {
+		fmt.Fprint(b.out, "\r\033[K")
+		b.drawn = time.Time{}
+	}
+}
+
+// Finish clears the bar, leaving the line free for a summary
+func (b *Bar) Finish() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.enabled {
+		fmt.Fprint(b.out, "\r\033[K")
+		b.enabled = false
+	}
+}
+
+// draw redraws the bar, at most every redrawInterval unless force is set or
+// the bar is full. The caller holds b.mu.
+func (b *Bar) draw(force bool) {
+	if !b.enabled {
+		return
+	}
+	now := time.Now()
+	full := b.total > 0 && b.current >= b.total
+	if !force && !full && now.Sub(b.drawn) < redrawInterval {
+		return
+	}
+	b.drawn = now
+
+	fmt.Fprintf(b.out, "\r\033[K%s", b.line(now.Sub(b.start)))
+}
+
+// line renders the bar after elapsed time, e.g.
+// "Updating tickets [██████░░░░]  312/1000  31%  52/s  ETA 13s"
+func (b *Bar) line(elapsed time.Duration) string {
+	var parts []string
+	parts = append(parts, b.message)
+
+	if b.total > 0 {
+		current := min(b.current, b.total)
+		filled := current * barWidth / b.total
+		parts = append(parts,
+			"["+strings.Repeat("█", filled)+strings.Repeat("░", barWidth-filled)+"]",
+			fmt.Sprintf(" %d/%d", current, b.total),
+			fmt.Sprintf(" %d%%", current*100/b.total))
+	} else {
+		parts = append(parts, fmt.Sprintf("%d", b.current))
+	}
+
+	// The rate needs a moment to settle before it means anything
+	if elapsed >= time.Second && b.current > 0 {
+		rate := float64(b.current) / elapsed.Seconds()
+		parts = append(parts, fmt.Sprintf(" %.0f/s", rate))
+
+		if b.total > b.current {
+			left := time.Duration(float64(b.total-b.current) / rate * float64(time.Second))
+			parts = append(parts, " ETA "+left.Round(time.Second).String())
+		}
+	}
+
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,184 @@
+package zdtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+
+	"zd-cli/internal/client"
+)
+
+// Cassette is a list of recorded requests and responses, stored as JSON.
+// Secrets in bodies are redacted and credentials aren't recorded, but other
+// data is, so cassettes from a real instance should be checked before
+// sharing.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is one recorded request and its response
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is a recorded request. URL is the path and query only, so
+// a cassette replays for any subdomain.
+type RecordedRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+// RecordedResponse is a recorded response
+type RecordedResponse struct {
+	Status int         `json:"status"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+// LoadCassette reads a cassette from path
+func LoadCassette(path string) (*Cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cassette: %w", err)
+	}
+
+	var cassette Cassette
+	if err := json.Unmarshal(data, &cassette); err != nil {
+		return nil, fmt.Errorf("failed to parse cassette %s: %w", path, err)
+	}
+	return &cassette, nil
+}
+
+// Save writes the cassette to path
+func (c *Cassette) Save(path string) error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode cassette: %w", err)
+	}
+
+	if err := os.WriteFile(path, append(data, '\n'), 0600); err != nil {
+		return fmt.Errorf("failed to write cassette: %w", err)
+	}
+	return nil
+}
+
+// Transport returns a transport that answers requests from the cassette.
+// Recordings of the same method and URL are played in order, and the last
+// one repeats once they run out, so polling replays too. A request that
+// wasn't recorded fails.
+func (c *Cassette) Transport() http.RoundTripper {
+	return &replayTransport{cassette: c, played: make(map[string]int)}
+}
+
+// Record returns a transport that sends requests through base and records
+// them, and their responses, to a new cassette at path. The cassette is saved
+// after each response, so it's complete however the program exits.
+func Record(path string, base http.RoundTripper) http.RoundTripper {
+	return &recordTransport{base: base, path: path, cassette: &Cassette{}}
+}
+
+// replayTransport answers requests from a cassette
+type replayTransport struct {
+	mu       sync.Mutex
+	cassette *Cassette
+	played   map[string]int // Responses played by method and URL
+}
+
+func (t *replayTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	key := req.Method + " " + req.URL.RequestURI()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var matches []Interaction
+	for _, interaction := range t.cassette.Interactions {
+		if interaction.Request.Method+" "+interaction.Request.URL == key {
+			matches = append(matches, interaction)
+		}
+	}
+	if len(matches) == 0 {
+		return nil, client.Permanent(fmt.Errorf("no recorded response for %s", key))
+	}
+
+	played := min(t.played[key], len(matches)-1)
+	t.played[key]++
+
+	recorded := matches[played].Response
+	header := recorded.Header.Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.Status, http.StatusText(recorded.Status)),
+		StatusCode:    recorded.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(recorded.Body)),
+		ContentLength: int64(len(recorded.Body)),
+		Request:       req,
+	}, nil
+}
+
+// recordTransport sends requests through base and records them
+type recordTransport struct {
+	base     http.RoundTripper
+	path     string
+	mu       sync.Mutex
+	cassette *Cassette
+}
+
+func (t *recordTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var reqBody []byte
+	if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			reqBody, _ = io.ReadAll(body)
+			body.Close()
+		}
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	header := resp.Header.Clone()
+	header.Del("Set-Cookie")
+	header.Del("Content-Length") // Redacting may change it
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.cassette.Interactions = append(t.cassette.Interactions, Interaction{
+		Request: RecordedRequest{
+			Method: req.Method,
+			URL:    req.URL.RequestURI(),
+			Body:   client.RedactSecrets(string(reqBody)),
+		},
+		Response: RecordedResponse{
+			Status: resp.StatusCode,
+			Header: header,
+			Body:   client.RedactSecrets(string(respBody)),
+		},
+	})
+	if err := t.cassette.Save(t.path); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
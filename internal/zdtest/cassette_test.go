@@ -0,0 +1,111 @@
+package zdtest
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"zd-cli/internal/cache"
+	"zd-cli/internal/client"
+)
+
+// clientWithTransport returns a client for Instance that sends its requests
+// through rt
+func clientWithTransport(t *testing.T, rt http.RoundTripper) *client.Client {
+	t.Helper()
+
+	zdClient, err := client.NewClientWithCache(Instance(), false, cache.TTL{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	zdClient.SetTransport(rt)
+	return zdClient
+}
+
+// countingTransport counts the requests it passes to base
+type countingTransport struct {
+	base  http.RoundTripper
+	count int
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.count++
+	return t.base.RoundTrip(req)
+}
+
+func TestReplayPlaysInOrderThenRepeatsTheLast(t *testing.T) {
+	cassette := &Cassette{Interactions: []Interaction{
+		{
+			Request:  RecordedRequest{Method: "GET", URL: "/api/v2/tickets/1.json"},
+			Response: RecordedResponse{Status: 200, Body: `{"ticket":{"id":1,"status":"new"}}`},
+		},
+		{
+			Request:  RecordedRequest{Method: "GET", URL: "/api/v2/tickets/1.json"},
+			Response: RecordedResponse{Status: 200, Body: `{"ticket":{"id":1,"status":"open"}}`},
+		},
+	}}
+	zdClient := clientWithTransport(t, cassette.Transport())
+
+	for _, want := range []string{"new", "open", "open"} {
+		ticket, err := zdClient.GetTicket(context.Background(), 1)
+		if err != nil {
+			t.Fatalf("GetTicket: %v", err)
+		}
+		if ticket.Status != want {
+			t.Errorf("status = %q, want %q", ticket.Status, want)
+		}
+	}
+}
+
+func TestReplayFailsUnrecordedRequestsWithoutRetrying(t *testing.T) {
+	cassette := &Cassette{}
+	counter := &countingTransport{base: cassette.Transport()}
+	zdClient := clientWithTransport(t, counter)
+
+	_, err := zdClient.GetTicket(context.Background(), 9)
+	if err == nil || !strings.Contains(err.Error(), "no recorded response for GET /api/v2/tickets/9.json") {
+		t.Errorf("GetTicket error = %v, want no recorded response", err)
+	}
+	if counter.count != 1 {
+		t.Errorf("sent %d requests, want 1", counter.count)
+	}
+}
+
+func TestRecordSavesARedactedCassetteThatReplays(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	server.Handle("GET", "/tickets/1.json", 200, `{"ticket":{"id":1,"subject":"Reset","token":"s3cret"}}`)
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	recording := clientWithTransport(t, Record(path, server.Transport()))
+	if _, err := recording.GetTicket(context.Background(), 1); err != nil {
+		t.Fatalf("GetTicket while recording: %v", err)
+	}
+
+	cassette, err := LoadCassette(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cassette.Interactions) != 1 {
+		t.Fatalf("recorded %d interactions, want 1", len(cassette.Interactions))
+	}
+	recorded := cassette.Interactions[0]
+	if recorded.Request.URL != "/api/v2/tickets/1.json" {
+		t.Errorf("recorded URL = %q, want /api/v2/tickets/1.json", recorded.Request.URL)
+	}
+	if strings.Contains(recorded.Response.Body, "s3cret") {
+		t.Errorf("recorded body %s keeps a secret", recorded.Response.Body)
+	}
+
+	replaying := clientWithTransport(t, cassette.Transport())
+	ticket, err := replaying.GetTicket(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("GetTicket while replaying: %v", err)
+	}
+	if ticket.Subject != "Reset" {
+		t.Errorf("subject = %q, want Reset", ticket.Subject)
+	}
+}
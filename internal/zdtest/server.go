@@ -0,0 +1,161 @@
+// Package zdtest runs commands and client code against a fake Zendesk, either
+// an httptest server with canned responses or a cassette of responses
+// recorded from a real instance.
+package zdtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+
+	"zd-cli/internal/cache"
+	"zd-cli/internal/client"
+	"zd-cli/internal/config"
+)
+
+// Subdomain is the subdomain of the instance Instance returns
+const Subdomain = "zdtest"
+
+// Server is a fake Zendesk API. Requests to a path with no handler get the
+// 404 Zendesk sends for an unknown endpoint.
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	routes   map[string]http.HandlerFunc // By "METHOD /api/v2/path.json"
+	requests []Request
+}
+
+// Request is a request the server received
+type Request struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Body   []byte
+}
+
+// NewServer starts a server with no handlers. Close it when done.
+func NewServer() *Server {
+	s := &Server{routes: make(map[string]http.HandlerFunc)}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	return s
+}
+
+// Handle responds to method and path, such as "GET" and "/tickets/1.json",
+// with status and body. A string or []byte body is sent as is; anything else
+// is sent as JSON.
+func (s *Server) Handle(method, path string, status int, body interface{}) {
+	data, err := encodeBody(body)
+	if err != nil {
+		panic(fmt.Sprintf("zdtest: %s %s: %v", method, path, err))
+	}
+
+	s.HandleFunc(method, path, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(status)
+		w.Write(data)
+	})
+}
+
+// HandleFunc responds to method and path with fn, replacing any handler
+// already set for them. path is relative to /api/v2 and has no query.
+func (s *Server) HandleFunc(method, path string, fn http.HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.routes[method+" /api/v2"+path] = fn
+}
+
+// Requests returns the requests received so far, in order
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// Transport returns a transport that sends requests for any Zendesk URL to
+// the server
+func (s *Server) Transport() http.RoundTripper {
+	target, _ := url.Parse(s.URL)
+	return &redirectTransport{base: s.Server.Client().Transport, target: target}
+}
+
+// NewClient returns a client for Instance that talks to the server, with
+// caching off so every call reaches it
+func (s *Server) NewClient() (*client.Client, error) {
+	zdClient, err := client.NewClientWithCache(Instance(), false, cache.TTL{})
+	if err != nil {
+		return nil, err
+	}
+	zdClient.SetTransport(s.Transport())
+	return zdClient, nil
+}
+
+// Instance returns an instance with token credentials for a fake subdomain
+func Instance() *config.Instance {
+	return &config.Instance{
+		Name:      Subdomain,
+		Subdomain: Subdomain,
+		AuthType:  config.AuthTypeToken,
+		Email:     "agent@example.com",
+		APIToken:  "zdtest-token",
+	}
+}
+
+// serve records the request and passes it to its handler
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{
+		Method: r.Method,
+		Path:   r.URL.Path,
+		Query:  r.URL.Query(),
+		Body:   body,
+	})
+	fn := s.routes[r.Method+" "+r.URL.Path]
+	s.mu.Unlock()
+
+	if fn == nil {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"error":"InvalidEndpoint","description":"Not found"}`)
+		return
+	}
+
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	fn(w, r)
+}
+
+// encodeBody returns a response body: strings and bytes as they are, and
+// anything else as JSON
+func encodeBody(body interface{}) ([]byte, error) {
+	switch b := body.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return []byte(b), nil
+	case []byte:
+		return b, nil
+	}
+	return json.Marshal(body)
+}
+
+// redirectTransport sends every request to target's host, keeping its path
+// and query
+type redirectTransport struct {
+	base   http.RoundTripper
+	target *url.URL
+}
+
+func (t *redirectTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.URL.Scheme = t.target.Scheme
+	req.URL.Host = t.target.Host
+	req.Host = t.target.Host
+	return t.base.RoundTrip(req)
+}
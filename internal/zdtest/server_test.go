@@ -0,0 +1,104 @@
+package zdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"zd-cli/internal/client"
+)
+
+func TestServerAnswersHandledPaths(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	server.Handle("GET", "/tickets/1.json", 200, map[string]interface{}{
+		"ticket": map[string]interface{}{"id": 1, "subject": "Printer on fire"},
+	})
+
+	zdClient, err := server.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ticket, err := zdClient.GetTicket(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("GetTicket: %v", err)
+	}
+	if ticket.Subject != "Printer on fire" {
+		t.Errorf("subject = %q, want %q", ticket.Subject, "Printer on fire")
+	}
+
+	requests := server.Requests()
+	if len(requests) != 1 || requests[0].Method != "GET" || requests[0].Path != "/api/v2/tickets/1.json" {
+		t.Errorf("requests = %+v, want one GET /api/v2/tickets/1.json", requests)
+	}
+}
+
+func TestServerSendsNotFoundForUnknownPaths(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	zdClient, err := server.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = zdClient.GetTicket(context.Background(), 2)
+	if err == nil || !strings.Contains(err.Error(), "InvalidEndpoint") {
+		t.Errorf("GetTicket error = %v, want InvalidEndpoint", err)
+	}
+}
+
+func TestServerRecordsRequestBodies(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	server.Handle("PUT", "/tickets/3.json", 200, `{"ticket":{"id":3,"status":"solved"}}`)
+
+	zdClient, err := server.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := zdClient.Tickets().Update(context.Background(), 3, map[string]interface{}{"status": "solved"}); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+
+	requests := server.Requests()
+	if len(requests) != 1 || !strings.Contains(string(requests[0].Body), `"status":"solved"`) {
+		t.Errorf("requests = %+v, want one with status solved in its body", requests)
+	}
+}
+
+func TestCreateRecordKeepsNumbersAndRefusals(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	server.Handle("POST", "/macros.json", 201, `{"macro":{"id":360012345678901234,"title":"Escalate"}}`)
+
+	zdClient, err := server.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	macros, _ := client.FindExportResource("macros")
+
+	created, err := zdClient.CreateRecord(context.Background(), macros, map[string]interface{}{"title": "Escalate"})
+	if err != nil {
+		t.Fatalf("CreateRecord: %v", err)
+	}
+	if id, _ := created["id"].(json.Number); id != "360012345678901234" {
+		t.Errorf("id = %v, want 360012345678901234 exactly", created["id"])
+	}
+
+	zdClient.SetWriteGuard(func(req *http.Request) error {
+		return errors.New("instance is read-only")
+	})
+	_, err = zdClient.CreateRecord(context.Background(), macros, map[string]interface{}{"title": "Escalate"})
+	if err == nil || err.Error() != "instance is read-only" {
+		t.Errorf("CreateRecord error = %v, want the guard's refusal as it is", err)
+	}
+}